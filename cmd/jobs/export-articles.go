@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuananhlai/brevity-go/internal/config"
+	"github.com/tuananhlai/brevity-go/internal/export"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// RunExportArticles exports the published articles of a digital author, oldest first, to an EPUB book or a zip
+// archive of Markdown files. The file is written to output, or to "<author ID>.epub" or "<author ID>.zip" if
+// output is empty.
+func RunExportArticles(authorID string, format string, output string) {
+	cfg := config.MustLoadConfig()
+
+	ctx := context.Background()
+
+	id, err := uuid.Parse(authorID)
+	if err != nil {
+		log.Fatalf("invalid author ID %q: %v\n", authorID, err)
+	}
+	if format != "epub" && format != "md" {
+		log.Fatalf("unsupported format %q, expected epub or md\n", format)
+	}
+
+	db, err := sqlx.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	s := store.New(db)
+
+	author, err := s.GetDigitalAuthor(ctx, id)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	previews, err := s.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{AuthorID: id})
+	if err != nil {
+		log.Fatalln(err)
+	}
+	slices.Reverse(previews)
+
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
+	articles := make([]export.Article, 0, len(previews))
+	for _, preview := range previews {
+		details, err := s.GetArticleBySlug(ctx, preview.Slug, uuid.Nil)
+		if err != nil {
+			log.Fatalf("fetching article %s failed: %v\n", preview.Slug, err)
+		}
+		articles = append(articles, export.NewArticle(details, baseURL+"/articles/"+details.Slug))
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case "epub":
+		err = export.EPUB(&buf, export.Book{
+			ID:       "urn:uuid:" + author.ID.String(),
+			Title:    author.DisplayName,
+			Author:   author.DisplayName,
+			Articles: articles,
+		})
+	default:
+		err = export.MarkdownBundle(&buf, articles)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if output == "" {
+		output = author.ID.String() + ".zip"
+		if format == "epub" {
+			output = author.ID.String() + ".epub"
+		}
+	}
+	if err := os.WriteFile(output, buf.Bytes(), 0o644); err != nil {
+		log.Fatalln(err)
+	}
+
+	log.Printf("exported %d articles to %s\n", len(articles), output)
+}
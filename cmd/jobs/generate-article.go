@@ -1,18 +1,27 @@
 package jobs
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"log"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 	"github.com/tuananhlai/brevity-go/internal/config"
+	"github.com/tuananhlai/brevity-go/internal/content"
 	"github.com/tuananhlai/brevity-go/internal/genarticle"
+	"github.com/tuananhlai/brevity-go/internal/slug"
 	"github.com/tuananhlai/brevity-go/internal/store"
 )
 
+// maxCreateAttempts is the number of slugs tried for a generated article before it is given up. A slug is only
+// taken again if another article is created with the same slug at the same time.
+const maxCreateAttempts = 3
+
 func RunGenerateArticle() {
 	cfg := config.MustLoadConfig()
 
@@ -33,22 +42,47 @@ func RunGenerateArticle() {
 	}
 
 	generator := genarticle.New(client)
+	renderer := content.NewRenderer()
+	slugs := slug.NewService(s)
 
 	var wg sync.WaitGroup
 	for _, author := range authors {
 		wg.Go(func() {
-			result, err := generator.Generate(ctx, author.SystemPrompt, author.ArticleSlugs)
+			var series *genarticle.Series
+			if author.SeriesID.Valid {
+				var err error
+				series, err = loadSeries(ctx, s, author.SeriesID.UUID)
+				if err != nil {
+					log.Printf("loading series for author %s failed: %v\n", author.ID, err)
+					return
+				}
+			}
+
+			result, err := generator.Generate(ctx, author.SystemPrompt, author.ArticleSlugs, series)
 			if err != nil {
 				log.Printf("generation for author %s failed: %v\n", author.ID, err)
 				return
 			}
 
-			err = s.CreateArticle(ctx, &store.Article{
-				Slug:        result.Slug,
-				Title:       result.Title,
-				Description: result.Description,
-				Content:     result.Content,
-				AuthorID:    author.ID,
+			rendered, err := renderer.Render(result.Content)
+			if err != nil {
+				log.Printf("rendering article for author %s failed: %v\n", author.ID, err)
+				return
+			}
+
+			// The slug suggested by the model is not guaranteed to be well-formed or unique.
+			err = createArticle(ctx, s, slugs, cmp.Or(result.Slug, result.Title), &store.Article{
+				Title:            result.Title,
+				Description:      result.Description,
+				Content:          result.Content,
+				ContentFormat:    store.ContentFormatMarkdown,
+				HTMLContent:      rendered.HTML,
+				PlaintextContent: rendered.Plaintext,
+				AuthorID:         author.ID,
+				Tags:             result.Tags,
+				SeriesID:         author.SeriesID,
+				// Generated articles stay hidden until the owner of the digital author approves them.
+				Status: store.ArticleStatusPendingReview,
 			})
 			log.Printf("generation for author %s completed. error = %v\n", author.ID, err)
 		})
@@ -56,3 +90,55 @@ func RunGenerateArticle() {
 
 	wg.Wait()
 }
+
+// createArticle creates an article with a unique slug generated from slugText. The slug is generated again if it
+// is taken by another article between its generation and the creation of the article.
+func createArticle(ctx context.Context, s *store.Store, slugs *slug.Service, slugText string,
+	article *store.Article) error {
+	for attempt := 1; ; attempt++ {
+		articleSlug, err := slugs.Generate(ctx, slugText)
+		if err != nil {
+			return err
+		}
+
+		article.Slug = articleSlug
+		err = s.CreateArticle(ctx, article)
+		if !errors.Is(err, store.ErrArticleSlugTaken) || attempt == maxCreateAttempts {
+			return err
+		}
+	}
+}
+
+// loadSeries returns a series along with its earlier parts, so that the next part can be generated.
+func loadSeries(ctx context.Context, s *store.Store, seriesID uuid.UUID) (*genarticle.Series, error) {
+	series, err := s.GetSeries(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.ListSeriesPartSummaries(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPosition, err := s.GetNextSeriesPosition(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &genarticle.Series{
+		Title:        series.Title,
+		Description:  series.Description,
+		Parts:        make([]genarticle.SeriesPart, len(parts)),
+		NextPosition: nextPosition,
+	}
+	for i, part := range parts {
+		result.Parts[i] = genarticle.SeriesPart{
+			Position: part.Position,
+			Title:    part.Title,
+			Summary:  part.Description,
+		}
+	}
+
+	return result, nil
+}
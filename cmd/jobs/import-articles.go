@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/tuananhlai/brevity-go/internal/config"
+	"github.com/tuananhlai/brevity-go/internal/importer"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// RunImportArticles imports the Markdown files of a directory as articles of a digital author, and prints what
+// was done with each file. With dryRun, it only prints what would be done.
+func RunImportArticles(dir string, authorID string, dryRun bool) {
+	cfg := config.MustLoadConfig()
+
+	ctx := context.Background()
+
+	id, err := uuid.Parse(authorID)
+	if err != nil {
+		log.Fatalf("invalid author ID %q: %v\n", authorID, err)
+	}
+
+	db, err := sqlx.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	s := store.New(db)
+
+	if _, err := s.GetDigitalAuthor(ctx, id); err != nil {
+		log.Fatalln(err)
+	}
+
+	results, err := importer.New(s).ImportDir(ctx, importer.ImportDirParams{
+		Dir:      dir,
+		AuthorID: id,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	counts := map[importer.Action]int{}
+	for _, result := range results {
+		counts[result.Action]++
+		if result.Err != nil {
+			log.Printf("%-9s %s: %v\n", result.Action, result.File, result.Err)
+			continue
+		}
+		log.Printf("%-9s %s: %s\n", result.Action, result.File, result.Slug)
+	}
+
+	prefix := ""
+	if dryRun {
+		prefix = "dry run: "
+	}
+	log.Printf("%s%d to create, %d to update, %d unchanged, %d failed\n", prefix, counts[importer.ActionCreate],
+		counts[importer.ActionUpdate], counts[importer.ActionUnchanged], counts[importer.ActionFail])
+}
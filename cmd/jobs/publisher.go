@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/uptrace/opentelemetry-go-extra/otelsql"
+	"github.com/uptrace/opentelemetry-go-extra/otelsqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/tuananhlai/brevity-go/internal/config"
+	"github.com/tuananhlai/brevity-go/internal/publisher"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/telemetry"
+)
+
+func RunPublisher() {
+	cfg := config.MustLoadConfig()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err := telemetry.Setup(ctx)
+	if err != nil {
+		log.Fatalf("error initializing opentelemetry sdk: %s", err)
+	}
+
+	db, err := otelsqlx.Open("postgres", cfg.DatabaseURL,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer db.Close()
+
+	p, err := publisher.New(store.New(db), cfg.PublisherInterval,
+		telemetry.Logger("github.com/tuananhlai/brevity-go/cmd/jobs"))
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	log.Printf("publisher started, checking for due articles every %s\n", cfg.PublisherInterval)
+	p.Run(ctx)
+	log.Println("publisher stopped")
+}
@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/tuananhlai/brevity-go/internal/config"
+	"github.com/tuananhlai/brevity-go/internal/content"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func RunRenderArticles() {
+	cfg := config.MustLoadConfig()
+
+	ctx := context.Background()
+
+	db, err := sqlx.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	s := store.New(db)
+	renderer := content.NewRenderer()
+
+	sources, err := s.ListArticleSources(ctx)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var updated int
+	for _, source := range sources {
+		var rendered *content.Rendered
+		switch source.ContentFormat {
+		case store.ContentFormatHTML:
+			rendered = renderer.RenderHTML(source.Content)
+		default:
+			rendered, err = renderer.Render(source.Content)
+			if err != nil {
+				log.Printf("rendering article %s failed: %v\n", source.ID, err)
+				continue
+			}
+		}
+
+		changed, err := s.UpdateArticleRendering(ctx, store.UpdateArticleRenderingParams{
+			ID:               source.ID,
+			HTMLContent:      rendered.HTML,
+			PlaintextContent: rendered.Plaintext,
+		})
+		if err != nil {
+			log.Printf("updating article %s failed: %v\n", source.ID, err)
+			continue
+		}
+		if changed {
+			updated++
+		}
+	}
+
+	log.Printf("rendered %d articles, %d changed\n", len(sources), updated)
+}
@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"os/signal"
+	"syscall"
+
+	"github.com/uptrace/opentelemetry-go-extra/otelsql"
+	"github.com/uptrace/opentelemetry-go-extra/otelsqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+
+	"github.com/tuananhlai/brevity-go/internal/config"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/telemetry"
+	"github.com/tuananhlai/brevity-go/internal/trending"
+)
+
+func RunTrending() {
+	cfg := config.MustLoadConfig()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err := telemetry.Setup(ctx)
+	if err != nil {
+		log.Fatalf("error initializing opentelemetry sdk: %s", err)
+	}
+
+	db, err := otelsqlx.Open("postgres", cfg.DatabaseURL,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer db.Close()
+
+	r, err := trending.New(store.New(db), trending.SystemClock{}, cfg.TrendingInterval, cfg.TrendingHalfLife,
+		telemetry.Logger("github.com/tuananhlai/brevity-go/cmd/jobs"))
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	log.Printf("trending refresher started, refreshing scores every %s with a half-life of %s\n",
+		cfg.TrendingInterval, cfg.TrendingHalfLife)
+	r.Run(ctx)
+	log.Println("trending refresher stopped")
+}
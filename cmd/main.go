@@ -21,7 +21,21 @@ var rootCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(serverCmd)
 	rootCmd.AddCommand(generateArticleCmd)
+	rootCmd.AddCommand(renderArticlesCmd)
+	rootCmd.AddCommand(publisherCmd)
+	rootCmd.AddCommand(trendingCmd)
+	rootCmd.AddCommand(exportArticlesCmd)
+	rootCmd.AddCommand(importArticlesCmd)
 	rootCmd.AddCommand(migrate.GetMigrateCmd())
+
+	exportArticlesCmd.Flags().String("author", "", "ID of the digital author whose articles are exported")
+	exportArticlesCmd.Flags().String("format", "epub", "Format of the export: epub or md")
+	exportArticlesCmd.Flags().StringP("output", "o", "", "Path of the exported file (default \"<author>.epub\" or \"<author>.zip\")")
+	_ = exportArticlesCmd.MarkFlagRequired("author")
+
+	importArticlesCmd.Flags().String("author", "", "ID of the digital author who publishes the articles")
+	importArticlesCmd.Flags().Bool("dry-run", false, "Report what would be created or updated without changing anything")
+	_ = importArticlesCmd.MarkFlagRequired("author")
 }
 
 var serverCmd = &cobra.Command{
@@ -42,6 +56,64 @@ var generateArticleCmd = &cobra.Command{
 	},
 }
 
+var renderArticlesCmd = &cobra.Command{
+	Use:   "render-articles",
+	Short: "Re-render the HTML and plaintext content of all articles",
+	Long: `Re-render the HTML and plaintext content of all articles from their source content.
+Run it after changing the content pipeline or to backfill articles created before it existed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		jobs.RunRenderArticles()
+	},
+}
+
+var publisherCmd = &cobra.Command{
+	Use:   "publisher",
+	Short: "Publish scheduled articles when they are due",
+	Long: `Start a worker which periodically publishes the scheduled articles whose publish time has come.
+Several workers can run at the same time without publishing an article twice.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		jobs.RunPublisher()
+	},
+}
+
+var trendingCmd = &cobra.Command{
+	Use:   "trending",
+	Short: "Refresh the trending scores of articles periodically",
+	Long: `Start a worker which periodically recomputes the trending scores used by orderBy=trending.
+The scores weigh the recent views, claps, comments and bookmarks of each article more than older ones.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		jobs.RunTrending()
+	},
+}
+
+var exportArticlesCmd = &cobra.Command{
+	Use:   "export-articles",
+	Short: "Export the published articles of a digital author",
+	Long: `Export the published articles of a digital author, oldest first, for offline reading.
+The epub format writes an EPUB 3 book with one chapter per article, the md format a zip archive of
+Markdown files with YAML front matter.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		author, _ := cmd.Flags().GetString("author")
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		jobs.RunExportArticles(author, format, output)
+	},
+}
+
+var importArticlesCmd = &cobra.Command{
+	Use:   "import-articles <dir>",
+	Short: "Import articles from Markdown files with front matter",
+	Long: `Import the Markdown files of a directory as published articles of a digital author.
+Each file starts with YAML front matter, with a title and optionally a slug, description, tags and date.
+Articles are matched by slug, so importing the same files again only updates the articles which changed.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		author, _ := cmd.Flags().GetString("author")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		jobs.RunImportArticles(args[0], author, dryRun)
+	},
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -1,15 +1,69 @@
 package server
 
 import (
+	"time"
+
 	"github.com/tuananhlai/brevity-go/internal/controller"
 	"github.com/tuananhlai/brevity-go/internal/encryption"
 	"github.com/tuananhlai/brevity-go/internal/llmapikey"
+	"github.com/tuananhlai/brevity-go/internal/ogimage"
 	"github.com/tuananhlai/brevity-go/internal/store"
 	"github.com/tuananhlai/brevity-go/internal/token"
 )
 
-func initializeArticleController(s *store.Store) *controller.ArticleController {
-	return controller.NewArticleController(s)
+func initializeArticleController(s *store.Store, pageTokenSecret []byte) *controller.ArticleController {
+	return controller.NewArticleController(s, pageTokenSecret)
+}
+
+func initializeArticleReviewController(s *store.Store, pageTokenSecret []byte) *controller.ArticleReviewController {
+	return controller.NewArticleReviewController(s, pageTokenSecret)
+}
+
+func initializeTagController(s *store.Store, pageTokenSecret []byte) *controller.TagController {
+	return controller.NewTagController(s, pageTokenSecret)
+}
+
+func initializeCommentController(s *store.Store, pageTokenSecret []byte) *controller.CommentController {
+	return controller.NewCommentController(s, pageTokenSecret)
+}
+
+func initializeBookmarkController(s *store.Store, pageTokenSecret []byte) *controller.BookmarkController {
+	return controller.NewBookmarkController(s, pageTokenSecret)
+}
+
+func initializeFollowController(s *store.Store, pageTokenSecret []byte) *controller.FollowController {
+	return controller.NewFollowController(s, pageTokenSecret)
+}
+
+func initializeAnalyticsController(s *store.Store, dedupWindow time.Duration,
+	visitorHashSecret []byte) *controller.AnalyticsController {
+	return controller.NewAnalyticsController(s, dedupWindow, visitorHashSecret)
+}
+
+func initializeFeedController(s *store.Store, baseURL string, apiBaseURL string,
+	itemCount int) *controller.FeedController {
+	return controller.NewFeedController(s, baseURL, apiBaseURL, itemCount)
+}
+
+func initializeSitemapController(s *store.Store, baseURL string) *controller.SitemapController {
+	return controller.NewSitemapController(s, baseURL)
+}
+
+func initializeRelatedArticleController(s *store.Store, cacheTTL time.Duration) *controller.RelatedArticleController {
+	return controller.NewRelatedArticleController(s, cacheTTL)
+}
+
+func initializeArticleExportController(s *store.Store, baseURL string) *controller.ArticleExportController {
+	return controller.NewArticleExportController(s, baseURL)
+}
+
+func initializeArticleMetaController(s *store.Store, ogImageCacheSize int, baseURL string,
+	apiBaseURL string) *controller.ArticleMetaController {
+	return controller.NewArticleMetaController(s, ogimage.NewRenderer(ogImageCacheSize), baseURL, apiBaseURL)
+}
+
+func initializeModerationController(s *store.Store, pageTokenSecret []byte) *controller.ModerationController {
+	return controller.NewModerationController(s, pageTokenSecret)
 }
 
 func initializeAuthController(s *store.Store, tokenIssuer *token.AccessTokenIssuer) *controller.AuthController {
@@ -45,7 +45,21 @@ func Run() {
 
 	s := store.New(db)
 	tokenIssuer := token.NewIssuer(accessTokenSecret)
-	articleController := initializeArticleController(s)
+	articleController := initializeArticleController(s, cfg.GetPageTokenSecret())
+	articleReviewController := initializeArticleReviewController(s, cfg.GetPageTokenSecret())
+	tagController := initializeTagController(s, cfg.GetPageTokenSecret())
+	commentController := initializeCommentController(s, cfg.GetPageTokenSecret())
+	bookmarkController := initializeBookmarkController(s, cfg.GetPageTokenSecret())
+	followController := initializeFollowController(s, cfg.GetPageTokenSecret())
+	analyticsController := initializeAnalyticsController(s, cfg.AnalyticsDedupWindow,
+		cfg.GetVisitorHashSecret())
+	feedController := initializeFeedController(s, cfg.BaseURL, cfg.GetAPIBaseURL(), cfg.FeedItemCount)
+	sitemapController := initializeSitemapController(s, cfg.BaseURL)
+	relatedArticleController := initializeRelatedArticleController(s, cfg.RelatedArticlesCacheTTL)
+	moderationController := initializeModerationController(s, cfg.GetPageTokenSecret())
+	articleExportController := initializeArticleExportController(s, cfg.BaseURL)
+	articleMetaController := initializeArticleMetaController(s, cfg.OGImageCacheSize, cfg.BaseURL,
+		cfg.GetAPIBaseURL())
 	authController := initializeAuthController(s, tokenIssuer)
 	healthController := controller.NewHealthController()
 	encryptionService, err := encryption.New([]byte(cfg.EncryptionKey))
@@ -56,24 +70,92 @@ func Run() {
 	}
 	llmAPIKeyController := initializeLLMAPIKeyController(s, encryptionService)
 	authMiddleware := controller.AuthMiddleware(tokenIssuer)
+	optionalAuthMiddleware := controller.OptionalAuthMiddleware(tokenIssuer)
+	moderatorMiddleware := controller.ModeratorMiddleware(s)
+	articleCache := controller.CacheControlMiddleware(cfg.ArticleCacheControl)
+	articlePreviewsCache := controller.CacheControlMiddleware(cfg.ArticlePreviewsCacheControl)
+	feedCache := controller.CacheControlMiddleware(cfg.FeedCacheControl)
+	sitemapCache := controller.CacheControlMiddleware(cfg.SitemapCacheControl)
+	ogImageCache := controller.CacheControlMiddleware(cfg.OGImageCacheControl)
 	digitalAuthorController := controller.NewDigitalAuthorController(s)
+	seriesController := controller.NewSeriesController(s)
+	readingListController := controller.NewReadingListController(s)
 
 	// == Gin Setup ==
 	r := gin.Default()
+	// The IP addresses of clients identify anonymous visitors, so forwarding headers are only read from the
+	// configured proxies.
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		logger.Error("invalid trusted proxies", "error", err)
+		os.Exit(1)
+	}
 	r.Use(otelgin.Middleware("main-server"))
 	r.Use(cors.New(getCorsConfig()))
 
 	// == Routes ==
 	r.GET("/health/liveness", healthController.CheckLiveness)
+	r.GET("/robots.txt", sitemapCache, sitemapController.Robots)
+	r.GET("/sitemap.xml", sitemapCache, sitemapController.Sitemap)
+	r.GET("/sitemaps/:file", sitemapCache, sitemapController.SitemapPage)
+	r.GET("/feeds/articles.rss", feedCache, feedController.ArticlesRSS)
+	r.GET("/feeds/articles.atom", feedCache, feedController.ArticlesAtom)
+	r.GET("/feeds/authors/:file", feedCache, feedController.AuthorAtom)
 	r.POST("/v1/auth/sign-up", authController.Register)
 	r.POST("/v1/auth/sign-in", authController.Login)
-	r.GET("/v1/article-previews", articleController.ListPreviews)
-	r.GET("/v1/articles/:slug", articleController.GetBySlug)
+	r.GET("/v1/article-previews", optionalAuthMiddleware, articlePreviewsCache, articleController.ListPreviews)
+	r.GET("/v1/articles/search", optionalAuthMiddleware, articleController.Search)
+	r.GET("/v1/articles/:slug", optionalAuthMiddleware, articleCache, articleController.GetBySlug)
+	r.GET("/v1/articles/:slug/related", optionalAuthMiddleware, relatedArticleController.ListRelated)
+	r.GET("/v1/articles/:slug/export", articleCache, articleExportController.Export)
+	r.GET("/v1/articles/:slug/meta", articleCache, articleMetaController.Meta)
+	r.GET("/v1/articles/:slug/og-image.png", ogImageCache, articleMetaController.OGImage)
+	r.POST("/v1/articles/:slug/claps", authMiddleware, articleController.Clap)
+	r.POST("/v1/articles/:slug/bookmark", authMiddleware, bookmarkController.Bookmark)
+	r.DELETE("/v1/articles/:slug/bookmark", authMiddleware, bookmarkController.DeleteBookmark)
+	r.POST("/v1/articles/:slug/events", optionalAuthMiddleware, analyticsController.RecordEvent)
+	r.POST("/v1/articles/:slug/reports", authMiddleware, moderationController.ReportArticle)
+	r.PUT("/v1/articles/:slug/takedown", authMiddleware, moderatorMiddleware, moderationController.TakeDownArticle)
+	r.DELETE("/v1/articles/:slug/takedown", authMiddleware, moderatorMiddleware, moderationController.RestoreArticle)
+	r.GET("/v1/articles/:slug/revisions", articleController.ListRevisions)
+	r.GET("/v1/articles/:slug/revisions/:n/diff", articleController.DiffRevisions)
+	r.GET("/v1/articles/:slug/comments", commentController.ListComments)
+	r.POST("/v1/articles/:slug/comments", authMiddleware, commentController.CreateComment)
+	r.PATCH("/v1/comments/:id", authMiddleware, commentController.UpdateComment)
+	r.DELETE("/v1/comments/:id", authMiddleware, commentController.DeleteComment)
+	r.PUT("/v1/comments/:id/moderation", authMiddleware, moderatorMiddleware, commentController.Moderate)
+	r.POST("/v1/comments/:id/reports", authMiddleware, moderationController.ReportComment)
+	r.GET("/v1/moderation/reports", authMiddleware, moderatorMiddleware, moderationController.ListReports)
+	r.POST("/v1/moderation/reports/:id/resolve", authMiddleware, moderatorMiddleware, moderationController.ResolveReport)
+	r.GET("/v1/tags", tagController.ListTags)
+	r.GET("/v1/tags/:slug/articles", optionalAuthMiddleware, tagController.ListArticles)
 	r.GET("/v1/auth/me", authMiddleware, authController.GetCurrentUser)
 	r.POST("/v1/llm-api-keys", authMiddleware, llmAPIKeyController.CreateLLMAPIKey)
 	r.GET("/v1/llm-api-keys", authMiddleware, llmAPIKeyController.ListLLMAPIKeys)
-	r.GET("/v1/digital-authors", digitalAuthorController.ListDigitalAuthors)
-	r.POST("/v1/digital-authors", digitalAuthorController.CreateDigitalAuthor)
+	r.GET("/v1/digital-authors", optionalAuthMiddleware, digitalAuthorController.ListDigitalAuthors)
+	r.POST("/v1/digital-authors", authMiddleware, digitalAuthorController.CreateDigitalAuthor)
+	r.POST("/v1/digital-authors/:id/follow", authMiddleware, followController.Follow)
+	r.DELETE("/v1/digital-authors/:id/follow", authMiddleware, followController.Unfollow)
+	r.GET("/v1/digital-authors/:id/analytics", authMiddleware, analyticsController.GetAuthorAnalytics)
+	r.POST("/v1/series", authMiddleware, seriesController.CreateSeries)
+	r.GET("/v1/series/:id", seriesController.GetSeries)
+	r.POST("/v1/series/:id/complete", authMiddleware, seriesController.CompleteSeries)
+	r.POST("/v1/reading-lists", authMiddleware, readingListController.CreateReadingList)
+	r.GET("/v1/reading-lists/:id", optionalAuthMiddleware, readingListController.GetReadingList)
+	r.PUT("/v1/reading-lists/:id", authMiddleware, readingListController.UpdateReadingList)
+	r.DELETE("/v1/reading-lists/:id", authMiddleware, readingListController.DeleteReadingList)
+	r.PUT("/v1/reading-lists/:id/articles/:slug", authMiddleware, readingListController.AddArticle)
+	r.DELETE("/v1/reading-lists/:id/articles/:slug", authMiddleware, readingListController.RemoveArticle)
+	r.GET("/v1/me/feed", authMiddleware, followController.ListFeed)
+	r.GET("/v1/me/bookmarks", authMiddleware, bookmarkController.ListBookmarks)
+	r.GET("/v1/me/reading-lists", authMiddleware, readingListController.ListOwnReadingLists)
+	r.GET("/v1/me/articles", authMiddleware, articleReviewController.ListOwnArticles)
+	r.GET("/v1/me/articles/:slug", authMiddleware, articleReviewController.GetOwnArticle)
+	r.DELETE("/v1/me/articles/:slug", authMiddleware, articleReviewController.Delete)
+	r.POST("/v1/me/articles/:slug/approve", authMiddleware, articleReviewController.Approve)
+	r.POST("/v1/me/articles/:slug/reject", authMiddleware, articleReviewController.Reject)
+	r.POST("/v1/me/articles/:slug/archive", authMiddleware, articleReviewController.Archive)
+	r.POST("/v1/me/articles/:slug/schedule", authMiddleware, articleReviewController.Schedule)
+	r.PUT("/v1/me/articles/:slug/slug", authMiddleware, articleReviewController.Rename)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%v", cfg.Port),
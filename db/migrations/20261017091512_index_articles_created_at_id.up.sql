@@ -0,0 +1,3 @@
+-- +migrate Up
+-- Supports keyset pagination of article previews, which are ordered by (created_at, id).
+CREATE INDEX IF NOT EXISTS idx_articles_created_at_id ON articles (created_at DESC, id DESC);
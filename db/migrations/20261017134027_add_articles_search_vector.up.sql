@@ -0,0 +1,14 @@
+-- +migrate Up
+BEGIN;
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(plaintext_content, '')), 'C')
+) STORED;
+
+COMMENT ON COLUMN articles.search_vector IS 'Full-text search document built from the title, description and plaintext content. Matches in the title rank highest.';
+
+CREATE INDEX IF NOT EXISTS idx_articles_search_vector ON articles USING GIN (search_vector);
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- +migrate Down
+BEGIN;
+
+COMMENT ON COLUMN articles.html_content IS NULL;
+ALTER TABLE articles DROP COLUMN IF EXISTS html_content;
+
+UPDATE articles SET content_format = 'text/markdown' WHERE content_format = 'markdown';
+ALTER TABLE articles ALTER COLUMN content_format SET DEFAULT 'html';
+
+COMMENT ON COLUMN articles.content IS 'The rich text content of the article, used for display in the UI.';
+COMMENT ON COLUMN articles.content_format IS 'The format of the article content, only "html" is supported for now.';
+
+COMMIT;
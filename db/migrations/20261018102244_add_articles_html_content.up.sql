@@ -0,0 +1,14 @@
+-- +migrate Up
+BEGIN;
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS html_content TEXT NOT NULL DEFAULT '';
+
+-- Article content is authored in Markdown and rendered to HTML on write.
+UPDATE articles SET content_format = 'markdown' WHERE content_format = 'text/markdown';
+ALTER TABLE articles ALTER COLUMN content_format SET DEFAULT 'markdown';
+
+COMMENT ON COLUMN articles.content IS 'The source content of the article, in the format specified by content_format.';
+COMMENT ON COLUMN articles.content_format IS 'The format of the article content, either "markdown" or "html".';
+COMMENT ON COLUMN articles.html_content IS 'The sanitized HTML rendering of the article content, safe for display in the UI. Existing articles are backfilled with `brevity render-articles`.';
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS article_tags;
+DROP TABLE IF EXISTS tags;
+
+COMMIT;
@@ -0,0 +1,24 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    slug VARCHAR(64) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (slug)
+);
+
+COMMENT ON TABLE tags IS 'Topics which articles can be categorized under.';
+COMMENT ON COLUMN tags.slug IS 'The normalized tag name, used for the URL and to deduplicate tags. Example: "go-concurrency"';
+COMMENT ON COLUMN tags.name IS 'The tag name to display in the UI. Example: "Go Concurrency"';
+
+CREATE TABLE IF NOT EXISTS article_tags (
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (article_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_tags_tag_id ON article_tags (tag_id);
+
+COMMIT;
@@ -0,0 +1,24 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS article_revisions (
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    content_format VARCHAR(31) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (article_id, revision_number)
+);
+
+COMMENT ON TABLE article_revisions IS 'Snapshots of the title, description and content of an article, taken every time the article is written.';
+COMMENT ON COLUMN article_revisions.revision_number IS 'The number of the revision within its article, starting at 1 for the revision created with the article.';
+
+-- Existing articles have never changed, so their current state is their first revision.
+INSERT INTO article_revisions (article_id, revision_number, title, description, content, content_format, created_at)
+SELECT id, 1, title, COALESCE(description, ''), content, content_format, created_at
+FROM articles
+ON CONFLICT DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,11 @@
+-- +migrate Down
+BEGIN;
+
+DROP INDEX IF EXISTS idx_digital_authors_owner_id;
+ALTER TABLE digital_authors DROP COLUMN IF EXISTS owner_id;
+
+DROP INDEX IF EXISTS idx_articles_status_published_at;
+ALTER TABLE articles DROP COLUMN IF EXISTS published_at;
+ALTER TABLE articles DROP COLUMN IF EXISTS status;
+
+COMMIT;
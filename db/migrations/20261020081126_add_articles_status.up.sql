@@ -0,0 +1,23 @@
+-- +migrate Up
+BEGIN;
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS status VARCHAR(31) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'pending_review', 'published', 'archived'));
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+-- Articles written before the review process existed have been public since they were created.
+UPDATE articles SET published_at = created_at WHERE status = 'published' AND published_at IS NULL;
+ALTER TABLE articles ALTER COLUMN status SET DEFAULT 'draft';
+
+COMMENT ON COLUMN articles.status IS 'The lifecycle status of the article: "draft", "pending_review", "published" or "archived". Only published articles are visible to the public.';
+COMMENT ON COLUMN articles.published_at IS 'The time the article becomes visible to the public. It may be in the future for published articles which are scheduled.';
+
+CREATE INDEX IF NOT EXISTS idx_articles_status_published_at ON articles (status, published_at);
+
+ALTER TABLE digital_authors ADD COLUMN IF NOT EXISTS owner_id UUID REFERENCES users (id);
+
+COMMENT ON COLUMN digital_authors.owner_id IS 'The user who created the digital author and reviews its articles. Digital authors created before owners existed have none.';
+
+CREATE INDEX IF NOT EXISTS idx_digital_authors_owner_id ON digital_authors (owner_id);
+
+COMMIT;
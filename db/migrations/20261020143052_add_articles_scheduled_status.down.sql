@@ -0,0 +1,13 @@
+-- +migrate Down
+BEGIN;
+
+DROP INDEX IF EXISTS idx_articles_scheduled_published_at;
+
+-- Scheduled articles are represented by published articles with a future publish time.
+UPDATE articles SET status = 'published' WHERE status = 'scheduled';
+
+ALTER TABLE articles DROP CONSTRAINT IF EXISTS articles_status_check;
+ALTER TABLE articles ADD CONSTRAINT articles_status_check
+    CHECK (status IN ('draft', 'pending_review', 'published', 'archived'));
+
+COMMIT;
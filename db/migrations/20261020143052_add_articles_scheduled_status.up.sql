@@ -0,0 +1,18 @@
+-- +migrate Up
+BEGIN;
+
+-- The constraint created with the status column has the default name.
+ALTER TABLE articles DROP CONSTRAINT IF EXISTS articles_status_check;
+ALTER TABLE articles ADD CONSTRAINT articles_status_check
+    CHECK (status IN ('draft', 'pending_review', 'scheduled', 'published', 'archived'));
+
+-- Articles which were scheduled by setting a future publish time are published by `brevity publisher` from now on.
+UPDATE articles SET status = 'scheduled' WHERE status = 'published' AND published_at > CURRENT_TIMESTAMP;
+
+-- Supports polling for the scheduled articles which are due.
+CREATE INDEX IF NOT EXISTS idx_articles_scheduled_published_at ON articles (published_at) WHERE status = 'scheduled';
+
+COMMENT ON COLUMN articles.status IS 'The lifecycle status of the article: "draft", "pending_review", "scheduled", "published" or "archived". Only published articles are visible to the public.';
+COMMENT ON COLUMN articles.published_at IS 'The time the article becomes visible to the public. Scheduled articles are published by `brevity publisher` once it has passed. NULL for articles which have not been approved.';
+
+COMMIT;
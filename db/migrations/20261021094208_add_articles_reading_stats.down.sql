@@ -0,0 +1,9 @@
+-- +migrate Down
+BEGIN;
+
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS readability_grade,
+    DROP COLUMN IF EXISTS reading_minutes,
+    DROP COLUMN IF EXISTS word_count;
+
+COMMIT;
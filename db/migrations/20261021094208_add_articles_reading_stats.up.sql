@@ -0,0 +1,13 @@
+-- +migrate Up
+BEGIN;
+
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS word_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reading_minutes INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS readability_grade DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN articles.word_count IS 'The number of words in plaintext_content. Existing articles are backfilled by `brevity render-articles`.';
+COMMENT ON COLUMN articles.reading_minutes IS 'The estimated time to read the article, rounded up to whole minutes.';
+COMMENT ON COLUMN articles.readability_grade IS 'The Flesch-Kincaid grade level of plaintext_content. Lower is easier to read.';
+
+COMMIT;
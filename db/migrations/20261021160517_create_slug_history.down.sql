@@ -0,0 +1,6 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS slug_history;
+
+COMMIT;
@@ -0,0 +1,16 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS slug_history (
+    slug VARCHAR(255) PRIMARY KEY,
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_slug_history_article_id ON slug_history (article_id);
+
+COMMENT ON TABLE slug_history IS 'The previous slugs of articles, so that old URLs can be redirected to the current slug.';
+COMMENT ON COLUMN slug_history.slug IS 'A slug the article had before. It is never reused by another article.';
+COMMENT ON COLUMN slug_history.created_at IS 'The time the article stopped using the slug.';
+
+COMMIT;
@@ -0,0 +1,11 @@
+-- +migrate Down
+BEGIN;
+
+ALTER TABLE articles
+    DROP CONSTRAINT IF EXISTS articles_series_position_key,
+    DROP COLUMN IF EXISTS series_position,
+    DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS series;
+
+COMMIT;
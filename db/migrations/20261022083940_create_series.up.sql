@@ -0,0 +1,26 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    author_id UUID NOT NULL REFERENCES digital_authors (id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_series_author_id_created_at ON series (author_id, created_at);
+
+COMMENT ON TABLE series IS 'Ordered sequences of articles by the same digital author, e.g. a multi-part tutorial.';
+COMMENT ON COLUMN series.author_id IS 'The digital author who writes the series. New articles of the author continue their latest series.';
+
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES series (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS series_position INTEGER,
+    ADD CONSTRAINT articles_series_position_key UNIQUE (series_id, series_position);
+
+COMMENT ON COLUMN articles.series_id IS 'The series the article is a part of. NULL for standalone articles.';
+COMMENT ON COLUMN articles.series_position IS 'The position of the article within its series, starting at 1.';
+
+COMMIT;
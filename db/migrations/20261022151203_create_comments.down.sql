@@ -0,0 +1,8 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS comments;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+
+COMMIT;
@@ -0,0 +1,32 @@
+-- +migrate Up
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS role VARCHAR(15) NOT NULL DEFAULT 'member' CHECK (role IN ('member', 'moderator'));
+
+COMMENT ON COLUMN users.role IS 'The permissions of the user, either "member" or "moderator". Moderators can hide comments.';
+
+CREATE TABLE IF NOT EXISTS comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES comments (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    moderation_status VARCHAR(15) NOT NULL DEFAULT 'visible' CHECK (moderation_status IN ('visible', 'flagged', 'hidden')),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    edited_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+-- Supports paginating the top-level comments of an article, oldest first.
+CREATE INDEX IF NOT EXISTS idx_comments_article_id_created_at_id ON comments (article_id, created_at, id) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+
+COMMENT ON TABLE comments IS 'Comments on articles. Replies to a comment reference it with parent_id.';
+COMMENT ON COLUMN comments.parent_id IS 'The comment this comment replies to. NULL for top-level comments.';
+COMMENT ON COLUMN comments.moderation_status IS 'Either "visible", "flagged" for comments which need the attention of a moderator, or "hidden" for comments whose body must not be shown.';
+COMMENT ON COLUMN comments.edited_at IS 'The last time the body was edited by its author. NULL if it was never edited.';
+COMMENT ON COLUMN comments.deleted_at IS 'The time the comment was deleted by its author. Deleted comments are kept so that their replies stay in the thread.';
+
+COMMIT;
@@ -0,0 +1,6 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS article_claps;
+
+COMMIT;
@@ -0,0 +1,16 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS article_claps (
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    count INTEGER NOT NULL CHECK (count BETWEEN 1 AND 50),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (article_id, user_id)
+);
+
+COMMENT ON TABLE article_claps IS 'The claps given by users to articles. Each user can clap an article up to 50 times.';
+COMMENT ON COLUMN article_claps.count IS 'The total number of times the user clapped the article.';
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS reading_list_articles;
+
+DROP TABLE IF EXISTS reading_lists;
+
+DROP TABLE IF EXISTS bookmarks;
+
+COMMIT;
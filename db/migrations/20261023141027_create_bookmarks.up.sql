@@ -0,0 +1,39 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS bookmarks (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, article_id)
+);
+
+-- Supports paginating the bookmarks of a user, most recently bookmarked first.
+CREATE INDEX IF NOT EXISTS idx_bookmarks_user_id_created_at_article_id ON bookmarks (user_id, created_at DESC, article_id DESC);
+
+COMMENT ON TABLE bookmarks IS 'Articles saved by users to read later. Bookmarks are private to their user.';
+
+CREATE TABLE IF NOT EXISTS reading_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    owner_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (owner_id, name)
+);
+
+COMMENT ON TABLE reading_lists IS 'Named collections of articles curated by users.';
+COMMENT ON COLUMN reading_lists.is_public IS 'Whether the reading list can be viewed by anyone. Private reading lists can only be viewed by their owner.';
+
+CREATE TABLE IF NOT EXISTS reading_list_articles (
+    reading_list_id UUID NOT NULL REFERENCES reading_lists (id) ON DELETE CASCADE,
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (reading_list_id, article_id)
+);
+
+COMMENT ON TABLE reading_list_articles IS 'The articles of reading lists.';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- +migrate Down
+BEGIN;
+
+DROP INDEX IF EXISTS idx_articles_author_id_created_at_id;
+
+DROP TABLE IF EXISTS follows;
+
+COMMIT;
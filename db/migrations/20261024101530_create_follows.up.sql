@@ -0,0 +1,19 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS follows (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES digital_authors (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, author_id)
+);
+
+-- Supports counting the followers of digital authors.
+CREATE INDEX IF NOT EXISTS idx_follows_author_id ON follows (author_id);
+
+-- Supports listing the newest articles of the followed digital authors in the home feed.
+CREATE INDEX IF NOT EXISTS idx_articles_author_id_created_at_id ON articles (author_id, created_at DESC, id DESC);
+
+COMMENT ON TABLE follows IS 'Digital authors followed by users. The articles of followed digital authors make up the home feed of a user.';
+
+COMMIT;
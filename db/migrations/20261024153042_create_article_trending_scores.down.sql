@@ -0,0 +1,8 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS article_trending_scores;
+
+DROP TABLE IF EXISTS article_daily_views;
+
+COMMIT;
@@ -0,0 +1,23 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS article_daily_views (
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    views INTEGER NOT NULL DEFAULT 0 CHECK (views >= 0),
+    PRIMARY KEY (article_id, day)
+);
+
+COMMENT ON TABLE article_daily_views IS 'The number of views of each article per day, in UTC.';
+
+CREATE TABLE IF NOT EXISTS article_trending_scores (
+    article_id UUID PRIMARY KEY REFERENCES articles (id) ON DELETE CASCADE,
+    score DOUBLE PRECISION NOT NULL,
+    refreshed_at TIMESTAMPTZ NOT NULL
+);
+
+COMMENT ON TABLE article_trending_scores IS 'The time-decayed engagement of published articles, used to list trending articles. It is refreshed periodically by the trending worker.';
+COMMENT ON COLUMN article_trending_scores.score IS 'The weighted sum of the views, claps, comments and bookmarks of the article, each halved for every half-life elapsed since it happened.';
+COMMENT ON COLUMN article_trending_scores.refreshed_at IS 'The time the score was computed for.';
+
+COMMIT;
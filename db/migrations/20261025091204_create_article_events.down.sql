@@ -0,0 +1,16 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS article_daily_readers;
+
+ALTER TABLE article_daily_stats
+    DROP COLUMN IF EXISTS read_seconds,
+    DROP COLUMN IF EXISTS completions;
+ALTER TABLE article_daily_stats RENAME CONSTRAINT article_daily_stats_pkey TO article_daily_views_pkey;
+ALTER TABLE article_daily_stats RENAME TO article_daily_views;
+
+COMMENT ON TABLE article_daily_views IS 'The number of views of each article per day, in UTC.';
+
+DROP TABLE IF EXISTS article_events;
+
+COMMIT;
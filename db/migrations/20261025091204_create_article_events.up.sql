@@ -0,0 +1,40 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS article_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    visitor_hash VARCHAR(64) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('view', 'read_completion')),
+    read_seconds INTEGER NOT NULL DEFAULT 0 CHECK (read_seconds >= 0),
+    window_start TIMESTAMPTZ NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    -- Only the first event of each visitor, type and window is recorded.
+    UNIQUE (article_id, visitor_hash, type, window_start)
+);
+
+COMMENT ON TABLE article_events IS 'View and read completion events of articles, at most one per visitor, type and deduplication window. Events are aggregated into the daily rollup tables when they are recorded.';
+COMMENT ON COLUMN article_events.visitor_hash IS 'The SHA-256 hash of the signed-in user, or of the anonymous visitor, who sent the event.';
+COMMENT ON COLUMN article_events.read_seconds IS 'The time the visitor took to read the article. Only set for read completion events.';
+COMMENT ON COLUMN article_events.window_start IS 'The start of the deduplication window the event happened in.';
+
+-- The daily views are extended with the other daily metrics of the articles.
+ALTER TABLE article_daily_views RENAME TO article_daily_stats;
+ALTER TABLE article_daily_stats RENAME CONSTRAINT article_daily_views_pkey TO article_daily_stats_pkey;
+ALTER TABLE article_daily_stats
+    ADD COLUMN IF NOT EXISTS completions INTEGER NOT NULL DEFAULT 0 CHECK (completions >= 0),
+    ADD COLUMN IF NOT EXISTS read_seconds BIGINT NOT NULL DEFAULT 0 CHECK (read_seconds >= 0);
+
+COMMENT ON TABLE article_daily_stats IS 'The number of views and read completions of each article per day, in UTC.';
+COMMENT ON COLUMN article_daily_stats.read_seconds IS 'The total reading time of the read completions.';
+
+CREATE TABLE IF NOT EXISTS article_daily_readers (
+    article_id UUID NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    visitor_hash VARCHAR(64) NOT NULL,
+    PRIMARY KEY (article_id, day, visitor_hash)
+);
+
+COMMENT ON TABLE article_daily_readers IS 'The distinct visitors of each article per day, in UTC. It is used to count unique readers over date ranges.';
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS related_article_caches;
+
+DROP EXTENSION IF EXISTS pg_trgm;
+
+COMMIT;
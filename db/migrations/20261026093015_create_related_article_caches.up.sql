@@ -0,0 +1,16 @@
+-- +migrate Up
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS related_article_caches (
+    article_id UUID PRIMARY KEY REFERENCES articles (id) ON DELETE CASCADE,
+    related_ids UUID[] NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL
+);
+
+COMMENT ON TABLE related_article_caches IS 'The most similar published articles to each article, computed on demand and reused until the article changes or the cache expires.';
+COMMENT ON COLUMN related_article_caches.related_ids IS 'The IDs of the related articles, most similar first. Articles which are no longer public are skipped when reading them.';
+COMMENT ON COLUMN related_article_caches.computed_at IS 'The time the related articles were computed.';
+
+COMMIT;
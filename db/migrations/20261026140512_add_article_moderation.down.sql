@@ -0,0 +1,11 @@
+-- +migrate Down
+BEGIN;
+
+DROP TABLE IF EXISTS reports;
+
+ALTER TABLE articles DROP COLUMN IF EXISTS takedown_reason;
+ALTER TABLE articles DROP COLUMN IF EXISTS taken_down_by;
+ALTER TABLE articles DROP COLUMN IF EXISTS taken_down_at;
+ALTER TABLE articles DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
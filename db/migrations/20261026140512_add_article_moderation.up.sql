@@ -0,0 +1,41 @@
+-- +migrate Up
+BEGIN;
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS taken_down_at TIMESTAMPTZ;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS taken_down_by UUID REFERENCES users (id) ON DELETE SET NULL;
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS takedown_reason TEXT;
+
+COMMENT ON COLUMN articles.deleted_at IS 'The time the article was deleted by the owner of its digital author. Deleted articles are kept, but hidden from everyone.';
+COMMENT ON COLUMN articles.taken_down_at IS 'The time a moderator took the article down. Articles which are taken down are hidden from the public until they are restored.';
+COMMENT ON COLUMN articles.taken_down_by IS 'The moderator who took the article down.';
+COMMENT ON COLUMN articles.takedown_reason IS 'Why the article was taken down, as given by the moderator.';
+
+CREATE TABLE IF NOT EXISTS reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+    reporter_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    article_id UUID REFERENCES articles (id) ON DELETE CASCADE,
+    comment_id UUID REFERENCES comments (id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('spam', 'harassment', 'misinformation', 'inappropriate', 'copyright', 'other')),
+    details TEXT NOT NULL DEFAULT '',
+    status VARCHAR(15) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'upheld', 'dismissed')),
+    resolved_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    resolution_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT chk_reports_target CHECK ((article_id IS NULL) <> (comment_id IS NULL))
+);
+
+-- A reader has at most one open report of each article or comment.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reports_open_article ON reports (reporter_id, article_id) WHERE status = 'open' AND article_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reports_open_comment ON reports (reporter_id, comment_id) WHERE status = 'open' AND comment_id IS NOT NULL;
+-- Supports paginating the moderation queue, oldest first.
+CREATE INDEX IF NOT EXISTS idx_reports_status_created_at_id ON reports (status, created_at, id);
+
+COMMENT ON TABLE reports IS 'Articles or comments flagged by readers for moderators to review.';
+COMMENT ON COLUMN reports.article_id IS 'The reported article. NULL for reports of comments.';
+COMMENT ON COLUMN reports.comment_id IS 'The reported comment. NULL for reports of articles.';
+COMMENT ON COLUMN reports.status IS 'Either "open" for reports waiting for a moderator, "upheld" if the reported content was hidden, or "dismissed" if it was kept.';
+COMMENT ON COLUMN reports.resolved_by IS 'The moderator who resolved the report. NULL for open reports.';
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- +migrate Down
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS idx_articles_created_at_id ON articles (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_articles_author_id_created_at_id ON articles (author_id, created_at DESC, id DESC);
+
+DROP INDEX IF EXISTS idx_articles_listed_at_id;
+DROP INDEX IF EXISTS idx_articles_author_id_listed_at_id;
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- +migrate Up
+BEGIN;
+
+-- Supports keyset pagination of article previews, which are ordered by (COALESCE(published_at, created_at), id)
+-- so that articles appear at the time they were published rather than generated.
+CREATE INDEX IF NOT EXISTS idx_articles_listed_at_id ON articles ((COALESCE(published_at, created_at)) DESC, id DESC);
+
+-- Supports listing the latest articles of the followed digital authors in the home feed, and of a digital author
+-- in its feed.
+CREATE INDEX IF NOT EXISTS idx_articles_author_id_listed_at_id ON articles (author_id, (COALESCE(published_at, created_at)) DESC, id DESC);
+
+DROP INDEX IF EXISTS idx_articles_created_at_id;
+DROP INDEX IF EXISTS idx_articles_author_id_created_at_id;
+
+COMMIT;
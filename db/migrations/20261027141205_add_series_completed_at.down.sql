@@ -0,0 +1,2 @@
+-- +migrate Down
+ALTER TABLE series DROP COLUMN IF EXISTS completed_at;
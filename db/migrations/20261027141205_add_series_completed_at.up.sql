@@ -0,0 +1,4 @@
+-- +migrate Up
+ALTER TABLE series ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN series.completed_at IS 'The time the owner of the digital author ended the series. New articles of the author only continue their latest series while it is not completed. NULL for open series.';
@@ -0,0 +1,7 @@
+-- +migrate Down
+BEGIN;
+
+DROP INDEX IF EXISTS idx_articles_author_id_updated_at;
+DROP INDEX IF EXISTS idx_articles_updated_at;
+
+COMMIT;
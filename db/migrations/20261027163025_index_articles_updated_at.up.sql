@@ -0,0 +1,9 @@
+-- +migrate Up
+BEGIN;
+
+-- Supports finding the latest update time of all articles, and of the articles of a digital author, which is the
+-- Last-Modified time of the lists and feeds of articles.
+CREATE INDEX IF NOT EXISTS idx_articles_updated_at ON articles (updated_at DESC);
+CREATE INDEX IF NOT EXISTS idx_articles_author_id_updated_at ON articles (author_id, updated_at DESC);
+
+COMMIT;
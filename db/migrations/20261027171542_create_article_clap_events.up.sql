@@ -0,0 +1,21 @@
+-- +migrate Up
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS article_clap_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    article_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    count INTEGER NOT NULL CHECK (count > 0),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    FOREIGN KEY (article_id, user_id) REFERENCES article_claps (article_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_clap_events_article_id_user_id ON article_clap_events (article_id, user_id);
+
+COMMENT ON TABLE article_clap_events IS 'The claps added to articles over time, so that each clap decays from the time it was given in the trending scores. The counts of the events of a user sum up to article_claps.count.';
+
+-- The time of earlier claps is unknown, so they are assumed to have been given at the last clap.
+INSERT INTO article_clap_events (article_id, user_id, count, created_at)
+SELECT article_id, user_id, count, updated_at FROM article_claps;
+
+COMMIT;
@@ -0,0 +1,6 @@
+-- +migrate Down
+BEGIN;
+
+DROP INDEX IF EXISTS idx_articles_plaintext_sample_trgm;
+
+COMMIT;
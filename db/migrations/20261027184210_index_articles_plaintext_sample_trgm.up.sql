@@ -0,0 +1,8 @@
+-- +migrate Up
+BEGIN;
+
+-- Supports finding the articles whose text is similar to an article's, for its related articles. The expression
+-- must match relatedTextSample in the store.
+CREATE INDEX IF NOT EXISTS idx_articles_plaintext_sample_trgm ON articles USING gin (left(plaintext_content, 4000) gin_trgm_ops);
+
+COMMIT;
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/tuananhlai/brevity-go/internal/telemetry"
@@ -12,10 +13,55 @@ type AppConfig struct {
 	Port          string `env:"PORT"`
 	DatabaseURL   string `env:"DATABASE_URL"`
 	EncryptionKey string `env:"ENCRYPTION_KEY"`
+	// PageTokenSecret is used to sign pagination tokens so that clients cannot tamper with them.
+	// If empty, EncryptionKey is used instead.
+	PageTokenSecret string `env:"PAGE_TOKEN_SECRET"`
+	// VisitorHashSecret keys the hashes which identify the visitors in the analytics of articles.
+	// If empty, EncryptionKey is used instead.
+	VisitorHashSecret string `env:"VISITOR_HASH_SECRET"`
+	// TrustedProxies are the IP addresses and CIDR ranges of the reverse proxies in front of the server, whose
+	// X-Forwarded-For and X-Real-IP headers are trusted to find the IP address of clients. If empty, no proxy is
+	// trusted and the IP address of the connection is used.
+	TrustedProxies []string `env:"TRUSTED_PROXIES" env-separator:","`
 	// LLMAPIKey is the API key used to generate **all** articles.
 	// This field might be removed once llm api key management feature
 	// is developed.
 	LLMAPIKey string `env:"LLM_API_KEY"`
+	// PublisherInterval is how often the publisher checks for scheduled articles which are due.
+	PublisherInterval time.Duration `env:"PUBLISHER_INTERVAL" env-default:"1m"`
+	// TrendingInterval is how often the trending scores of the articles are refreshed.
+	TrendingInterval time.Duration `env:"TRENDING_INTERVAL" env-default:"10m"`
+	// TrendingHalfLife is the time after which an engagement with an article counts for half as much
+	// in its trending score.
+	TrendingHalfLife time.Duration `env:"TRENDING_HALF_LIFE" env-default:"24h"`
+	// AnalyticsDedupWindow is the length of the windows in which only the first view, or read completion,
+	// of an article by each visitor is counted. The windows are fixed: they start at multiples of their length
+	// since the zero time, so two events shortly before and after the start of a window are both counted.
+	// It must be positive.
+	AnalyticsDedupWindow time.Duration `env:"ANALYTICS_DEDUP_WINDOW" env-default:"30m"`
+	// BaseURL is the URL of the website. It is used to link to its pages from outside of it, such as in feeds
+	// and sitemaps.
+	BaseURL string `env:"BASE_URL" env-default:"https://brevity.laituananh.com"`
+	// APIBaseURL is the public URL of the API, which links to its own resources from outside of it, such as the
+	// social preview images of articles and the feeds. If empty, BaseURL is used, for websites which serve the API
+	// under /v1.
+	APIBaseURL string `env:"API_BASE_URL"`
+	// FeedItemCount is the number of articles in the RSS and Atom feeds.
+	FeedItemCount int `env:"FEED_ITEM_COUNT" env-default:"20"`
+	// RelatedArticlesCacheTTL is how long the related articles of an article are reused before they are
+	// computed again. They are also computed again when the article is updated.
+	RelatedArticlesCacheTTL time.Duration `env:"RELATED_ARTICLES_CACHE_TTL" env-default:"6h"`
+	// OGImageCacheSize is the number of social preview images of articles which are kept in memory, so that they
+	// are not rendered for every request.
+	OGImageCacheSize int `env:"OG_IMAGE_CACHE_SIZE" env-default:"500"`
+	// The Cache-Control policies of the public routes, which let browsers and CDNs cache their responses to
+	// anonymous users. Responses to signed-in users are always private, and error responses are never stored. An
+	// empty policy sets no header.
+	ArticleCacheControl         string `env:"ARTICLE_CACHE_CONTROL" env-default:"public, max-age=60, stale-while-revalidate=300"`
+	ArticlePreviewsCacheControl string `env:"ARTICLE_PREVIEWS_CACHE_CONTROL" env-default:"public, max-age=30, stale-while-revalidate=120"`
+	FeedCacheControl            string `env:"FEED_CACHE_CONTROL" env-default:"public, max-age=300"`
+	SitemapCacheControl         string `env:"SITEMAP_CACHE_CONTROL" env-default:"public, max-age=3600"`
+	OGImageCacheControl         string `env:"OG_IMAGE_CACHE_CONTROL" env-default:"public, max-age=86400"`
 }
 
 func LoadConfig() (*AppConfig, error) {
@@ -25,6 +71,9 @@ func LoadConfig() (*AppConfig, error) {
 	if err := cleanenv.ReadEnv(&config); err != nil {
 		return nil, fmt.Errorf("error reading configuration: %v", err)
 	}
+	if config.AnalyticsDedupWindow <= 0 {
+		return nil, fmt.Errorf("ANALYTICS_DEDUP_WINDOW must be positive, got %s", config.AnalyticsDedupWindow)
+	}
 
 	return &config, nil
 }
@@ -38,3 +87,27 @@ func MustLoadConfig() *AppConfig {
 	}
 	return config
 }
+
+// GetPageTokenSecret returns the secret used to sign pagination tokens.
+func (c *AppConfig) GetPageTokenSecret() []byte {
+	if c.PageTokenSecret != "" {
+		return []byte(c.PageTokenSecret)
+	}
+	return []byte(c.EncryptionKey)
+}
+
+// GetVisitorHashSecret returns the secret used to hash the identities of visitors.
+func (c *AppConfig) GetVisitorHashSecret() []byte {
+	if c.VisitorHashSecret != "" {
+		return []byte(c.VisitorHashSecret)
+	}
+	return []byte(c.EncryptionKey)
+}
+
+// GetAPIBaseURL returns the public URL of the API.
+func (c *AppConfig) GetAPIBaseURL() string {
+	if c.APIBaseURL != "" {
+		return c.APIBaseURL
+	}
+	return c.BaseURL
+}
@@ -0,0 +1,140 @@
+// Package content converts article Markdown, which is untrusted LLM or user output,
+// into the representations stored alongside an article.
+package content
+
+import (
+	"bytes"
+	"fmt"
+	stdhtml "html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+// Rendered holds the derived representations of a Markdown document.
+type Rendered struct {
+	// HTML is the sanitized HTML rendering of the document. It is safe to embed in a web page.
+	HTML string
+	// Plaintext is the text of the document without any markup, used for search and indexing.
+	Plaintext string
+}
+
+// Renderer renders Markdown into sanitized HTML and plaintext. It is safe for concurrent use.
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+	// textPolicy strips all markup.
+	textPolicy *bluemonday.Policy
+}
+
+// NewRenderer returns a renderer which supports GitHub Flavored Markdown.
+func NewRenderer() *Renderer {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		// Raw HTML in the source is omitted since WithUnsafe is not set.
+		// XHTML output keeps the HTML usable in XML documents such as feeds and EPUB files.
+		goldmark.WithRendererOptions(html.WithXHTML()),
+	)
+
+	// The sanitizer is the last line of defense: it removes scripts, event handlers and
+	// dangerous URLs even if the Markdown renderer lets them through.
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+
+	textPolicy := bluemonday.StrictPolicy()
+	textPolicy.AddSpaceWhenStrippingTag(true)
+
+	return &Renderer{
+		markdown:   markdown,
+		policy:     policy,
+		textPolicy: textPolicy,
+	}
+}
+
+// Render converts the Markdown source into sanitized HTML and plaintext. Headings get an id attribute, see
+// TableOfContents.
+func (r *Renderer) Render(markdown string) (*Rendered, error) {
+	source := []byte(markdown)
+	doc := r.markdown.Parser().Parse(text.NewReader(source))
+	assignHeadingIDs(doc, source)
+
+	var buf bytes.Buffer
+	if err := r.markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return nil, fmt.Errorf("error rendering markdown: %w", err)
+	}
+
+	return &Rendered{
+		HTML:      r.policy.Sanitize(buf.String()),
+		Plaintext: extractPlaintext(doc, source),
+	}, nil
+}
+
+// RenderHTML sanitizes an HTML document and extracts its plaintext. It is used for legacy articles
+// which were authored in HTML.
+func (r *Renderer) RenderHTML(source string) *Rendered {
+	sanitized := r.policy.Sanitize(source)
+	plaintext := stdhtml.UnescapeString(r.textPolicy.Sanitize(sanitized))
+
+	return &Rendered{
+		HTML:      sanitized,
+		Plaintext: strings.Join(strings.Fields(plaintext), " "),
+	}
+}
+
+var consecutiveNewlines = regexp.MustCompile(`\n{2,}`)
+
+// extractPlaintext returns the text content of a Markdown AST. Each block ends up on its own line.
+func extractPlaintext(doc ast.Node, source []byte) string {
+	var b strings.Builder
+
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			switch n.(type) {
+			case *extast.TableCell:
+				b.WriteByte(' ')
+			default:
+				if n.Type() == ast.TypeBlock {
+					b.WriteByte('\n')
+				}
+			}
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Text:
+			b.Write(n.Value(source))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				b.WriteByte(' ')
+			}
+		case *ast.String:
+			b.Write(n.Value)
+		case *ast.AutoLink:
+			b.Write(n.Label(source))
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				b.Write(line.Value(source))
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.HTMLBlock, *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.TrimSpace(consecutiveNewlines.ReplaceAllString(strings.Join(lines, "\n"), "\n"))
+}
@@ -0,0 +1,94 @@
+package content_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/content"
+)
+
+func TestRender_Markdown(t *testing.T) {
+	r := content.NewRenderer()
+
+	rendered, err := r.Render("# Title\n\nSome *emphasis* and `code`.\n\n```go\nfmt.Println(\"hi\")\n```\n")
+	require.NoError(t, err)
+
+	require.Contains(t, rendered.HTML, `<h1 id="title">Title</h1>`)
+	require.Contains(t, rendered.HTML, "<em>emphasis</em>")
+	require.Contains(t, rendered.HTML, `<code class="language-go">`)
+	require.Equal(t, "Title\nSome emphasis and code.\nfmt.Println(\"hi\")", rendered.Plaintext)
+}
+
+func TestRender_Table(t *testing.T) {
+	r := content.NewRenderer()
+
+	rendered, err := r.Render("| a | b |\n|---|---|\n| 1 | 2 |\n")
+	require.NoError(t, err)
+
+	require.Contains(t, rendered.HTML, "<table>")
+	require.Equal(t, "a b\n1 2", rendered.Plaintext)
+}
+
+func TestRender_RemovesUnsafeContent(t *testing.T) {
+	r := content.NewRenderer()
+
+	testCases := []struct {
+		name     string
+		markdown string
+		denied   []string
+	}{
+		{
+			name:     "script block",
+			markdown: "Hello\n\n<script>alert(1)</script>\n",
+			denied:   []string{"<script", "alert(1)"},
+		},
+		{
+			name:     "inline event handler",
+			markdown: `Hello <img src="x" onerror="alert(1)"> world`,
+			denied:   []string{"onerror", "alert(1)"},
+		},
+		{
+			name:     "javascript link",
+			markdown: "[click me](javascript:alert(1))",
+			denied:   []string{"javascript:"},
+		},
+		{
+			name:     "iframe",
+			markdown: `<iframe src="https://example.com"></iframe>`,
+			denied:   []string{"<iframe"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, err := r.Render(tc.markdown)
+			require.NoError(t, err)
+
+			for _, denied := range tc.denied {
+				require.NotContains(t, rendered.HTML, denied)
+				require.NotContains(t, rendered.Plaintext, denied)
+			}
+		})
+	}
+}
+
+func TestRender_LinksAreNoFollow(t *testing.T) {
+	r := content.NewRenderer()
+
+	rendered, err := r.Render("[example](https://example.com)")
+	require.NoError(t, err)
+
+	require.Contains(t, rendered.HTML, `href="https://example.com"`)
+	require.Contains(t, rendered.HTML, `rel="nofollow"`)
+	require.Equal(t, "example", rendered.Plaintext)
+}
+
+func TestRenderHTML(t *testing.T) {
+	r := content.NewRenderer()
+
+	rendered := r.RenderHTML(`<p onclick="alert(1)">Fish &amp; chips</p><script>alert(2)</script><p>are   great</p>`)
+
+	require.Equal(t, "<p>Fish &amp; chips</p><p>are   great</p>", rendered.HTML)
+	require.Equal(t, "Fish & chips are great", rendered.Plaintext)
+}
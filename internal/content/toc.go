@@ -0,0 +1,148 @@
+package content
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/tuananhlai/brevity-go/internal/slug"
+)
+
+const (
+	// maxAnchorLength is the maximum length of the anchors of headings, before their deduplication suffix.
+	maxAnchorLength = 64
+	// fallbackAnchor is the anchor of headings without any letters or digits which can be written in ASCII.
+	fallbackAnchor = "section"
+)
+
+// Heading is an entry of the table of contents of a document.
+type Heading struct {
+	// Level is the level of the heading, from 1 for <h1> to 6 for <h6>.
+	Level int
+	// Text is the text of the heading without markup.
+	Text string
+	// ID is the anchor of the heading: the id attribute of its HTML element.
+	ID string
+}
+
+// assignHeadingIDs sets the id attribute of every heading of a Markdown AST to an anchor derived from its text,
+// e.g. "getting-started". Headings with the same text get a numeric suffix in document order, e.g.
+// "getting-started-2", so the anchors only depend on the document and stay the same when it is rendered again.
+func assignHeadingIDs(doc ast.Node, source []byte) {
+	used := map[string]bool{}
+
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		base := slug.NormalizeASCII(headingText(heading, source), maxAnchorLength)
+		if base == "" {
+			base = fallbackAnchor
+		}
+		id := base
+		for i := 2; used[id]; i++ {
+			id = base + "-" + strconv.Itoa(i)
+		}
+		used[id] = true
+
+		heading.SetAttributeString("id", []byte(id))
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// headingText returns the text of a heading without markup.
+func headingText(heading *ast.Heading, source []byte) string {
+	var b bytes.Buffer
+	_ = ast.Walk(heading, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Text:
+			b.Write(n.Value(source))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				b.WriteByte(' ')
+			}
+		case *ast.String:
+			b.Write(n.Value)
+		case *ast.AutoLink:
+			b.Write(n.Label(source))
+		case *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+
+	return b.String()
+}
+
+// TableOfContents returns the headings of an HTML document which have an id attribute, in document order.
+// Headings rendered from Markdown always have one. It returns an empty slice if the document has no such
+// headings.
+func TableOfContents(document string) []Heading {
+	headings := []Heading{}
+
+	var current *Heading
+	var text strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(document))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			// The document is sanitized, so the only error is the end of the document.
+			return headings
+		case html.StartTagToken:
+			name, hasAttr := tokenizer.TagName()
+			level := headingLevel(atom.Lookup(name))
+			if current != nil || level == 0 || !hasAttr {
+				continue
+			}
+			for {
+				key, value, more := tokenizer.TagAttr()
+				if string(key) == "id" && len(value) > 0 {
+					current = &Heading{Level: level, ID: string(value)}
+					text.Reset()
+				}
+				if !more {
+					break
+				}
+			}
+		case html.TextToken:
+			if current != nil {
+				text.Write(tokenizer.Text())
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			if current != nil && headingLevel(atom.Lookup(name)) == current.Level {
+				current.Text = strings.Join(strings.Fields(text.String()), " ")
+				headings = append(headings, *current)
+				current = nil
+			}
+		}
+	}
+}
+
+// headingLevel returns the level of a heading element, or 0 if the element is not a heading.
+func headingLevel(a atom.Atom) int {
+	switch a {
+	case atom.H1:
+		return 1
+	case atom.H2:
+		return 2
+	case atom.H3:
+		return 3
+	case atom.H4:
+		return 4
+	case atom.H5:
+		return 5
+	case atom.H6:
+		return 6
+	}
+	return 0
+}
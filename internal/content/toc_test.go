@@ -0,0 +1,49 @@
+package content_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/content"
+)
+
+func TestRender_HeadingIDs(t *testing.T) {
+	r := content.NewRenderer()
+
+	rendered, err := r.Render("# Getting *Started*\n\n## Setup\n\n## Setup\n\n### Tiếng [Việt](https://example.com)\n\n## !!!\n")
+	require.NoError(t, err)
+
+	require.Contains(t, rendered.HTML, `<h1 id="getting-started">Getting <em>Started</em></h1>`)
+	require.Contains(t, rendered.HTML, `<h2 id="setup">Setup</h2>`)
+	require.Contains(t, rendered.HTML, `<h2 id="setup-2">Setup</h2>`)
+	require.Contains(t, rendered.HTML, `<h3 id="tieng-viet">`)
+	require.Contains(t, rendered.HTML, `<h2 id="section">!!!</h2>`)
+
+	again, err := r.Render("# Getting *Started*\n\n## Setup\n\n## Setup\n\n### Tiếng [Việt](https://example.com)\n\n## !!!\n")
+	require.NoError(t, err)
+	require.Equal(t, rendered.HTML, again.HTML)
+}
+
+func TestTableOfContents(t *testing.T) {
+	r := content.NewRenderer()
+
+	rendered, err := r.Render("# Go & Rust\n\nIntro.\n\n## Setup\n\n### `go install`\n\n## Setup\n")
+	require.NoError(t, err)
+
+	require.Equal(t, []content.Heading{
+		{Level: 1, Text: "Go & Rust", ID: "go-rust"},
+		{Level: 2, Text: "Setup", ID: "setup"},
+		{Level: 3, Text: "go install", ID: "go-install"},
+		{Level: 2, Text: "Setup", ID: "setup-2"},
+	}, content.TableOfContents(rendered.HTML))
+}
+
+func TestTableOfContents_HeadingsWithoutID(t *testing.T) {
+	require.Equal(t, []content.Heading{
+		{Level: 2, Text: "Kept", ID: "kept"},
+	}, content.TableOfContents("<h1>Legacy</h1><p>Text</p><h2 id=\"kept\">\n  Kept\n</h2><h3 id=\"\">Empty</h3>"))
+
+	require.Empty(t, content.TableOfContents("<p>No headings</p>"))
+	require.NotNil(t, content.TableOfContents(""))
+}
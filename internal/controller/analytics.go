@@ -0,0 +1,265 @@
+package controller
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const (
+	CodeInvalidDateRange ErrorCode = "invalid_date_range"
+)
+
+const (
+	// defaultAnalyticsDays is the number of days in the analytics range if it is not given, including today.
+	defaultAnalyticsDays = 30
+	// maxAnalyticsDays is the maximum number of days in the analytics range.
+	maxAnalyticsDays = 366
+)
+
+// AnalyticsStore defines the store methods used by the analytics controller.
+type AnalyticsStore interface {
+	RecordArticleEvent(ctx context.Context, params store.RecordArticleEventParams) (bool, error)
+	GetDigitalAuthorAnalytics(ctx context.Context, params store.GetDigitalAuthorAnalyticsParams) (*store.AuthorAnalytics, error)
+}
+
+// AnalyticsController records how visitors read articles, and reports it to the owners of the digital authors.
+type AnalyticsController struct {
+	store AnalyticsStore
+	// dedupWindow is the length of the windows in which only the first event of each visitor and type is recorded.
+	// The windows are fixed buckets which start at multiples of their length, not sliding windows: two events
+	// which straddle the start of a window are both recorded. It must be positive.
+	dedupWindow time.Duration
+	// visitorHashSecret keys the hashes of the visitors, so that the IP addresses of anonymous visitors cannot be
+	// recovered from the analytics.
+	visitorHashSecret []byte
+}
+
+func NewAnalyticsController(store AnalyticsStore, dedupWindow time.Duration,
+	visitorHashSecret []byte) *AnalyticsController {
+	return &AnalyticsController{store: store, dedupWindow: dedupWindow, visitorHashSecret: visitorHashSecret}
+}
+
+// RecordEvent records a view or read completion event of a visitor on a published article. Repeated events of
+// the same visitor in a deduplication window are accepted but not recorded.
+func (c *AnalyticsController) RecordEvent(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "AnalyticsController.RecordEvent")
+	defer span.End()
+
+	var uri ArticleEventURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req ArticleEventRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	readSeconds := 0
+	if req.Type == string(store.ArticleEventReadCompletion) {
+		readSeconds = req.ReadSeconds
+	}
+	now := time.Now()
+	recorded, err := c.store.RecordArticleEvent(ctx, store.RecordArticleEventParams{
+		Slug:        uri.Slug,
+		VisitorHash: c.visitorHash(ginCtx, span),
+		Type:        store.ArticleEventType(req.Type),
+		ReadSeconds: readSeconds,
+		OccurredAt:  now,
+		WindowStart: now.Truncate(c.dedupWindow),
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusAccepted, ArticleEventResponse{Recorded: recorded})
+}
+
+// visitorHash identifies the visitor who sent the request: the signed-in user, or else the IP address of the
+// client. Nothing chosen by the client, such as a header or a field of the request, is part of the identity, so
+// that a client cannot be counted as many visitors. The IP address is only trusted from the proxies configured on
+// the router. Only a keyed hash is stored, so that visitors cannot be identified from the analytics.
+func (c *AnalyticsController) visitorHash(ginCtx *gin.Context, span trace.Span) string {
+	var visitor string
+	if userID := getOptionalContextUserUUID(ginCtx, span); userID != uuid.Nil {
+		visitor = "user:" + userID.String()
+	} else {
+		visitor = "client:" + ginCtx.ClientIP()
+	}
+
+	mac := hmac.New(sha256.New, c.visitorHashSecret)
+	mac.Write([]byte(visitor))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GetAuthorAnalytics returns the views, unique readers, average read time and completion rate of the articles
+// of a digital author owned by the current user, over a date range which defaults to the last 30 days.
+func (c *AnalyticsController) GetAuthorAnalytics(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "AnalyticsController.GetAuthorAnalytics")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri AuthorAnalyticsURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req AuthorAnalyticsRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The binding has validated the dates, so they can be parsed without errors.
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.To != "" {
+		to, _ = time.Parse(time.DateOnly, req.To)
+	}
+	from := to.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	if req.From != "" {
+		from, _ = time.Parse(time.DateOnly, req.From)
+	}
+	if from.After(to) || to.Sub(from) >= maxAnalyticsDays*24*time.Hour {
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeInvalidDateRange,
+				Message: "from must not be after to, and the range must not be longer than 366 days",
+			},
+			Span: span,
+		})
+		return
+	}
+
+	analytics, err := c.store.GetDigitalAuthorAnalytics(ctx, store.GetDigitalAuthorAnalyticsParams{
+		AuthorID: uuid.MustParse(uri.ID),
+		OwnerID:  userID,
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrDigitalAuthorNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeDigitalAuthorNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := AuthorAnalyticsResponse{
+		From:             from.Format(time.DateOnly),
+		To:               to.Format(time.DateOnly),
+		AnalyticsMetrics: newAnalyticsMetrics(analytics.Views, analytics.UniqueReaders, analytics.Completions, analytics.ReadSeconds),
+		Days:             make([]DailyAnalytics, len(analytics.Days)),
+	}
+	for i, day := range analytics.Days {
+		response.Days[i] = DailyAnalytics{
+			Date:             day.Day.Format(time.DateOnly),
+			AnalyticsMetrics: newAnalyticsMetrics(day.Views, day.UniqueReaders, day.Completions, day.ReadSeconds),
+		}
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+func newAnalyticsMetrics(views, uniqueReaders, completions int, readSeconds int64) AnalyticsMetrics {
+	metrics := AnalyticsMetrics{
+		Views:         views,
+		UniqueReaders: uniqueReaders,
+		Completions:   completions,
+	}
+	if completions > 0 {
+		metrics.AverageReadSeconds = float64(readSeconds) / float64(completions)
+	}
+	if views > 0 {
+		metrics.CompletionRate = min(float64(completions)/float64(views), 1)
+	}
+	return metrics
+}
+
+type ArticleEventURI struct {
+	Slug string `uri:"slug"`
+}
+
+type ArticleEventRequest struct {
+	// Type is either "view" or "read_completion".
+	Type string `json:"type" binding:"required,oneof=view read_completion"`
+	// ReadSeconds is the time the visitor took to read the article. It only applies to read completions.
+	ReadSeconds int `json:"readSeconds" binding:"min=0,max=86400"`
+}
+
+type ArticleEventResponse struct {
+	// Recorded is false if the event was a duplicate of an event already recorded in the same window.
+	Recorded bool `json:"recorded"`
+}
+
+type AuthorAnalyticsURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type AuthorAnalyticsRequest struct {
+	// From and To are the first and last days of the range in UTC, inclusive, in the YYYY-MM-DD format.
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To   string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type AnalyticsMetrics struct {
+	Views int `json:"views"`
+	// UniqueReaders is the number of distinct visitors, who are counted once even if they came back.
+	UniqueReaders int `json:"uniqueReaders"`
+	Completions   int `json:"completions"`
+	// AverageReadSeconds is the average time the visitors took to read an article to the end.
+	AverageReadSeconds float64 `json:"averageReadSeconds"`
+	// CompletionRate is the ratio of the read completions to the views, between 0 and 1.
+	CompletionRate float64 `json:"completionRate"`
+}
+
+type AuthorAnalyticsResponse struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	AnalyticsMetrics
+	Days []DailyAnalytics `json:"days"`
+}
+
+type DailyAnalytics struct {
+	Date string `json:"date"`
+	AnalyticsMetrics
+}
@@ -0,0 +1,201 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestAnalyticsController(t *testing.T) {
+	suite.Run(t, new(AnalyticsControllerTestSuite))
+}
+
+type AnalyticsControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockAnalyticsStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *AnalyticsControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *AnalyticsControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockAnalyticsStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	// Like the server, trust no proxy, so that the IP address of the connection identifies anonymous visitors.
+	s.Require().NoError(s.router.SetTrustedProxies(nil))
+	ctrl := controller.NewAnalyticsController(s.mockStore, 30*time.Minute, []byte("test-visitor-hash-secret"))
+	s.router.POST("/v1/articles/:slug/events", controller.OptionalAuthMiddleware(s.tokenIssuer), ctrl.RecordEvent)
+	s.router.GET("/v1/digital-authors/:id/analytics", controller.AuthMiddleware(s.tokenIssuer), ctrl.GetAuthorAnalytics)
+}
+
+func (s *AnalyticsControllerTestSuite) TestRecordEvent_View() {
+	var params store.RecordArticleEventParams
+	s.mockStore.On("RecordArticleEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { params = args.Get(1).(store.RecordArticleEventParams) }).
+		Return(true, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/events", strings.NewReader(`{"type": "view", "readSeconds": 120}`)))
+
+	s.Require().Equal(http.StatusAccepted, w.Code)
+	s.Require().True(gjson.Get(w.Body.String(), "recorded").Bool())
+	s.Require().Equal("go-generics", params.Slug)
+	s.Require().Equal(store.ArticleEventView, params.Type)
+	// Read time is only recorded for read completions.
+	s.Require().Zero(params.ReadSeconds)
+	s.Require().Len(params.VisitorHash, 64)
+	s.Require().Equal(params.OccurredAt.Truncate(30*time.Minute), params.WindowStart)
+}
+
+func (s *AnalyticsControllerTestSuite) TestRecordEvent_SameVisitorSameHash() {
+	hashes := s.recordAnonymousEvents([]anonymousEvent{
+		{remoteAddr: "203.0.113.7:40000", body: `{"type": "read_completion", "readSeconds": 120}`},
+		{remoteAddr: "203.0.113.7:40001", body: `{"type": "read_completion", "readSeconds": 90}`},
+		{remoteAddr: "198.51.100.2:40000", body: `{"type": "read_completion", "readSeconds": 90}`},
+	})
+
+	s.Require().Equal(hashes[0], hashes[1])
+	s.Require().NotEqual(hashes[0], hashes[2])
+}
+
+func (s *AnalyticsControllerTestSuite) TestRecordEvent_ClientChosenIdentityIgnored() {
+	hashes := s.recordAnonymousEvents([]anonymousEvent{
+		{
+			remoteAddr: "203.0.113.7:40000",
+			body:       `{"type": "view", "visitorID": "` + uuid.NewString() + `"}`,
+			header:     http.Header{"X-Forwarded-For": {"192.0.2.1"}, "User-Agent": {"Firefox"}},
+		},
+		{
+			remoteAddr: "203.0.113.7:40000",
+			body:       `{"type": "view", "visitorID": "` + uuid.NewString() + `"}`,
+			header:     http.Header{"X-Forwarded-For": {"192.0.2.2"}, "User-Agent": {"Chrome"}},
+		},
+	})
+
+	// Both events come from the same client, so the second one is deduplicated with the first one.
+	s.Require().Equal(hashes[0], hashes[1])
+}
+
+type anonymousEvent struct {
+	remoteAddr string
+	body       string
+	header     http.Header
+}
+
+// recordAnonymousEvents records events of anonymous visitors and returns their visitor hashes.
+func (s *AnalyticsControllerTestSuite) recordAnonymousEvents(events []anonymousEvent) []string {
+	var hashes []string
+	s.mockStore.On("RecordArticleEvent", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			hashes = append(hashes, args.Get(1).(store.RecordArticleEventParams).VisitorHash)
+		}).
+		Return(false, nil)
+
+	for _, event := range events {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", "/v1/articles/go-generics/events", strings.NewReader(event.body))
+		s.Require().NoError(err)
+		req.RemoteAddr = event.remoteAddr
+		for key, values := range event.header {
+			req.Header[key] = values
+		}
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusAccepted, w.Code)
+		s.Require().False(gjson.Get(w.Body.String(), "recorded").Bool())
+	}
+
+	s.Require().Len(hashes, len(events))
+	return hashes
+}
+
+func (s *AnalyticsControllerTestSuite) TestRecordEvent_InvalidType() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/events", strings.NewReader(`{"type": "click"}`)))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *AnalyticsControllerTestSuite) TestRecordEvent_ArticleNotFound() {
+	s.mockStore.On("RecordArticleEvent", mock.Anything, mock.Anything).Return(false, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/unknown/events", strings.NewReader(`{"type": "view"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+}
+
+func (s *AnalyticsControllerTestSuite) TestGetAuthorAnalytics_Success() {
+	authorID := uuid.New()
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	s.mockStore.On("GetDigitalAuthorAnalytics", mock.Anything, store.GetDigitalAuthorAnalyticsParams{
+		AuthorID: authorID,
+		OwnerID:  s.userID,
+		From:     from,
+		To:       to,
+	}).Return(&store.AuthorAnalytics{
+		Views:         10,
+		UniqueReaders: 6,
+		Completions:   4,
+		ReadSeconds:   600,
+		Days: []store.DailyAnalytics{
+			{Day: from, Views: 10, UniqueReaders: 6, Completions: 4, ReadSeconds: 600},
+			{Day: to},
+		},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET",
+		"/v1/digital-authors/"+authorID.String()+"/analytics?from=2026-10-01&to=2026-10-02", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(10), gjson.Get(res, "views").Int())
+	s.Require().Equal(int64(6), gjson.Get(res, "uniqueReaders").Int())
+	s.Require().Equal(150.0, gjson.Get(res, "averageReadSeconds").Float())
+	s.Require().Equal(0.4, gjson.Get(res, "completionRate").Float())
+	s.Require().Len(gjson.Get(res, "days").Array(), 2)
+	s.Require().Equal("2026-10-02", gjson.Get(res, "days.1.date").String())
+	s.Require().Equal(0.0, gjson.Get(res, "days.1.completionRate").Float())
+}
+
+func (s *AnalyticsControllerTestSuite) TestGetAuthorAnalytics_InvalidRange() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET",
+		"/v1/digital-authors/"+uuid.NewString()+"/analytics?from=2026-10-02&to=2026-10-01", nil))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidDateRange), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *AnalyticsControllerTestSuite) TestGetAuthorAnalytics_NotOwner() {
+	s.mockStore.On("GetDigitalAuthorAnalytics", mock.Anything, mock.Anything).Return(nil, store.ErrDigitalAuthorNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/digital-authors/"+uuid.NewString()+"/analytics", nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeDigitalAuthorNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
@@ -2,66 +2,176 @@ package controller
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"html"
 	"net/http"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 
+	"github.com/tuananhlai/brevity-go/internal/content"
 	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
 )
 
 const (
-	CodeArticleNotFound ErrorCode = "article_not_found"
+	CodeArticleNotFound  ErrorCode = "article_not_found"
+	CodeInvalidPageToken ErrorCode = "invalid_page_token"
+)
+
+const (
+	defaultPreviewsPageSize = 50
+	defaultSearchPageSize   = 20
 )
 
 // ArticleStore defines the store methods used by the article controller.
 type ArticleStore interface {
 	CreateArticle(ctx context.Context, article *store.Article) error
-	ListArticlesPreviews(ctx context.Context) ([]store.ArticlePreview, error)
-	GetArticleBySlug(ctx context.Context, slug string) (*store.ArticleDetails, error)
+	ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)
+	GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error)
+	GetArticleSlugRedirect(ctx context.Context, slug string) (string, error)
+	GetArticlesLastUpdatedAt(ctx context.Context, authorID uuid.UUID) (time.Time, error)
+	SearchArticles(ctx context.Context, params store.SearchArticlesParams) ([]store.ArticleSearchResult, error)
+	ClapArticle(ctx context.Context, params store.ClapArticleParams) (*store.ArticleClaps, error)
+	ListArticleRevisions(ctx context.Context, slug string) ([]store.ArticleRevisionSummary, error)
+	GetArticleRevision(ctx context.Context, slug string, number int) (*store.ArticleRevision, error)
 }
 
 type ArticleController struct {
 	store ArticleStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
 }
 
-func NewArticleController(store ArticleStore) *ArticleController {
-	return &ArticleController{store: store}
+func NewArticleController(store ArticleStore, pageTokenSecret []byte) *ArticleController {
+	return &ArticleController{store: store, pageTokenSecret: pageTokenSecret}
 }
 
 func (c *ArticleController) ListPreviews(ginCtx *gin.Context) {
 	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleController.ListPreviews")
 	defer span.End()
 
-	articles, err := c.store.ListArticlesPreviews(ctx)
+	var req ListPreviewsRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	params := store.ListArticlesPreviewsParams{
+		TagSlug:  req.Tag,
+		ViewerID: getOptionalContextUserUUID(ginCtx, span),
+	}
+	// The newest order is the default, which is left empty in the params and the page tokens.
+	if req.OrderBy != string(store.ArticleOrderNewest) {
+		params.OrderBy = store.ArticleOrder(req.OrderBy)
+	}
+	if req.MaxReadingTime != nil {
+		params.MaxReadingMinutes = *req.MaxReadingTime
+	}
+
+	response, err := listPreviewsPage(ctx, c.store.ListArticlesPreviews, c.pageTokenSecret, req.PreviewsPageRequest, params)
 	if err != nil {
+		if errors.Is(err, utils.ErrInvalidPageToken) {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+
 		writeUnknownErrorResponse(ginCtx, span, err)
 		return
 	}
 
-	response := ListPreviewsResponse{
-		Items: make([]ArticlePreview, len(articles)),
+	lastUpdated, err := c.store.GetArticlesLastUpdatedAt(ctx, uuid.Nil)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
 	}
-	for i, article := range articles {
-		response.Items[i] = ArticlePreview{
-			ID:          article.ID,
-			Slug:        article.Slug,
-			Title:       article.Title,
-			Description: article.Description,
-			Author: ArticlePreviewAuthor{
-				ID:          article.AuthorID,
-				Username:    article.AuthorUsername,
-				DisplayName: article.AuthorDisplayName.String,
-				AvatarURL:   article.AuthorAvatarURL.String,
-			},
-			CreatedAt: article.CreatedAt,
-			UpdatedAt: article.UpdatedAt,
+
+	writeCachedJSON(ginCtx, newPreviewsCacheValidator(response, lastUpdated),
+		func() any { return response })
+}
+
+// newPreviewsCacheValidator returns the validators of a page of article previews, given the latest update time
+// of the articles which may be listed.
+func newPreviewsCacheValidator(response *ListPreviewsResponse, lastUpdated time.Time) *cacheValidator {
+	validator := newCollectionCacheValidator(lastUpdated)
+	validator.add(response.NextPageToken)
+	for _, item := range response.Items {
+		validator.addArticle(item.ID, item.UpdatedAt, item.Claps, item.ViewerClaps, item.Bookmarked, item.Author,
+			item.Tags)
+	}
+	return validator
+}
+
+// listPreviewsPage returns the page of article previews matching params which is requested by req.
+// If the page token in req is invalid, the returned error wraps utils.ErrInvalidPageToken.
+func listPreviewsPage(ctx context.Context, listPreviews listPreviewsFunc, pageTokenSecret []byte,
+	req PreviewsPageRequest, params store.ListArticlesPreviewsParams,
+) (*ListPreviewsResponse, error) {
+	pageSize := defaultPreviewsPageSize
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	// Fetch one extra item to find out whether there is a next page.
+	params.Limit = pageSize + 1
+	if req.PageToken != "" {
+		var pageToken listPreviewsPageToken
+		if err := utils.ParsePageToken(pageTokenSecret, req.PageToken, &pageToken); err != nil {
+			return nil, err
+		}
+		// A page token is only valid for the order that produced it.
+		if pageToken.OrderBy != params.OrderBy {
+			return nil, fmt.Errorf("%w: page token does not match the order", utils.ErrInvalidPageToken)
+		}
+		params.After = &store.ArticlePreviewCursor{
+			ClapCount:     pageToken.ClapCount,
+			TrendingScore: pageToken.TrendingScore,
+			PublishedAt:   pageToken.PublishedAt,
+			ID:            pageToken.ID,
 		}
 	}
-	ginCtx.JSON(http.StatusOK, response)
+
+	articles, err := listPreviews(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if len(articles) > pageSize {
+		articles = articles[:pageSize]
+		last := articles[len(articles)-1]
+		pageToken := listPreviewsPageToken{
+			OrderBy:     params.OrderBy,
+			PublishedAt: last.ListedAt(),
+			ID:          last.ID,
+		}
+		switch params.OrderBy {
+		case store.ArticleOrderMostClapped:
+			pageToken.ClapCount = last.ClapCount
+		case store.ArticleOrderTrending:
+			pageToken.TrendingScore = last.TrendingScore
+		}
+		nextPageToken, err = utils.GeneratePageToken(pageTokenSecret, pageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &ListPreviewsResponse{
+		Items:         make([]ArticlePreview, len(articles)),
+		NextPageToken: nextPageToken,
+	}
+	for i, article := range articles {
+		response.Items[i] = newArticlePreview(article)
+	}
+
+	return response, nil
 }
 
 func (c *ArticleController) GetBySlug(ginCtx *gin.Context) {
@@ -73,10 +183,28 @@ func (c *ArticleController) GetBySlug(ginCtx *gin.Context) {
 		writeBindingErrorResponse(ginCtx, span, err)
 		return
 	}
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
 
-	article, err := c.store.GetArticleBySlug(ctx, req.Slug)
+	article, err := c.store.GetArticleBySlug(ctx, req.Slug, getOptionalContextUserUUID(ginCtx, span))
 	if err != nil {
 		if errors.Is(err, store.ErrArticleNotFound) {
+			// The slug might have been used by the article before it was renamed.
+			currentSlug, redirectErr := c.store.GetArticleSlugRedirect(ctx, req.Slug)
+			if redirectErr == nil {
+				location := *ginCtx.Request.URL
+				location.Path = path.Join(path.Dir(location.Path), currentSlug)
+				location.RawPath = ""
+				ginCtx.Redirect(http.StatusMovedPermanently, location.String())
+				return
+			}
+			if !errors.Is(redirectErr, store.ErrArticleNotFound) {
+				writeUnknownErrorResponse(ginCtx, span, redirectErr)
+				return
+			}
+
 			writeErrorResponse(ginCtx, writeErrorResponseParams{
 				Body: ErrorResponse{
 					Code:    CodeArticleNotFound,
@@ -92,31 +220,196 @@ func (c *ArticleController) GetBySlug(ginCtx *gin.Context) {
 		return
 	}
 
-	response := GetBySlugResponse{
-		ID:      article.ID,
-		Slug:    article.Slug,
-		Title:   article.Title,
-		Content: article.Content,
+	validator := newCacheValidator()
+	validator.add(req.Format)
+	validator.addArticle(article.ID, article.UpdatedAt, article.ClapCount, article.ViewerClapCount,
+		article.ViewerBookmarked, article.AuthorDisplayName, article.Tags, article.ArticleSeriesInfo)
+	writeCachedJSON(ginCtx, validator, func() any { return newGetBySlugResponse(article, req.Format) })
+}
+
+// newGetBySlugResponse converts an article into a response. format is the requested content format,
+// the source format of the article is used if it is empty.
+func newGetBySlugResponse(article *store.ArticleDetails, format string) GetBySlugResponse {
+	contentFormat, content := article.ContentFormat, article.Content
+	if format == string(store.ContentFormatHTML) {
+		contentFormat, content = store.ContentFormatHTML, article.HTMLContent
+	}
+
+	return GetBySlugResponse{
+		ID:            article.ID,
+		Slug:          article.Slug,
+		Title:         article.Title,
+		Content:       content,
+		ContentFormat: string(contentFormat),
+		TOC:           newTOC(article.HTMLContent),
+		Tags:          newTags(article.Tags),
 		Author: GetBySlugResponseAuthor{
 			ID:          article.AuthorID,
 			Username:    article.AuthorUsername,
 			DisplayName: article.AuthorDisplayName.String,
 			AvatarURL:   article.AuthorAvatarURL.String,
 		},
-		CreatedAt: article.CreatedAt,
-		UpdatedAt: article.UpdatedAt,
+		Status:           string(article.Status),
+		PublishedAt:      nullTimePtr(article.PublishedAt),
+		WordCount:        article.WordCount,
+		ReadingTime:      article.ReadingMinutes,
+		ReadabilityGrade: article.ReadabilityGrade,
+		Claps:            article.ClapCount,
+		ViewerClaps:      article.ViewerClapCount,
+		Bookmarked:       article.ViewerBookmarked,
+		Series:           newArticleSeries(article.ArticleSeriesInfo),
+		CreatedAt:        article.CreatedAt,
+		UpdatedAt:        article.UpdatedAt,
+	}
+}
+
+// newTOC returns the table of contents of an article from its HTML content.
+func newTOC(htmlContent string) []TOCEntry {
+	headings := content.TableOfContents(htmlContent)
+	toc := make([]TOCEntry, len(headings))
+	for i, heading := range headings {
+		toc[i] = TOCEntry{Level: heading.Level, Text: heading.Text, ID: heading.ID}
+	}
+	return toc
+}
+
+// Search returns articles matching a full-text query, most relevant first.
+func (c *ArticleController) Search(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleController.Search")
+	defer span.End()
+
+	var req SearchRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	pageSize := defaultSearchPageSize
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	params := store.SearchArticlesParams{
+		Query:    req.Query,
+		ViewerID: getOptionalContextUserUUID(ginCtx, span),
+		Limit:    pageSize + 1,
+	}
+	if req.PageToken != "" {
+		var pageToken searchPageToken
+		if err := utils.ParsePageToken(c.pageTokenSecret, req.PageToken, &pageToken); err != nil {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+		// A page token is only valid for the query that produced it.
+		if pageToken.Query != req.Query {
+			writeInvalidPageTokenResponse(ginCtx, span, errors.New("page token does not match the search query"))
+			return
+		}
+		params.After = &store.ArticleSearchCursor{
+			Rank: pageToken.Rank,
+			ID:   pageToken.ID,
+		}
+	}
+
+	results, err := c.store.SearchArticles(ctx, params)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	var nextPageToken string
+	if len(results) > pageSize {
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		nextPageToken, err = utils.GeneratePageToken(c.pageTokenSecret, searchPageToken{
+			Query: req.Query,
+			Rank:  last.Rank,
+			ID:    last.ID,
+		})
+		if err != nil {
+			writeUnknownErrorResponse(ginCtx, span, err)
+			return
+		}
+	}
+
+	response := SearchResponse{
+		Items:         make([]ArticleSearchResult, len(results)),
+		NextPageToken: nextPageToken,
+	}
+	for i, result := range results {
+		response.Items[i] = ArticleSearchResult{
+			ArticlePreview: newArticlePreview(result.ArticlePreview),
+			Highlight:      formatSearchHighlight(result.Highlight),
+		}
 	}
 	ginCtx.JSON(http.StatusOK, response)
 }
 
+// formatSearchHighlight converts a search highlight from the store into an HTML snippet, where matched terms
+// are wrapped in <mark> tags. Everything else in the snippet is escaped.
+func formatSearchHighlight(highlight string) string {
+	escaped := html.EscapeString(highlight)
+	return strings.NewReplacer(
+		store.SearchHighlightStart, "<mark>",
+		store.SearchHighlightStop, "</mark>",
+	).Replace(escaped)
+}
+
+func newArticlePreview(article store.ArticlePreview) ArticlePreview {
+	return ArticlePreview{
+		ID:          article.ID,
+		Slug:        article.Slug,
+		Title:       article.Title,
+		Description: article.Description,
+		Tags:        newTags(article.Tags),
+		Author: ArticlePreviewAuthor{
+			ID:          article.AuthorID,
+			Username:    article.AuthorUsername,
+			DisplayName: article.AuthorDisplayName.String,
+			AvatarURL:   article.AuthorAvatarURL.String,
+		},
+		Status:           string(article.Status),
+		PublishedAt:      nullTimePtr(article.PublishedAt),
+		WordCount:        article.WordCount,
+		ReadingTime:      article.ReadingMinutes,
+		ReadabilityGrade: article.ReadabilityGrade,
+		Claps:            article.ClapCount,
+		ViewerClaps:      article.ViewerClapCount,
+		Bookmarked:       article.ViewerBookmarked,
+		CreatedAt:        article.CreatedAt,
+		UpdatedAt:        article.UpdatedAt,
+	}
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
 type ArticlePreview struct {
 	ID          uuid.UUID            `json:"id"`
 	Slug        string               `json:"slug"`
 	Title       string               `json:"title"`
 	Description string               `json:"description"`
+	Tags        []Tag                `json:"tags"`
 	Author      ArticlePreviewAuthor `json:"author"`
-	CreatedAt   time.Time            `json:"createdAt"`
-	UpdatedAt   time.Time            `json:"updatedAt"`
+	Status      string               `json:"status"`
+	PublishedAt *time.Time           `json:"publishedAt,omitempty"`
+	WordCount   int                  `json:"wordCount"`
+	// ReadingTime is the estimated time to read the article in minutes.
+	ReadingTime int `json:"readingTime"`
+	// ReadabilityGrade is the Flesch-Kincaid grade level of the article. Lower is easier to read.
+	ReadabilityGrade float64 `json:"readabilityGrade"`
+	// Claps is the total number of claps given to the article.
+	Claps int `json:"claps"`
+	// ViewerClaps is the number of claps given by the current user. It is 0 for anonymous requests.
+	ViewerClaps int `json:"viewerClaps"`
+	// Bookmarked reports whether the current user bookmarked the article. It is false for anonymous requests.
+	Bookmarked bool      `json:"bookmarked"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
 type ArticlePreviewAuthor struct {
@@ -126,22 +419,112 @@ type ArticlePreviewAuthor struct {
 	AvatarURL   string    `json:"avatarURL,omitempty"`
 }
 
+// PreviewsPageRequest holds the pagination parameters of endpoints which list article previews.
+type PreviewsPageRequest struct {
+	PageToken string `form:"pageToken"`
+	PageSize  *int   `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+type ListPreviewsRequest struct {
+	PreviewsPageRequest
+	OrderBy string `form:"orderBy" binding:"omitempty,oneof=newest most_clapped trending"`
+	// Tag is an optional tag slug. If set, only articles with the tag are returned.
+	Tag string `form:"tag"`
+	// MaxReadingTime is an optional number of minutes. If set, only articles which can be read within it
+	// are returned.
+	MaxReadingTime *int `form:"maxReadingTime" binding:"omitempty,min=1"`
+}
+
+type listPreviewsFunc func(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)
+
 type ListPreviewsResponse struct {
 	Items []ArticlePreview `json:"items"`
+	// NextPageToken is empty if there are no more results.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// listPreviewsPageToken is the content of the page token returned by ListPreviews.
+// It holds the sort key of the last item in the current page.
+type listPreviewsPageToken struct {
+	// OrderBy is empty for the default order.
+	OrderBy store.ArticleOrder `json:"orderBy,omitempty"`
+	// ClapCount is only set when ordering by the number of claps.
+	ClapCount int `json:"clapCount,omitempty"`
+	// TrendingScore is only set when ordering by the trending score.
+	TrendingScore float64 `json:"trendingScore,omitempty"`
+	// PublishedAt is the publish time of the article, or its creation time if it is not approved yet.
+	PublishedAt time.Time `json:"publishedAt"`
+	ID          uuid.UUID `json:"id"`
+}
+
+type SearchRequest struct {
+	Query     string `form:"q" binding:"required,max=200"`
+	PageToken string `form:"pageToken"`
+	PageSize  *int   `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+type SearchResponse struct {
+	Items []ArticleSearchResult `json:"items"`
+	// NextPageToken is empty if there are no more results.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+type ArticleSearchResult struct {
+	ArticlePreview
+	// Highlight is an HTML snippet of the article content with matched terms wrapped in <mark> tags.
+	Highlight string `json:"highlight"`
+}
+
+// searchPageToken is the content of the page token returned by Search.
+type searchPageToken struct {
+	Query string    `json:"q"`
+	Rank  float64   `json:"rank"`
+	ID    uuid.UUID `json:"id"`
 }
 
 type GetBySlugRequest struct {
-	Slug string `uri:"slug"`
+	Slug string `uri:"slug" form:"-"`
+	// Format is the format of the returned content. If empty, the content is returned in its source format.
+	Format string `form:"format" binding:"omitempty,oneof=html markdown"`
 }
 
 type GetBySlugResponse struct {
-	ID        uuid.UUID               `json:"id"`
-	Slug      string                  `json:"slug"`
-	Title     string                  `json:"title"`
-	Content   string                  `json:"content"`
-	Author    GetBySlugResponseAuthor `json:"author"`
-	CreatedAt time.Time               `json:"createdAt"`
-	UpdatedAt time.Time               `json:"updatedAt"`
+	ID      uuid.UUID `json:"id"`
+	Slug    string    `json:"slug"`
+	Title   string    `json:"title"`
+	Content string    `json:"content"`
+	// ContentFormat is the format of Content, either "html" or "markdown".
+	ContentFormat string `json:"contentFormat"`
+	// TOC is the table of contents of the article, built from its headings in document order. The ID of each
+	// entry is the id attribute of its heading in the HTML content, so that sections can be linked to.
+	TOC         []TOCEntry              `json:"toc"`
+	Tags        []Tag                   `json:"tags"`
+	Author      GetBySlugResponseAuthor `json:"author"`
+	Status      string                  `json:"status"`
+	PublishedAt *time.Time              `json:"publishedAt,omitempty"`
+	WordCount   int                     `json:"wordCount"`
+	// ReadingTime is the estimated time to read the article in minutes.
+	ReadingTime int `json:"readingTime"`
+	// ReadabilityGrade is the Flesch-Kincaid grade level of the article. Lower is easier to read.
+	ReadabilityGrade float64 `json:"readabilityGrade"`
+	// Claps is the total number of claps given to the article.
+	Claps int `json:"claps"`
+	// ViewerClaps is the number of claps given by the current user. It is 0 for anonymous requests.
+	ViewerClaps int `json:"viewerClaps"`
+	// Bookmarked reports whether the current user bookmarked the article. It is false for anonymous requests.
+	Bookmarked bool `json:"bookmarked"`
+	// Series is the series the article is a part of. It is nil for standalone articles.
+	Series    *ArticleSeries `json:"series,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+type TOCEntry struct {
+	// Level is the level of the heading, from 1 to 6.
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	// ID is the anchor of the section, e.g. "getting-started".
+	ID string `json:"id"`
 }
 
 type GetBySlugResponseAuthor struct {
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// Clap adds claps of the current user to a published article. Each user can clap an article up to
+// store.MaxClapsPerUser times, extra claps are ignored.
+func (c *ArticleController) Clap(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleController.Clap")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ClapURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req ClapRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	claps, err := c.store.ClapArticle(ctx, store.ClapArticleParams{
+		Slug:   uri.Slug,
+		UserID: userID,
+		Count:  req.Count,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, ClapResponse{
+		Claps:       claps.ClapCount,
+		ViewerClaps: claps.ViewerClapCount,
+	})
+}
+
+type ClapURI struct {
+	Slug string `uri:"slug"`
+}
+
+type ClapRequest struct {
+	// Count is the number of claps to add.
+	Count int `json:"count" binding:"required,min=1,max=50"`
+}
+
+type ClapResponse struct {
+	// Claps is the total number of claps given to the article.
+	Claps int `json:"claps"`
+	// ViewerClaps is the number of claps given by the current user, up to store.MaxClapsPerUser.
+	ViewerClaps int `json:"viewerClaps"`
+}
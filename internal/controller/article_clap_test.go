@@ -0,0 +1,126 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func (s *ArticleControllerTestSuite) TestClap_Success() {
+	s.mockStore.On("ClapArticle", mock.Anything, store.ClapArticleParams{
+		Slug:   "go-generics",
+		UserID: s.userID,
+		Count:  5,
+	}).Return(&store.ArticleClaps{ClapCount: 42, ViewerClapCount: 5}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/claps", strings.NewReader(`{"count": 5}`)))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(42), gjson.Get(res, "claps").Int())
+	s.Require().Equal(int64(5), gjson.Get(res, "viewerClaps").Int())
+}
+
+func (s *ArticleControllerTestSuite) TestClap_TooManyClaps() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/claps", strings.NewReader(`{"count": 51}`)))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *ArticleControllerTestSuite) TestClap_Unauthorized() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/articles/go-generics/claps", strings.NewReader(`{"count": 1}`))
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (s *ArticleControllerTestSuite) TestClap_ArticleNotFound() {
+	s.mockStore.On("ClapArticle", mock.Anything, mock.Anything).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/unknown/claps", strings.NewReader(`{"count": 1}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_ViewerState() {
+	article := &store.ArticleDetails{
+		ID:               uuid.New(),
+		Slug:             "go-generics",
+		ClapCount:        42,
+		ViewerClapCount:  5,
+		ViewerBookmarked: true,
+	}
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, s.userID).Return(article, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/articles/go-generics", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(42), gjson.Get(res, "claps").Int())
+	s.Require().Equal(int64(5), gjson.Get(res, "viewerClaps").Int())
+	s.Require().True(gjson.Get(res, "bookmarked").Bool())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_MostClapped() {
+	previews := []store.ArticlePreview{
+		{ID: uuid.New(), Slug: "popular", ClapCount: 100},
+		{ID: uuid.New(), Slug: "less-popular", ClapCount: 10},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		ViewerID: s.userID,
+		OrderBy:  store.ArticleOrderMostClapped,
+		Limit:    2,
+	}).Return(previews, nil).Once()
+
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(time.Time{}, nil)
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/article-previews?orderBy=most_clapped&pageSize=1", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(100), gjson.Get(res, "items.0.claps").Int())
+	nextPageToken := gjson.Get(res, "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	// The next page must continue after the clap count of the last item.
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		ViewerID: s.userID,
+		OrderBy:  store.ArticleOrderMostClapped,
+		Limit:    2,
+		After: &store.ArticlePreviewCursor{
+			ClapCount: 100,
+			ID:        previews[0].ID,
+		},
+	}).Return(previews[1:], nil).Once()
+
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET",
+		"/v1/article-previews?orderBy=most_clapped&pageSize=1&pageToken="+nextPageToken, nil))
+	s.Require().Equal(http.StatusOK, w.Code)
+
+	// The page token cannot be used with another order.
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/article-previews?pageToken="+nextPageToken, nil))
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidPageToken), gjson.Get(w.Body.String(), "errorCode").String())
+}
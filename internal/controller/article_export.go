@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/export"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const (
+	exportFormatMarkdown = "md"
+	exportFormatEPUB     = "epub"
+	exportFormatHTML     = "html"
+)
+
+// ArticleExportStore defines the store methods used by the article export controller.
+type ArticleExportStore interface {
+	GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error)
+}
+
+// ArticleExportController lets readers download published articles, to read them offline.
+type ArticleExportController struct {
+	store ArticleExportStore
+	// baseURL is the URL of the website, which the exported articles link to.
+	baseURL string
+}
+
+func NewArticleExportController(store ArticleExportStore, baseURL string) *ArticleExportController {
+	return &ArticleExportController{store: store, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Export returns a published article as a file to download: a Markdown file with YAML front matter, an EPUB
+// book or a standalone HTML document.
+func (c *ArticleExportController) Export(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleExportController.Export")
+	defer span.End()
+
+	var uri ExportArticleURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req ExportArticleRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	details, err := c.store.GetArticleBySlug(ctx, uri.Slug, uuid.Nil)
+	if err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+	article := export.NewArticle(details, articlePageURL(c.baseURL, details.Slug))
+
+	var body []byte
+	var contentType, fileName string
+	switch req.Format {
+	case exportFormatEPUB:
+		var buf bytes.Buffer
+		err = export.EPUB(&buf, export.Book{
+			ID:          "urn:uuid:" + article.ID,
+			Title:       article.Title,
+			Author:      article.AuthorName,
+			Description: article.Description,
+			Articles:    []export.Article{article},
+		})
+		body, contentType, fileName = buf.Bytes(), "application/epub+zip", article.Slug+".epub"
+	case exportFormatHTML:
+		body, err = export.HTML(article)
+		contentType, fileName = "text/html; charset=utf-8", article.Slug+".html"
+	default:
+		body, err = export.Markdown(article)
+		contentType, fileName = "text/markdown; charset=utf-8", export.MarkdownFileName(article)
+	}
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	ginCtx.Data(http.StatusOK, contentType, body)
+}
+
+type ExportArticleURI struct {
+	Slug string `uri:"slug"`
+}
+
+type ExportArticleRequest struct {
+	// Format defaults to "md".
+	Format string `form:"format" binding:"omitempty,oneof=md epub html"`
+}
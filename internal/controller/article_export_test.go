@@ -0,0 +1,116 @@
+package controller_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleExportController(t *testing.T) {
+	suite.Run(t, new(ArticleExportControllerTestSuite))
+}
+
+type ArticleExportControllerTestSuite struct {
+	suite.Suite
+	mockStore *controller.MockArticleExportStore
+	router    *gin.Engine
+	article   *store.ArticleDetails
+}
+
+func (s *ArticleExportControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *ArticleExportControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockArticleExportStore(s.T())
+	s.router = gin.Default()
+	ctrl := controller.NewArticleExportController(s.mockStore, "https://brevity.example.com/")
+	s.router.GET("/v1/articles/:slug/export", ctrl.Export)
+
+	s.article = &store.ArticleDetails{
+		ID:                uuid.New(),
+		Slug:              "go-generics",
+		Title:             "Go Generics",
+		Content:           "# Go Generics\n\nType parameters.",
+		ContentFormat:     store.ContentFormatMarkdown,
+		HTMLContent:       "<h1>Go Generics</h1><p>Type parameters.</p>",
+		PublishedAt:       sql.NullTime{Time: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC), Valid: true},
+		UpdatedAt:         time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC),
+		AuthorDisplayName: sql.NullString{String: "Gopher Bot", Valid: true},
+		Tags:              store.TagList{{Name: "Go", Slug: "go"}},
+	}
+}
+
+func (s *ArticleExportControllerTestSuite) TestExport_Markdown() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "go-generics", uuid.Nil).Return(s.article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/export", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("text/markdown; charset=utf-8", w.Header().Get("Content-Type"))
+	s.Require().Equal(`attachment; filename=go-generics.md`, w.Header().Get("Content-Disposition"))
+	s.Require().Contains(w.Body.String(), "title: Go Generics\n")
+	s.Require().Contains(w.Body.String(), "url: https://brevity.example.com/articles/go-generics\n")
+	s.Require().Contains(w.Body.String(), "---\n\n# Go Generics\n\nType parameters.\n")
+}
+
+func (s *ArticleExportControllerTestSuite) TestExport_EPUB() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "go-generics", uuid.Nil).Return(s.article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/export?format=epub", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("application/epub+zip", w.Header().Get("Content-Type"))
+	s.Require().Equal(`attachment; filename=go-generics.epub`, w.Header().Get("Content-Disposition"))
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	s.Require().NoError(err)
+	s.Require().Equal("mimetype", zr.File[0].Name)
+}
+
+func (s *ArticleExportControllerTestSuite) TestExport_HTML() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "go-generics", uuid.Nil).Return(s.article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/export?format=html", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	s.Require().Contains(w.Body.String(), s.article.HTMLContent)
+}
+
+func (s *ArticleExportControllerTestSuite) TestExport_InvalidFormat() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/export?format=pdf", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *ArticleExportControllerTestSuite) TestExport_NotFound() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "draft", uuid.Nil).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/draft/export", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
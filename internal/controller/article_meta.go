@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/ogimage"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// siteName is the name of the website shown in link previews.
+const siteName = "Brevity"
+
+// ArticleMetaStore defines the store methods used by the article meta controller.
+type ArticleMetaStore interface {
+	GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error)
+}
+
+// ArticleMetaController serves the metadata and images which social networks and chat apps use to show a preview
+// of the links to published articles.
+type ArticleMetaController struct {
+	store    ArticleMetaStore
+	renderer *ogimage.Renderer
+	// baseURL is the URL of the website, where the pages of the articles are.
+	baseURL string
+	// apiBaseURL is the public URL of the API, where the preview images are.
+	apiBaseURL string
+}
+
+func NewArticleMetaController(store ArticleMetaStore, renderer *ogimage.Renderer, baseURL string,
+	apiBaseURL string) *ArticleMetaController {
+	return &ArticleMetaController{
+		store:      store,
+		renderer:   renderer,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiBaseURL: strings.TrimSuffix(apiBaseURL, "/"),
+	}
+}
+
+// Meta returns the Open Graph and Twitter card metadata of a published article, which the website adds to the
+// page of the article.
+func (c *ArticleMetaController) Meta(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleMetaController.Meta")
+	defer span.End()
+
+	article, ok := c.getArticle(ctx, ginCtx, span)
+	if !ok {
+		return
+	}
+
+	validator := newCacheValidator()
+	validator.addArticle(article.ID, article.UpdatedAt, article.AuthorDisplayName, article.Tags)
+	writeCachedJSON(ginCtx, validator, func() any { return c.newArticleMetaResponse(article) })
+}
+
+// OGImage returns the social preview image of a published article: a PNG card with its title, author and
+// reading time.
+func (c *ArticleMetaController) OGImage(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleMetaController.OGImage")
+	defer span.End()
+
+	article, ok := c.getArticle(ctx, ginCtx, span)
+	if !ok {
+		return
+	}
+
+	// The title and the reading time are updated along with the article, unlike the name of the author.
+	validator := newCacheValidator()
+	validator.addArticle(article.ID, article.UpdatedAt, article.AuthorDisplayName)
+	err := writeCachedData(ginCtx, validator, "image/png", func() ([]byte, error) {
+		return c.renderer.Render(newOGImageCard(article))
+	})
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+}
+
+// getArticle returns the published article of the request. If it fails, an error response is written and
+// false is returned.
+func (c *ArticleMetaController) getArticle(ctx context.Context, ginCtx *gin.Context,
+	span trace.Span) (*store.ArticleDetails, bool) {
+	var uri ArticleMetaURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return nil, false
+	}
+
+	article, err := c.store.GetArticleBySlug(ctx, uri.Slug, uuid.Nil)
+	if err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return nil, false
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return nil, false
+	}
+
+	return article, true
+}
+
+func newOGImageCard(article *store.ArticleDetails) ogimage.Card {
+	return ogimage.Card{
+		Title:          article.Title,
+		Author:         article.AuthorDisplayName.String,
+		ReadingMinutes: article.ReadingMinutes,
+	}
+}
+
+// ogImageURL returns the URL of the preview image of an article. The URL changes whenever the article is
+// updated, since social networks keep the images they fetched for a long time.
+func (c *ArticleMetaController) ogImageURL(article *store.ArticleDetails) string {
+	return c.apiBaseURL + "/v1/articles/" + url.PathEscape(article.Slug) + "/og-image.png?v=" +
+		strconv.FormatInt(article.UpdatedAt.Unix(), 10)
+}
+
+func (c *ArticleMetaController) newArticleMetaResponse(article *store.ArticleDetails) ArticleMetaResponse {
+	pageURL := articlePageURL(c.baseURL, article.Slug)
+	imageURL := c.ogImageURL(article)
+
+	meta := []MetaTag{
+		{Property: "og:type", Content: "article"},
+		{Property: "og:site_name", Content: siteName},
+		{Property: "og:title", Content: article.Title},
+	}
+	if article.Description != "" {
+		meta = append(meta, MetaTag{Property: "og:description", Content: article.Description})
+	}
+	meta = append(meta,
+		MetaTag{Property: "og:url", Content: pageURL},
+		MetaTag{Property: "og:image", Content: imageURL},
+		MetaTag{Property: "og:image:type", Content: "image/png"},
+		MetaTag{Property: "og:image:width", Content: strconv.Itoa(ogimage.Width)},
+		MetaTag{Property: "og:image:height", Content: strconv.Itoa(ogimage.Height)},
+		MetaTag{Property: "og:image:alt", Content: article.Title},
+	)
+	if article.PublishedAt.Valid {
+		meta = append(meta, MetaTag{
+			Property: "article:published_time",
+			Content:  article.PublishedAt.Time.UTC().Format(time.RFC3339),
+		})
+	}
+	meta = append(meta,
+		MetaTag{Property: "article:modified_time", Content: article.UpdatedAt.UTC().Format(time.RFC3339)},
+		MetaTag{Property: "article:author", Content: digitalAuthorPageURL(c.baseURL, article.AuthorID.String())},
+	)
+	for _, tag := range article.Tags {
+		meta = append(meta, MetaTag{Property: "article:tag", Content: tag.Name})
+	}
+	meta = append(meta,
+		MetaTag{Name: "twitter:card", Content: "summary_large_image"},
+		MetaTag{Name: "twitter:title", Content: article.Title},
+	)
+	if article.Description != "" {
+		meta = append(meta, MetaTag{Name: "twitter:description", Content: article.Description})
+	}
+	meta = append(meta,
+		MetaTag{Name: "twitter:image", Content: imageURL},
+		MetaTag{Name: "twitter:image:alt", Content: article.Title},
+	)
+
+	return ArticleMetaResponse{
+		Title:        article.Title,
+		Description:  article.Description,
+		CanonicalURL: pageURL,
+		ImageURL:     imageURL,
+		Meta:         meta,
+	}
+}
+
+type ArticleMetaURI struct {
+	Slug string `uri:"slug"`
+}
+
+type ArticleMetaResponse struct {
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	CanonicalURL string `json:"canonicalURL"`
+	ImageURL     string `json:"imageURL"`
+	// Meta are the <meta> elements to add to the head of the page of the article, in order.
+	Meta []MetaTag `json:"meta"`
+}
+
+// MetaTag is a <meta> element. Open Graph metadata is identified by Property, and other metadata, such as
+// Twitter cards, by Name.
+type MetaTag struct {
+	Property string `json:"property,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Content  string `json:"content"`
+}
@@ -0,0 +1,156 @@
+package controller_test
+
+import (
+	"bytes"
+	"database/sql"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/ogimage"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleMetaController(t *testing.T) {
+	suite.Run(t, new(ArticleMetaControllerTestSuite))
+}
+
+type ArticleMetaControllerTestSuite struct {
+	suite.Suite
+	mockStore *controller.MockArticleMetaStore
+	router    *gin.Engine
+	article   *store.ArticleDetails
+}
+
+func (s *ArticleMetaControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *ArticleMetaControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockArticleMetaStore(s.T())
+	s.router = gin.Default()
+	ctrl := controller.NewArticleMetaController(s.mockStore, ogimage.NewRenderer(10),
+		"https://brevity.example.com/", "https://api.brevity.example.com")
+	s.router.GET("/v1/articles/:slug/meta", ctrl.Meta)
+	s.router.GET("/v1/articles/:slug/og-image.png", ctrl.OGImage)
+
+	s.article = &store.ArticleDetails{
+		ID:                uuid.New(),
+		Slug:              "go-generics",
+		Title:             "Go Generics",
+		Description:       "Type parameters in practice.",
+		AuthorID:          uuid.New(),
+		AuthorDisplayName: sql.NullString{String: "Gopher Bot", Valid: true},
+		ReadingMinutes:    6,
+		PublishedAt:       sql.NullTime{Time: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC), Valid: true},
+		UpdatedAt:         time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC),
+		Tags:              store.TagList{{ID: uuid.New(), Slug: "go", Name: "Go"}},
+	}
+}
+
+// metaContent returns the content of the first <meta> element with the given property or name.
+func metaContent(body string, key string) gjson.Result {
+	return gjson.Get(body, `meta.#(property=="`+key+`").content`)
+}
+
+func (s *ArticleMetaControllerTestSuite) TestMeta() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "go-generics", uuid.Nil).Return(s.article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/meta", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	res := w.Body.String()
+	imageURL := "https://api.brevity.example.com/v1/articles/go-generics/og-image.png?v=1790931600"
+	s.Require().Equal("Go Generics", gjson.Get(res, "title").String())
+	s.Require().Equal("Type parameters in practice.", gjson.Get(res, "description").String())
+	s.Require().Equal("https://brevity.example.com/articles/go-generics", gjson.Get(res, "canonicalURL").String())
+	s.Require().Equal(imageURL, gjson.Get(res, "imageURL").String())
+
+	s.Require().Equal("article", metaContent(res, "og:type").String())
+	s.Require().Equal("Go Generics", metaContent(res, "og:title").String())
+	s.Require().Equal("Type parameters in practice.", metaContent(res, "og:description").String())
+	s.Require().Equal("https://brevity.example.com/articles/go-generics", metaContent(res, "og:url").String())
+	s.Require().Equal(imageURL, metaContent(res, "og:image").String())
+	s.Require().Equal("1200", metaContent(res, "og:image:width").String())
+	s.Require().Equal("630", metaContent(res, "og:image:height").String())
+	s.Require().Equal("2026-10-01T09:00:00Z", metaContent(res, "article:published_time").String())
+	s.Require().Equal("https://brevity.example.com/digital-authors/"+s.article.AuthorID.String(),
+		metaContent(res, "article:author").String())
+	s.Require().Equal("Go", metaContent(res, "article:tag").String())
+	s.Require().Equal("summary_large_image", gjson.Get(res, `meta.#(name=="twitter:card").content`).String())
+	s.Require().Equal(imageURL, gjson.Get(res, `meta.#(name=="twitter:image").content`).String())
+	s.Require().NotEmpty(w.Header().Get("ETag"))
+}
+
+func (s *ArticleMetaControllerTestSuite) TestMeta_WithoutDescription() {
+	s.article.Description = ""
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "go-generics", uuid.Nil).Return(s.article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/meta", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	res := w.Body.String()
+	s.Require().False(metaContent(res, "og:description").Exists())
+	s.Require().False(gjson.Get(res, `meta.#(name=="twitter:description")`).Exists())
+}
+
+func (s *ArticleMetaControllerTestSuite) TestMeta_NotFound() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "draft", uuid.Nil).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/draft/meta", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleMetaControllerTestSuite) TestOGImage() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "go-generics", uuid.Nil).Return(s.article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/og-image.png", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("image/png", w.Header().Get("Content-Type"))
+	img, err := png.Decode(bytes.NewReader(w.Body.Bytes()))
+	s.Require().NoError(err)
+	s.Require().Equal(ogimage.Width, img.Bounds().Dx())
+	s.Require().Equal(ogimage.Height, img.Bounds().Dy())
+
+	etag := w.Header().Get("ETag")
+	s.Require().NotEmpty(etag)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/articles/go-generics/og-image.png", nil)
+	req.Header.Set("If-None-Match", etag)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotModified, w.Code)
+	s.Require().Empty(w.Body.Bytes())
+}
+
+func (s *ArticleMetaControllerTestSuite) TestOGImage_NotFound() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "draft", uuid.Nil).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/draft/og-image.png", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
@@ -0,0 +1,335 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/slug"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+const (
+	CodeInvalidStatusTransition ErrorCode = "invalid_status_transition"
+	CodeInvalidPublishTime      ErrorCode = "invalid_publish_time"
+	CodeInvalidSlug             ErrorCode = "invalid_slug"
+	CodeArticleSlugTaken        ErrorCode = "article_slug_taken"
+)
+
+// ArticleReviewStore defines the store methods used by the article review controller.
+type ArticleReviewStore interface {
+	ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)
+	GetOwnedArticleBySlug(ctx context.Context, ownerID uuid.UUID, slug string) (*store.ArticleDetails, error)
+	UpdateArticleStatus(ctx context.Context, params store.UpdateArticleStatusParams) (*store.ArticleState, error)
+	DeleteOwnedArticle(ctx context.Context, ownerID uuid.UUID, slug string) error
+	UpdateArticleSlug(ctx context.Context, params store.UpdateArticleSlugParams) error
+}
+
+// ArticleReviewController lets users review the articles generated by the digital authors they own,
+// before the articles are visible to the public.
+type ArticleReviewController struct {
+	store ArticleReviewStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
+}
+
+func NewArticleReviewController(store ArticleReviewStore, pageTokenSecret []byte) *ArticleReviewController {
+	return &ArticleReviewController{store: store, pageTokenSecret: pageTokenSecret}
+}
+
+// ListOwnArticles lists the articles of the current user's digital authors in any status, most recently
+// published first. Articles which have not been approved are ordered by their creation time.
+func (c *ArticleReviewController) ListOwnArticles(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.ListOwnArticles")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req ListOwnArticlesRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response, err := listPreviewsPage(ctx, c.store.ListArticlesPreviews, c.pageTokenSecret, req.PreviewsPageRequest,
+		store.ListArticlesPreviewsParams{
+			OwnerID: userID,
+			Status:  store.ArticleStatus(req.Status),
+		})
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidPageToken) {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// GetOwnArticle returns an article of the current user's digital authors in any status, so that it can be reviewed.
+func (c *ArticleReviewController) GetOwnArticle(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.GetOwnArticle")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req GetBySlugRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	article, err := c.store.GetOwnedArticleBySlug(ctx, userID, req.Slug)
+	if err != nil {
+		writeReviewErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newGetBySlugResponse(article, req.Format))
+}
+
+// Approve publishes an article immediately. Archived articles which were public before are published again at
+// their original publish time.
+func (c *ArticleReviewController) Approve(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.Approve")
+	defer span.End()
+
+	c.updateStatus(ctx, ginCtx, span, store.UpdateArticleStatusParams{Status: store.ArticleStatusPublished})
+}
+
+// Reject archives an article which has not been published, instead of publishing it. Published articles are
+// taken off the website with Archive instead. A rejected article can still be published later with Approve.
+func (c *ArticleReviewController) Reject(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.Reject")
+	defer span.End()
+
+	c.updateStatus(ctx, ginCtx, span, store.UpdateArticleStatusParams{
+		Status: store.ArticleStatusArchived,
+		From:   []store.ArticleStatus{store.ArticleStatusDraft, store.ArticleStatusPendingReview, store.ArticleStatusScheduled},
+	})
+}
+
+// Archive takes a published article off the website, without deleting it. It can be published again with
+// Approve.
+func (c *ArticleReviewController) Archive(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.Archive")
+	defer span.End()
+
+	c.updateStatus(ctx, ginCtx, span, store.UpdateArticleStatusParams{
+		Status: store.ArticleStatusArchived,
+		From:   []store.ArticleStatus{store.ArticleStatusPublished},
+	})
+}
+
+// Schedule makes an article be published at a time in the future.
+func (c *ArticleReviewController) Schedule(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.Schedule")
+	defer span.End()
+
+	var req ScheduleArticleRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	if !req.PublishAt.After(time.Now()) {
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeInvalidPublishTime,
+				Message: "publishAt must be in the future",
+			},
+			Span: span,
+		})
+		return
+	}
+
+	c.updateStatus(ctx, ginCtx, span, store.UpdateArticleStatusParams{
+		Status:    store.ArticleStatusScheduled,
+		PublishAt: req.PublishAt,
+	})
+}
+
+// Delete deletes an article of the current user's digital authors in any status. The article disappears for
+// everyone, including the current user.
+func (c *ArticleReviewController) Delete(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.Delete")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req ReviewArticleRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.DeleteOwnedArticle(ctx, userID, req.Slug); err != nil {
+		writeReviewErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// Rename changes the slug of an article of the current user's digital authors. The previous slug keeps
+// redirecting to the article, and cannot be used by other articles.
+func (c *ArticleReviewController) Rename(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleReviewController.Rename")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ReviewArticleRequest
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req RenameArticleRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The new slug is normalized like generated slugs, but no suffix is added if it is taken.
+	newSlug := slug.NormalizeASCII(req.Slug, slug.MaxLength)
+	if newSlug == "" {
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeInvalidSlug,
+				Message: "slug must contain letters or digits",
+			},
+			Span: span,
+		})
+		return
+	}
+
+	article, err := c.store.GetOwnedArticleBySlug(ctx, userID, uri.Slug)
+	if err != nil {
+		writeReviewErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	err = c.store.UpdateArticleSlug(ctx, store.UpdateArticleSlugParams{ID: article.ID, Slug: newSlug})
+	if err != nil {
+		writeReviewErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, ArticleSlugResponse{Slug: newSlug})
+}
+
+func (c *ArticleReviewController) updateStatus(ctx context.Context, ginCtx *gin.Context, span trace.Span,
+	params store.UpdateArticleStatusParams,
+) {
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req ReviewArticleRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	params.Slug = req.Slug
+	params.OwnerID = userID
+	state, err := c.store.UpdateArticleStatus(ctx, params)
+	if err != nil {
+		writeReviewErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, ArticleStateResponse{
+		Status:      string(state.Status),
+		PublishedAt: nullTimePtr(state.PublishedAt),
+	})
+}
+
+func writeReviewErrorResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	switch {
+	case errors.Is(err, store.ErrArticleNotFound):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeArticleNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+	case errors.Is(err, store.ErrInvalidStatusTransition):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeInvalidStatusTransition,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusConflict,
+		})
+	case errors.Is(err, store.ErrArticleSlugTaken):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeArticleSlugTaken,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusConflict,
+		})
+	default:
+		writeUnknownErrorResponse(ginCtx, span, err)
+	}
+}
+
+type ListOwnArticlesRequest struct {
+	PreviewsPageRequest
+	Status string `form:"status" binding:"omitempty,oneof=draft pending_review scheduled published archived"`
+}
+
+type ReviewArticleRequest struct {
+	Slug string `uri:"slug"`
+}
+
+type ScheduleArticleRequest struct {
+	PublishAt time.Time `json:"publishAt" binding:"required"`
+}
+
+type RenameArticleRequest struct {
+	Slug string `json:"slug" binding:"required"`
+}
+
+type ArticleSlugResponse struct {
+	Slug string `json:"slug"`
+}
+
+type ArticleStateResponse struct {
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+}
@@ -0,0 +1,289 @@
+package controller_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestArticleReviewController(t *testing.T) {
+	suite.Run(t, new(ArticleReviewControllerTestSuite))
+}
+
+type ArticleReviewControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockArticleReviewStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *ArticleReviewControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *ArticleReviewControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockArticleReviewStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewArticleReviewController(s.mockStore, testPageTokenSecret)
+	authMiddleware := controller.AuthMiddleware(s.tokenIssuer)
+	s.router.GET("/v1/me/articles", authMiddleware, ctrl.ListOwnArticles)
+	s.router.GET("/v1/me/articles/:slug", authMiddleware, ctrl.GetOwnArticle)
+	s.router.POST("/v1/me/articles/:slug/approve", authMiddleware, ctrl.Approve)
+	s.router.POST("/v1/me/articles/:slug/reject", authMiddleware, ctrl.Reject)
+	s.router.POST("/v1/me/articles/:slug/archive", authMiddleware, ctrl.Archive)
+	s.router.POST("/v1/me/articles/:slug/schedule", authMiddleware, ctrl.Schedule)
+	s.router.DELETE("/v1/me/articles/:slug", authMiddleware, ctrl.Delete)
+	s.router.PUT("/v1/me/articles/:slug/slug", authMiddleware, ctrl.Rename)
+}
+
+func (s *ArticleReviewControllerTestSuite) TestListOwnArticles_Success() {
+	previews := []store.ArticlePreview{
+		{ID: uuid.New(), Slug: "draft-article", Status: store.ArticleStatusPendingReview},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		OwnerID: s.userID,
+		Status:  store.ArticleStatusPendingReview,
+		Limit:   51,
+	}).Return(previews, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/me/articles?status=pending_review", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("draft-article", gjson.Get(res, "items.0.slug").String())
+	s.Require().Equal("pending_review", gjson.Get(res, "items.0.status").String())
+	s.Require().False(gjson.Get(res, "items.0.publishedAt").Exists())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestListOwnArticles_Unauthorized() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/me/articles", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (s *ArticleReviewControllerTestSuite) TestGetOwnArticle_Success() {
+	s.mockStore.On("GetOwnedArticleBySlug", mock.Anything, s.userID, "draft-article").Return(&store.ArticleDetails{
+		Slug:          "draft-article",
+		Content:       "# Draft",
+		ContentFormat: store.ContentFormatMarkdown,
+		Status:        store.ArticleStatusDraft,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/me/articles/draft-article", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("# Draft", gjson.Get(res, "content").String())
+	s.Require().Equal("draft", gjson.Get(res, "status").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestApprove_Success() {
+	publishedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockStore.On("UpdateArticleStatus", mock.Anything, store.UpdateArticleStatusParams{
+		Slug:    "draft-article",
+		OwnerID: s.userID,
+		Status:  store.ArticleStatusPublished,
+	}).Return(&store.ArticleState{
+		Status:      store.ArticleStatusPublished,
+		PublishedAt: sql.NullTime{Time: publishedAt, Valid: true},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/draft-article/approve", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("published", gjson.Get(res, "status").String())
+	s.Require().Equal(publishedAt.Format(time.RFC3339), gjson.Get(res, "publishedAt").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestReject_Published() {
+	// Published articles are archived rather than rejected.
+	s.mockStore.On("UpdateArticleStatus", mock.Anything, store.UpdateArticleStatusParams{
+		Slug:    "published-article",
+		OwnerID: s.userID,
+		Status:  store.ArticleStatusArchived,
+		From:    []store.ArticleStatus{store.ArticleStatusDraft, store.ArticleStatusPendingReview, store.ArticleStatusScheduled},
+	}).Return(nil, store.ErrInvalidStatusTransition)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/published-article/reject", nil))
+
+	s.Require().Equal(http.StatusConflict, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidStatusTransition), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestReject_NotOwned() {
+	s.mockStore.On("UpdateArticleStatus", mock.Anything, mock.Anything).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/other-article/reject", nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+}
+
+func (s *ArticleReviewControllerTestSuite) TestArchive_Published() {
+	publishedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockStore.On("UpdateArticleStatus", mock.Anything, store.UpdateArticleStatusParams{
+		Slug:    "published-article",
+		OwnerID: s.userID,
+		Status:  store.ArticleStatusArchived,
+		From:    []store.ArticleStatus{store.ArticleStatusPublished},
+	}).Return(&store.ArticleState{
+		Status:      store.ArticleStatusArchived,
+		PublishedAt: sql.NullTime{Time: publishedAt, Valid: true},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/published-article/archive", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("archived", gjson.Get(res, "status").String())
+	// The original publish time is kept, in case the article is published again.
+	s.Require().Equal(publishedAt.Format(time.RFC3339), gjson.Get(res, "publishedAt").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestArchive_InvalidTransition() {
+	s.mockStore.On("UpdateArticleStatus", mock.Anything, store.UpdateArticleStatusParams{
+		Slug:    "archived-article",
+		OwnerID: s.userID,
+		Status:  store.ArticleStatusArchived,
+		From:    []store.ArticleStatus{store.ArticleStatusPublished},
+	}).Return(nil, store.ErrInvalidStatusTransition)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/archived-article/archive", nil))
+
+	s.Require().Equal(http.StatusConflict, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidStatusTransition), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestSchedule_Success() {
+	publishAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	s.mockStore.On("UpdateArticleStatus", mock.Anything, mock.MatchedBy(func(params store.UpdateArticleStatusParams) bool {
+		return params.Slug == "draft-article" && params.OwnerID == s.userID &&
+			params.Status == store.ArticleStatusScheduled && params.PublishAt.Equal(publishAt)
+	})).Return(&store.ArticleState{
+		Status:      store.ArticleStatusScheduled,
+		PublishedAt: sql.NullTime{Time: publishAt, Valid: true},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	body := strings.NewReader(`{"publishAt": "` + publishAt.Format(time.RFC3339) + `"}`)
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/draft-article/schedule", body))
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("scheduled", gjson.Get(w.Body.String(), "status").String())
+	s.Require().Equal(publishAt.Format(time.RFC3339), gjson.Get(w.Body.String(), "publishedAt").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestSchedule_PastTime() {
+	w := httptest.NewRecorder()
+	body := strings.NewReader(`{"publishAt": "2021-01-01T00:00:00Z"}`)
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/me/articles/draft-article/schedule", body))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidPublishTime), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestDelete_Success() {
+	s.mockStore.On("DeleteOwnedArticle", mock.Anything, s.userID, "draft-article").Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"DELETE", "/v1/me/articles/draft-article", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *ArticleReviewControllerTestSuite) TestDelete_NotOwned() {
+	s.mockStore.On("DeleteOwnedArticle", mock.Anything, s.userID, "other-article").Return(store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"DELETE", "/v1/me/articles/other-article", nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestRename_Success() {
+	articleID := uuid.New()
+	s.mockStore.On("GetOwnedArticleBySlug", mock.Anything, s.userID, "draft-article").
+		Return(&store.ArticleDetails{ID: articleID, Slug: "draft-article"}, nil)
+	s.mockStore.On("UpdateArticleSlug", mock.Anything, store.UpdateArticleSlugParams{
+		ID:   articleID,
+		Slug: "better-title",
+	}).Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/me/articles/draft-article/slug", strings.NewReader(`{"slug": "Better Title"}`)))
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("better-title", gjson.Get(w.Body.String(), "slug").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestRename_SlugTaken() {
+	articleID := uuid.New()
+	s.mockStore.On("GetOwnedArticleBySlug", mock.Anything, s.userID, "draft-article").
+		Return(&store.ArticleDetails{ID: articleID, Slug: "draft-article"}, nil)
+	s.mockStore.On("UpdateArticleSlug", mock.Anything, mock.Anything).Return(store.ErrArticleSlugTaken)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/me/articles/draft-article/slug", strings.NewReader(`{"slug": "other-article"}`)))
+
+	s.Require().Equal(http.StatusConflict, w.Code)
+	s.Require().Equal(string(controller.CodeArticleSlugTaken), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestRename_InvalidSlug() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/me/articles/draft-article/slug", strings.NewReader(`{"slug": "!!!"}`)))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidSlug), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleReviewControllerTestSuite) TestRename_NotOwned() {
+	s.mockStore.On("GetOwnedArticleBySlug", mock.Anything, s.userID, "other-article").
+		Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/me/articles/other-article/slug", strings.NewReader(`{"slug": "mine-now"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/textdiff"
+)
+
+const (
+	CodeArticleRevisionNotFound ErrorCode = "article_revision_not_found"
+)
+
+const (
+	diffModeUnified = "unified"
+	diffModeWord    = "word"
+)
+
+// maxWordDiffLength is the maximum total length in bytes of the two versions of a field which are compared word
+// by word. The word diff takes quadratic time in the number of words in the worst case, so longer fields are
+// compared line by line with the unified diff instead.
+const maxWordDiffLength = 32 * 1024
+
+// ListRevisions lists the revisions of an article, newest first.
+func (c *ArticleController) ListRevisions(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleController.ListRevisions")
+	defer span.End()
+
+	var req ListRevisionsRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	revisions, err := c.store.ListArticleRevisions(ctx, req.Slug)
+	if err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := ListRevisionsResponse{
+		Items: make([]ArticleRevision, len(revisions)),
+	}
+	for i, revision := range revisions {
+		response.Items[i] = ArticleRevision{
+			Number:        revision.Number,
+			Title:         revision.Title,
+			Description:   revision.Description,
+			ContentFormat: string(revision.ContentFormat),
+			CreatedAt:     revision.CreatedAt,
+		}
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// DiffRevisions returns the difference between a revision of an article and another revision,
+// which defaults to the previous one.
+func (c *ArticleController) DiffRevisions(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ArticleController.DiffRevisions")
+	defer span.End()
+
+	var req DiffRevisionsRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	against := req.Number - 1
+	if req.Against != nil {
+		against = *req.Against
+	}
+	mode := diffModeUnified
+	if req.Mode != "" {
+		mode = req.Mode
+	}
+
+	to, err := c.getRevision(ctx, req.Slug, req.Number)
+	if err != nil {
+		writeGetRevisionErrorResponse(ginCtx, span, err)
+		return
+	}
+	from, err := c.getRevision(ctx, req.Slug, against)
+	if err != nil {
+		writeGetRevisionErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := DiffRevisionsResponse{
+		From: against,
+		To:   req.Number,
+		Mode: mode,
+	}
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{name: "title", from: from.Title, to: to.Title},
+		{name: "description", from: from.Description, to: to.Description},
+		{name: "content", from: from.Content, to: to.Content},
+	}
+	for _, field := range fields {
+		fieldDiff := RevisionFieldDiff{
+			Field:   field.name,
+			Changed: field.from != field.to,
+		}
+
+		if fieldDiff.Changed {
+			// Fields too long to be compared word by word fall back to the unified diff.
+			if mode == diffModeWord && len(field.from)+len(field.to) <= maxWordDiffLength {
+				for _, change := range textdiff.Words(field.from, field.to) {
+					fieldDiff.Words = append(fieldDiff.Words, WordChange{
+						Op:   string(change.Op),
+						Text: change.Text,
+					})
+				}
+			} else {
+				fieldDiff.Unified, err = textdiff.Unified(field.from, field.to,
+					fmt.Sprintf("revision %d", against), fmt.Sprintf("revision %d", req.Number))
+				if err != nil {
+					writeUnknownErrorResponse(ginCtx, span, err)
+					return
+				}
+			}
+		}
+
+		response.Fields = append(response.Fields, fieldDiff)
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// getRevision returns a revision of an article. Revision 0 is the empty article before the first revision.
+func (c *ArticleController) getRevision(ctx context.Context, slug string, number int) (*store.ArticleRevision, error) {
+	if number == 0 {
+		return &store.ArticleRevision{}, nil
+	}
+
+	return c.store.GetArticleRevision(ctx, slug, number)
+}
+
+func writeGetRevisionErrorResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	if errors.Is(err, store.ErrArticleRevisionNotFound) {
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeArticleRevisionNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+		return
+	}
+
+	writeUnknownErrorResponse(ginCtx, span, err)
+}
+
+type ListRevisionsRequest struct {
+	Slug string `uri:"slug"`
+}
+
+type ListRevisionsResponse struct {
+	Items []ArticleRevision `json:"items"`
+}
+
+type ArticleRevision struct {
+	Number        int       `json:"number"`
+	Title         string    `json:"title"`
+	Description   string    `json:"description"`
+	ContentFormat string    `json:"contentFormat"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type DiffRevisionsRequest struct {
+	Slug   string `uri:"slug" form:"-"`
+	Number int    `uri:"n" form:"-" binding:"min=1"`
+	// Against is the number of the revision to compare with. It defaults to the previous revision.
+	// Revision 0 is the empty article, so comparing with it shows the whole revision as inserted.
+	Against *int `form:"against" binding:"omitempty,min=0"`
+	// Mode is either "unified" (the default) or "word".
+	Mode string `form:"mode" binding:"omitempty,oneof=unified word"`
+}
+
+type DiffRevisionsResponse struct {
+	From   int                 `json:"from"`
+	To     int                 `json:"to"`
+	Mode   string              `json:"mode"`
+	Fields []RevisionFieldDiff `json:"fields"`
+}
+
+// RevisionFieldDiff is the difference in one field of an article between two revisions.
+type RevisionFieldDiff struct {
+	// Field is one of "title", "description" or "content".
+	Field   string `json:"field"`
+	Changed bool   `json:"changed"`
+	// Unified is the diff in unified format. It is set in the "unified" mode, and in the "word" mode if the field
+	// is too long to be compared word by word.
+	Unified string `json:"unified,omitempty"`
+	// Words is the word-level diff. It is only set in the "word" mode.
+	Words []WordChange `json:"words,omitempty"`
+}
+
+type WordChange struct {
+	// Op is one of "equal", "insert" or "delete".
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,146 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func (s *ArticleControllerTestSuite) TestListRevisions_Success() {
+	date := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	revisions := []store.ArticleRevisionSummary{
+		{Number: 2, Title: "New Title", ContentFormat: store.ContentFormatMarkdown, CreatedAt: date.Add(time.Hour)},
+		{Number: 1, Title: "Old Title", ContentFormat: store.ContentFormatMarkdown, CreatedAt: date},
+	}
+	s.mockStore.On("ListArticleRevisions", mock.Anything, "test-article").Return(revisions, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 2)
+	s.Require().Equal(int64(2), gjson.Get(res, "items.0.number").Int())
+	s.Require().Equal("New Title", gjson.Get(res, "items.0.title").String())
+	s.Require().Equal("markdown", gjson.Get(res, "items.0.contentFormat").String())
+}
+
+func (s *ArticleControllerTestSuite) TestListRevisions_ArticleNotFound() {
+	s.mockStore.On("ListArticleRevisions", mock.Anything, "unknown").Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/unknown/revisions", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleControllerTestSuite) TestDiffRevisions_Unified() {
+	s.mockRevision(1, "Title", "one\ntwo\n")
+	s.mockRevision(2, "Title", "one\n2\n")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions/2/diff", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(1), gjson.Get(res, "from").Int())
+	s.Require().Equal(int64(2), gjson.Get(res, "to").Int())
+	s.Require().Equal("unified", gjson.Get(res, "mode").String())
+	s.Require().Equal("title", gjson.Get(res, "fields.0.field").String())
+	s.Require().False(gjson.Get(res, "fields.0.changed").Bool())
+	s.Require().Equal("content", gjson.Get(res, "fields.2.field").String())
+	s.Require().True(gjson.Get(res, "fields.2.changed").Bool())
+	s.Require().Equal("--- revision 1\n+++ revision 2\n@@ -1,2 +1,2 @@\n one\n-two\n+2\n",
+		gjson.Get(res, "fields.2.unified").String())
+}
+
+func (s *ArticleControllerTestSuite) TestDiffRevisions_Word() {
+	s.mockRevision(1, "Old Title", "content")
+	s.mockRevision(3, "New Title", "content")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions/3/diff?against=1&mode=word", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(1), gjson.Get(res, "from").Int())
+	s.Require().Equal("word", gjson.Get(res, "mode").String())
+	s.Require().Equal(`[{"op":"delete","text":"Old"},{"op":"insert","text":"New"},{"op":"equal","text":" Title"}]`,
+		gjson.Get(res, "fields.0.words").Raw)
+	s.Require().False(gjson.Get(res, "fields.0.unified").Exists())
+}
+
+func (s *ArticleControllerTestSuite) TestDiffRevisions_WordTooLong() {
+	long := strings.Repeat("word ", 8*1024)
+	s.mockRevision(1, "Old Title", long+"\none\n")
+	s.mockRevision(2, "New Title", long+"\ntwo\n")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions/2/diff?mode=word", nil)
+	s.router.ServeHTTP(w, req)
+
+	// The content is too long to be compared word by word, so it is compared line by line instead.
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("word", gjson.Get(res, "mode").String())
+	s.Require().True(gjson.Get(res, "fields.0.words").Exists())
+	s.Require().False(gjson.Get(res, "fields.2.words").Exists())
+	s.Require().Contains(gjson.Get(res, "fields.2.unified").String(), "-one\n+two\n")
+}
+
+func (s *ArticleControllerTestSuite) TestDiffRevisions_FirstRevision() {
+	s.mockRevision(1, "Title", "content")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions/1/diff?mode=word", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(0), gjson.Get(res, "from").Int())
+	s.Require().Equal(`[{"op":"insert","text":"Title"}]`, gjson.Get(res, "fields.0.words").Raw)
+}
+
+func (s *ArticleControllerTestSuite) TestDiffRevisions_RevisionNotFound() {
+	s.mockStore.On("GetArticleRevision", mock.Anything, "test-article", 5).
+		Return(nil, store.ErrArticleRevisionNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions/5/diff", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleRevisionNotFound),
+		gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleControllerTestSuite) TestDiffRevisions_InvalidMode() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article/revisions/2/diff?mode=side-by-side", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *ArticleControllerTestSuite) mockRevision(number int, title, content string) {
+	s.mockStore.On("GetArticleRevision", mock.Anything, "test-article", number).Return(&store.ArticleRevision{
+		ArticleRevisionSummary: store.ArticleRevisionSummary{
+			Number:        number,
+			Title:         title,
+			ContentFormat: store.ContentFormatMarkdown,
+		},
+		Content: content,
+	}, nil)
+}
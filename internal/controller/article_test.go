@@ -14,16 +14,21 @@ import (
 	"github.com/tidwall/gjson"
 	"github.com/tuananhlai/brevity-go/internal/controller"
 	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
 )
 
+var testPageTokenSecret = []byte("test-page-token-secret")
+
 func TestArticleController(t *testing.T) {
 	suite.Run(t, new(ArticleControllerTestSuite))
 }
 
 type ArticleControllerTestSuite struct {
 	suite.Suite
-	mockStore *controller.MockArticleStore
-	router    *gin.Engine
+	mockStore   *controller.MockArticleStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
 }
 
 func (s *ArticleControllerTestSuite) SetupTest() {
@@ -32,10 +37,18 @@ func (s *ArticleControllerTestSuite) SetupTest() {
 
 func (s *ArticleControllerTestSuite) BeforeTest(suiteName, testName string) {
 	s.mockStore = controller.NewMockArticleStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
 	s.router = gin.Default()
-	ctrl := controller.NewArticleController(s.mockStore)
-	s.router.GET("/v1/article-previews", ctrl.ListPreviews)
-	s.router.GET("/v1/articles/:slug", ctrl.GetBySlug)
+	ctrl := controller.NewArticleController(s.mockStore, testPageTokenSecret)
+	optionalAuthMiddleware := controller.OptionalAuthMiddleware(s.tokenIssuer)
+	s.router.GET("/v1/article-previews", optionalAuthMiddleware, ctrl.ListPreviews)
+	s.router.GET("/v1/articles/search", optionalAuthMiddleware, ctrl.Search)
+	s.router.GET("/v1/articles/:slug", optionalAuthMiddleware, controller.CacheControlMiddleware("public, max-age=60"),
+		ctrl.GetBySlug)
+	s.router.POST("/v1/articles/:slug/claps", controller.AuthMiddleware(s.tokenIssuer), ctrl.Clap)
+	s.router.GET("/v1/articles/:slug/revisions", ctrl.ListRevisions)
+	s.router.GET("/v1/articles/:slug/revisions/:n/diff", ctrl.DiffRevisions)
 }
 
 func (s *ArticleControllerTestSuite) TestListPreviews_Success() {
@@ -56,8 +69,11 @@ func (s *ArticleControllerTestSuite) TestListPreviews_Success() {
 			UpdatedAt:         date,
 		},
 	}
-	s.mockStore.On("ListArticlesPreviews", mock.Anything).Return(previews, nil)
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		Limit: 51,
+	}).Return(previews, nil)
 
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(time.Time{}, nil)
 	w := httptest.NewRecorder()
 	req, _ := http.NewRequest("GET", "/v1/article-previews", nil)
 	s.router.ServeHTTP(w, req)
@@ -81,4 +97,528 @@ func (s *ArticleControllerTestSuite) TestListPreviews_Success() {
 		gjson.Get(res, "items.0.createdAt").String())
 	s.Require().Equal(date.Format(time.RFC3339),
 		gjson.Get(res, "items.0.updatedAt").String())
+	s.Require().False(gjson.Get(res, "nextPageToken").Exists())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_Pagination() {
+	date := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	previews := make([]store.ArticlePreview, 3)
+	for i := range previews {
+		previews[i] = store.ArticlePreview{
+			ID:       uuid.New(),
+			Slug:     "test-article",
+			Title:    "Test Article",
+			AuthorID: uuid.New(),
+			// The articles were approved a week after their generation.
+			PublishedAt: sql.NullTime{Time: date.Add(-time.Duration(i) * time.Hour), Valid: true},
+			CreatedAt:   date.Add(-time.Duration(i)*time.Hour - 7*24*time.Hour),
+			UpdatedAt:   date,
+		}
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		Limit: 3,
+	}).Return(previews, nil).Once()
+
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(time.Time{}, nil)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews?pageSize=2", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 2)
+	nextPageToken := gjson.Get(res, "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	// The next page must start after the last item of the first page.
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		Limit: 3,
+		After: &store.ArticlePreviewCursor{
+			PublishedAt: previews[1].PublishedAt.Time,
+			ID:          previews[1].ID,
+		},
+	}).Return(previews[2:], nil).Once()
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews?pageSize=2&pageToken="+nextPageToken, nil)
+	s.router.ServeHTTP(w, req)
+
+	res = w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().False(gjson.Get(res, "nextPageToken").Exists())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_ArticleLeavesList() {
+	previews := []store.ArticlePreview{
+		{ID: uuid.New(), Slug: "newer", UpdatedAt: time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC)},
+		{ID: uuid.New(), Slug: "older", UpdatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{Limit: 51}).
+		Return(previews, nil).Once()
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).
+		Return(previews[0].UpdatedAt, nil).Once()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews", nil)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Fri, 02 Oct 2026 09:00:00 GMT", w.Header().Get("Last-Modified"))
+
+	// Taking down the older article bumps its update time, although it is no longer in the list.
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{Limit: 51}).
+		Return(previews[:1], nil).Once()
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).
+		Return(time.Date(2026, 10, 4, 9, 0, 0, 0, time.UTC), nil).Once()
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews", nil)
+	req.Header.Set("If-Modified-Since", "Sat, 03 Oct 2026 09:00:00 GMT")
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Sun, 04 Oct 2026 09:00:00 GMT", w.Header().Get("Last-Modified"))
+	s.Require().Len(gjson.Get(w.Body.String(), "items").Array(), 1)
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_ConditionalGet() {
+	previews := []store.ArticlePreview{
+		{ID: uuid.New(), Slug: "newer", UpdatedAt: time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC)},
+		{ID: uuid.New(), Slug: "older", UpdatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{Limit: 51}).
+		Return(previews, nil).Times(4)
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(previews[0].UpdatedAt, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews", nil)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Fri, 02 Oct 2026 09:00:00 GMT", w.Header().Get("Last-Modified"))
+	etag := w.Header().Get("ETag")
+	s.Require().NotEmpty(etag)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews", nil)
+	req.Header.Set("If-None-Match", etag)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusNotModified, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews", nil)
+	req.Header.Set("If-Modified-Since", "Thu, 01 Oct 2026 09:00:00 GMT")
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews", nil)
+	req.Header.Set("If-Modified-Since", "Fri, 02 Oct 2026 09:00:00 GMT")
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusNotModified, w.Code)
+
+	// A change in the set of articles changes the ETag.
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{Limit: 51}).
+		Return(previews[1:], nil).Once()
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews", nil)
+	req.Header.Set("If-None-Match", etag)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(w.Body.String(), "items").Array(), 1)
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_TagFilter() {
+	tag := store.Tag{ID: uuid.New(), Slug: "go", Name: "Go"}
+	previews := []store.ArticlePreview{
+		{
+			ID:    uuid.New(),
+			Slug:  "test-article",
+			Title: "Test Article",
+			Tags:  store.TagList{tag},
+		},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		TagSlug: "go",
+		Limit:   51,
+	}).Return(previews, nil)
+
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(time.Time{}, nil)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews?tag=go", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().Equal(tag.ID.String(), gjson.Get(res, "items.0.tags.0.id").String())
+	s.Require().Equal(tag.Slug, gjson.Get(res, "items.0.tags.0.slug").String())
+	s.Require().Equal(tag.Name, gjson.Get(res, "items.0.tags.0.name").String())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_MaxReadingTime() {
+	previews := []store.ArticlePreview{
+		{
+			ID:               uuid.New(),
+			Slug:             "short-article",
+			Title:            "Short Article",
+			WordCount:        450,
+			ReadingMinutes:   2,
+			ReadabilityGrade: 8.25,
+		},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		MaxReadingMinutes: 5,
+		Limit:             51,
+	}).Return(previews, nil)
+
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(time.Time{}, nil)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews?maxReadingTime=5", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(450), gjson.Get(res, "items.0.wordCount").Int())
+	s.Require().Equal(int64(2), gjson.Get(res, "items.0.readingTime").Int())
+	s.Require().Equal(8.25, gjson.Get(res, "items.0.readabilityGrade").Float())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_InvalidMaxReadingTime() {
+	for _, maxReadingTime := range []string{"0", "-1", "abc"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1/article-previews?maxReadingTime="+maxReadingTime, nil)
+		s.router.ServeHTTP(w, req)
+
+		s.Require().Equal(http.StatusBadRequest, w.Code, "maxReadingTime %s", maxReadingTime)
+	}
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_InvalidPageToken() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews?pageToken=eyJpZCI6IjEifQ.bm90LWEtc2lnbmF0dXJl", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidPageToken), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_InvalidPageSize() {
+	for _, pageSize := range []string{"0", "101", "abc"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1/article-previews?pageSize="+pageSize, nil)
+		s.router.ServeHTTP(w, req)
+
+		s.Require().Equal(http.StatusBadRequest, w.Code, "pageSize=%s", pageSize)
+		s.Require().Equal(string(controller.CodeBindingRequestError),
+			gjson.Get(w.Body.String(), "errorCode").String())
+	}
+}
+
+func (s *ArticleControllerTestSuite) TestSearch_Success() {
+	articleID := uuid.New()
+	results := []store.ArticleSearchResult{
+		{
+			ArticlePreview: store.ArticlePreview{
+				ID:       articleID,
+				Slug:     "go-channels",
+				Title:    "Go Channels",
+				AuthorID: uuid.New(),
+			},
+			Rank:      0.5,
+			Highlight: "use " + store.SearchHighlightStart + "channels" + store.SearchHighlightStop + " <script>",
+		},
+	}
+	s.mockStore.On("SearchArticles", mock.Anything, store.SearchArticlesParams{
+		Query: "channels",
+		Limit: 21,
+	}).Return(results, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/search?q=channels", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().Equal(articleID.String(), gjson.Get(res, "items.0.id").String())
+	s.Require().Equal("go-channels", gjson.Get(res, "items.0.slug").String())
+	s.Require().Equal("use <mark>channels</mark> &lt;script&gt;", gjson.Get(res, "items.0.highlight").String())
+}
+
+func (s *ArticleControllerTestSuite) TestSearch_SignedIn() {
+	results := []store.ArticleSearchResult{
+		{ArticlePreview: store.ArticlePreview{ID: uuid.New(), Slug: "go-channels", ViewerClapCount: 5,
+			ViewerBookmarked: true}},
+	}
+	s.mockStore.On("SearchArticles", mock.Anything, store.SearchArticlesParams{
+		Query:    "channels",
+		ViewerID: s.userID,
+		Limit:    21,
+	}).Return(results, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/articles/search?q=channels", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(5), gjson.Get(res, "items.0.viewerClaps").Int())
+	s.Require().True(gjson.Get(res, "items.0.bookmarked").Bool())
+}
+
+func (s *ArticleControllerTestSuite) TestSearch_MissingQuery() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/search", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeBindingRequestError), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleControllerTestSuite) TestSearch_PageTokenFromAnotherQuery() {
+	results := make([]store.ArticleSearchResult, 2)
+	for i := range results {
+		results[i] = store.ArticleSearchResult{
+			ArticlePreview: store.ArticlePreview{ID: uuid.New()},
+			Rank:           0.1,
+		}
+	}
+	s.mockStore.On("SearchArticles", mock.Anything, store.SearchArticlesParams{
+		Query: "go",
+		Limit: 2,
+	}).Return(results, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/search?q=go&pageSize=1", nil)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	nextPageToken := gjson.Get(w.Body.String(), "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/articles/search?q=rust&pageToken="+nextPageToken, nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidPageToken), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_Format() {
+	article := &store.ArticleDetails{
+		ID:            uuid.New(),
+		Slug:          "test-article",
+		Title:         "Test Article",
+		Content:       "# Hello",
+		ContentFormat: store.ContentFormatMarkdown,
+		HTMLContent:   "<h1>Hello</h1>",
+		AuthorID:      uuid.New(),
+	}
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, uuid.Nil).Return(article, nil)
+
+	testCases := []struct {
+		query           string
+		expectedContent string
+		expectedFormat  string
+	}{
+		{query: "", expectedContent: "# Hello", expectedFormat: "markdown"},
+		{query: "?format=markdown", expectedContent: "# Hello", expectedFormat: "markdown"},
+		{query: "?format=html", expectedContent: "<h1>Hello</h1>", expectedFormat: "html"},
+	}
+
+	for _, tc := range testCases {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1/articles/test-article"+tc.query, nil)
+		s.router.ServeHTTP(w, req)
+
+		res := w.Body.String()
+		s.Require().Equal(http.StatusOK, w.Code)
+		s.Require().Equal(tc.expectedContent, gjson.Get(res, "content").String(), tc.query)
+		s.Require().Equal(tc.expectedFormat, gjson.Get(res, "contentFormat").String(), tc.query)
+	}
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_TOC() {
+	article := &store.ArticleDetails{
+		ID:            uuid.New(),
+		Slug:          "test-article",
+		Title:         "Test Article",
+		Content:       "# Hello\n\n## Setup",
+		ContentFormat: store.ContentFormatMarkdown,
+		HTMLContent:   `<h1 id="hello">Hello</h1><p>Intro</p><h2 id="setup">Setup</h2>`,
+		AuthorID:      uuid.New(),
+	}
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, uuid.Nil).Return(article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().JSONEq(`[{"level":1,"text":"Hello","id":"hello"},{"level":2,"text":"Setup","id":"setup"}]`,
+		gjson.Get(w.Body.String(), "toc").Raw)
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_EmptyTOC() {
+	article := &store.ArticleDetails{
+		ID:            uuid.New(),
+		Slug:          "test-article",
+		Title:         "Test Article",
+		Content:       "Hello",
+		ContentFormat: store.ContentFormatMarkdown,
+		HTMLContent:   "<p>Hello</p>",
+		AuthorID:      uuid.New(),
+	}
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, uuid.Nil).Return(article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("[]", gjson.Get(w.Body.String(), "toc").Raw)
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_ConditionalGet() {
+	article := &store.ArticleDetails{
+		ID:        uuid.New(),
+		Slug:      "test-article",
+		Content:   "# Hello",
+		AuthorID:  uuid.New(),
+		UpdatedAt: time.Date(2026, 10, 1, 9, 0, 0, 500, time.UTC),
+	}
+	clapped := *article
+	clapped.ClapCount = 1
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, uuid.Nil).Return(article, nil).Times(4)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article", nil)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Thu, 01 Oct 2026 09:00:00 GMT", w.Header().Get("Last-Modified"))
+	etag := w.Header().Get("ETag")
+	s.Require().Regexp(`^"[0-9a-f]{32}"$`, etag)
+
+	for _, header := range []http.Header{
+		{"If-None-Match": {etag}},
+		{"If-Modified-Since": {"Thu, 01 Oct 2026 09:00:00 GMT"}},
+	} {
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("GET", "/v1/articles/test-article", nil)
+		req.Header = header
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusNotModified, w.Code, header)
+		s.Require().Empty(w.Body.String())
+	}
+
+	// The ETag depends on the requested format.
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/articles/test-article?format=html", nil)
+	req.Header.Set("If-None-Match", etag)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().NotEqual(etag, w.Header().Get("ETag"))
+
+	// Claps do not update the article, but they change the ETag.
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, uuid.Nil).Return(&clapped, nil).Once()
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/articles/test-article", nil)
+	req.Header.Set("If-None-Match", etag)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(int64(1), gjson.Get(w.Body.String(), "claps").Int())
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_InvalidFormat() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/test-article?format=pdf", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_RedirectsOldSlug() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "old-slug", uuid.Nil).Return(nil, store.ErrArticleNotFound)
+	s.mockStore.On("GetArticleSlugRedirect", mock.Anything, "old-slug").Return("new-slug", nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/old-slug?format=html", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusMovedPermanently, w.Code)
+	s.Require().Equal("/v1/articles/new-slug?format=html", w.Header().Get("Location"))
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_NotFound() {
+	s.mockStore.On("GetArticleBySlug", mock.Anything, "unknown", uuid.Nil).Return(nil, store.ErrArticleNotFound)
+	s.mockStore.On("GetArticleSlugRedirect", mock.Anything, "unknown").Return("", store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/unknown", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+	// The article may be published soon, so caches must not keep the error.
+	s.Require().Equal("no-store", w.Header().Get("Cache-Control"))
+}
+
+func (s *ArticleControllerTestSuite) TestGetBySlug_Series() {
+	article := &store.ArticleDetails{
+		ID:   uuid.New(),
+		Slug: "channels",
+		ArticleSeriesInfo: store.ArticleSeriesInfo{
+			SeriesID:      uuid.NullUUID{UUID: uuid.New(), Valid: true},
+			SeriesTitle:   sql.NullString{String: "Learn Go Concurrency", Valid: true},
+			Position:      sql.NullInt32{Int32: 2, Valid: true},
+			PreviousSlug:  sql.NullString{String: "goroutines", Valid: true},
+			PreviousTitle: sql.NullString{String: "Goroutines", Valid: true},
+		},
+	}
+	s.mockStore.On("GetArticleBySlug", mock.Anything, article.Slug, uuid.Nil).Return(article, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/channels", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Learn Go Concurrency", gjson.Get(res, "series.title").String())
+	s.Require().Equal(int64(2), gjson.Get(res, "series.position").Int())
+	s.Require().Equal("goroutines", gjson.Get(res, "series.previous.slug").String())
+	s.Require().False(gjson.Get(res, "series.next").Exists())
+}
+
+func (s *ArticleControllerTestSuite) TestListPreviews_Trending() {
+	previews := []store.ArticlePreview{
+		{ID: uuid.New(), Slug: "hot", TrendingScore: 12.5},
+		{ID: uuid.New(), Slug: "warm", TrendingScore: 0.75},
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		OrderBy: store.ArticleOrderTrending,
+		Limit:   2,
+	}).Return(previews, nil).Once()
+
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).Return(time.Time{}, nil)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/article-previews?orderBy=trending&pageSize=1", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("hot", gjson.Get(res, "items.0.slug").String())
+	nextPageToken := gjson.Get(res, "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	// The next page must continue after the trending score of the last item.
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		OrderBy: store.ArticleOrderTrending,
+		Limit:   2,
+		After: &store.ArticlePreviewCursor{
+			TrendingScore: 12.5,
+			ID:            previews[0].ID,
+		},
+	}).Return(previews[1:], nil).Once()
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/v1/article-previews?orderBy=trending&pageSize=1&pageToken="+nextPageToken, nil)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("warm", gjson.Get(w.Body.String(), "items.0.slug").String())
 }
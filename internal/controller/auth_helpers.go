@@ -2,8 +2,12 @@ package controller
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -32,6 +36,48 @@ func getContextUserID(ginCtx *gin.Context) (string, error) {
 	return userID, nil
 }
 
+// getContextUserUUID returns the current user ID from the Gin context as a UUID. If there is no valid user ID,
+// an unauthorized response is written and false is returned.
+func getContextUserUUID(ginCtx *gin.Context, span trace.Span) (uuid.UUID, bool) {
+	rawUserID, err := getContextUserID(ginCtx)
+	if err == nil {
+		var userID uuid.UUID
+		userID, err = uuid.Parse(rawUserID)
+		if err == nil {
+			span.SetAttributes(attribute.String("userID", rawUserID))
+			return userID, true
+		}
+	}
+
+	writeErrorResponse(ginCtx, writeErrorResponseParams{
+		Body: ErrorResponse{
+			Code:    CodeUnauthorized,
+			Message: "error getting userID from context",
+		},
+		Span:       span,
+		Err:        err,
+		StatusCode: http.StatusUnauthorized,
+	})
+	return uuid.Nil, false
+}
+
+// getOptionalContextUserUUID returns the current user ID from the Gin context as a UUID, or uuid.Nil if the
+// request is anonymous.
+func getOptionalContextUserUUID(ginCtx *gin.Context, span trace.Span) uuid.UUID {
+	rawUserID, err := getContextUserID(ginCtx)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil
+	}
+
+	span.SetAttributes(attribute.String("userID", rawUserID))
+	return userID
+}
+
 // extractAccessTokenFromRequest returns the access token from the HTTP request.
 func extractAccessTokenFromRequest(ginCtx *gin.Context) (string, bool) {
 	token, err := ginCtx.Cookie(accessTokenCookieName)
@@ -1,10 +1,14 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
 	"github.com/tuananhlai/brevity-go/internal/token"
 )
 
@@ -35,3 +39,59 @@ func AuthMiddleware(tokenIssuer *token.AccessTokenIssuer) gin.HandlerFunc {
 		ginCtx.Next()
 	}
 }
+
+// OptionalAuthMiddleware identifies the current user of HTTP requests which have a valid access token, and lets
+// the other requests reach the HTTP handler anonymously. Use getOptionalContextUserUUID to get the current user.
+func OptionalAuthMiddleware(tokenIssuer *token.AccessTokenIssuer) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		accessToken, ok := extractAccessTokenFromRequest(ginCtx)
+		if ok {
+			if userID, err := tokenIssuer.Verify(accessToken); err == nil {
+				setContextUserID(ginCtx, userID)
+			}
+		}
+
+		ginCtx.Next()
+	}
+}
+
+// ModeratorStore defines the store methods used by the moderator middleware.
+type ModeratorStore interface {
+	GetUserByID(ctx context.Context, userID string) (*store.User, error)
+}
+
+// ModeratorMiddleware stops HTTP requests of users who are not moderators from reaching the HTTP handler.
+// It must run after AuthMiddleware.
+func ModeratorMiddleware(userStore ModeratorStore) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModeratorMiddleware")
+		defer span.End()
+
+		userID, ok := getContextUserUUID(ginCtx, span)
+		if !ok {
+			ginCtx.Abort()
+			return
+		}
+
+		user, err := userStore.GetUserByID(ctx, userID.String())
+		if err != nil {
+			writeUnknownErrorResponse(ginCtx, span, err)
+			ginCtx.Abort()
+			return
+		}
+		if user.Role != store.UserRoleModerator {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeForbidden,
+					Message: "only moderators can perform this action",
+				},
+				Span:       span,
+				StatusCode: http.StatusForbidden,
+			})
+			ginCtx.Abort()
+			return
+		}
+
+		ginCtx.Next()
+	}
+}
@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+// BookmarkStore defines the store methods used by the bookmark controller.
+type BookmarkStore interface {
+	BookmarkArticle(ctx context.Context, userID uuid.UUID, slug string) error
+	DeleteBookmark(ctx context.Context, userID uuid.UUID, slug string) error
+	ListBookmarkedArticles(ctx context.Context, params store.ListBookmarkedArticlesParams) ([]store.BookmarkedArticle, error)
+}
+
+// BookmarkController lets users save articles to read later. Bookmarks are private to their user.
+type BookmarkController struct {
+	store BookmarkStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
+}
+
+func NewBookmarkController(store BookmarkStore, pageTokenSecret []byte) *BookmarkController {
+	return &BookmarkController{store: store, pageTokenSecret: pageTokenSecret}
+}
+
+// Bookmark bookmarks a published article for the current user. Bookmarking an article twice has no effect.
+func (c *BookmarkController) Bookmark(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "BookmarkController.Bookmark")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req BookmarkRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.BookmarkArticle(ctx, userID, req.Slug); err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// DeleteBookmark removes the bookmark of the current user on an article. Removing a bookmark which does not
+// exist has no effect.
+func (c *BookmarkController) DeleteBookmark(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "BookmarkController.DeleteBookmark")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req BookmarkRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.DeleteBookmark(ctx, userID, req.Slug); err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// ListBookmarks lists the articles bookmarked by the current user, most recently bookmarked first.
+func (c *BookmarkController) ListBookmarks(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "BookmarkController.ListBookmarks")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req PreviewsPageRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	pageSize := defaultPreviewsPageSize
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	// Fetch one extra item to find out whether there is a next page.
+	params := store.ListBookmarkedArticlesParams{
+		UserID: userID,
+		Limit:  pageSize + 1,
+	}
+	if req.PageToken != "" {
+		var pageToken listBookmarksPageToken
+		if err := utils.ParsePageToken(c.pageTokenSecret, req.PageToken, &pageToken); err != nil {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+		params.After = &store.BookmarkCursor{
+			BookmarkedAt: pageToken.BookmarkedAt,
+			ID:           pageToken.ID,
+		}
+	}
+
+	articles, err := c.store.ListBookmarkedArticles(ctx, params)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	var nextPageToken string
+	if len(articles) > pageSize {
+		articles = articles[:pageSize]
+		last := articles[len(articles)-1]
+		nextPageToken, err = utils.GeneratePageToken(c.pageTokenSecret, listBookmarksPageToken{
+			BookmarkedAt: last.BookmarkedAt,
+			ID:           last.ID,
+		})
+		if err != nil {
+			writeUnknownErrorResponse(ginCtx, span, err)
+			return
+		}
+	}
+
+	response := ListBookmarksResponse{
+		Items:         make([]BookmarkedArticle, len(articles)),
+		NextPageToken: nextPageToken,
+	}
+	for i, article := range articles {
+		response.Items[i] = BookmarkedArticle{
+			ArticlePreview: newArticlePreview(article.ArticlePreview),
+			BookmarkedAt:   article.BookmarkedAt,
+		}
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+type BookmarkRequest struct {
+	Slug string `uri:"slug"`
+}
+
+type BookmarkedArticle struct {
+	ArticlePreview
+	BookmarkedAt time.Time `json:"bookmarkedAt"`
+}
+
+type ListBookmarksResponse struct {
+	Items []BookmarkedArticle `json:"items"`
+	// NextPageToken is empty if there are no more results.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// listBookmarksPageToken is the content of the page token returned by ListBookmarks.
+// It holds the sort key of the last item in the current page.
+type listBookmarksPageToken struct {
+	BookmarkedAt time.Time `json:"bookmarkedAt"`
+	ID           uuid.UUID `json:"id"`
+}
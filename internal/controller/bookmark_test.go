@@ -0,0 +1,131 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestBookmarkController(t *testing.T) {
+	suite.Run(t, new(BookmarkControllerTestSuite))
+}
+
+type BookmarkControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockBookmarkStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *BookmarkControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *BookmarkControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockBookmarkStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewBookmarkController(s.mockStore, testPageTokenSecret)
+	authMiddleware := controller.AuthMiddleware(s.tokenIssuer)
+	s.router.POST("/v1/articles/:slug/bookmark", authMiddleware, ctrl.Bookmark)
+	s.router.DELETE("/v1/articles/:slug/bookmark", authMiddleware, ctrl.DeleteBookmark)
+	s.router.GET("/v1/me/bookmarks", authMiddleware, ctrl.ListBookmarks)
+}
+
+func (s *BookmarkControllerTestSuite) TestBookmark_Success() {
+	s.mockStore.On("BookmarkArticle", mock.Anything, s.userID, "go-generics").Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/bookmark", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *BookmarkControllerTestSuite) TestBookmark_ArticleNotFound() {
+	s.mockStore.On("BookmarkArticle", mock.Anything, s.userID, "unknown").Return(store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/unknown/bookmark", nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *BookmarkControllerTestSuite) TestBookmark_Unauthorized() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/articles/go-generics/bookmark", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (s *BookmarkControllerTestSuite) TestDeleteBookmark_Success() {
+	s.mockStore.On("DeleteBookmark", mock.Anything, s.userID, "go-generics").Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"DELETE", "/v1/articles/go-generics/bookmark", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *BookmarkControllerTestSuite) TestListBookmarks_Pagination() {
+	date := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	articles := make([]store.BookmarkedArticle, 3)
+	for i := range articles {
+		articles[i] = store.BookmarkedArticle{
+			ArticlePreview: store.ArticlePreview{ID: uuid.New(), Slug: "article", ViewerBookmarked: true},
+			BookmarkedAt:   date.Add(-time.Duration(i) * time.Hour),
+		}
+	}
+	s.mockStore.On("ListBookmarkedArticles", mock.Anything, store.ListBookmarkedArticlesParams{
+		UserID: s.userID,
+		Limit:  3,
+	}).Return(articles, nil).Once()
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/me/bookmarks?pageSize=2", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 2)
+	s.Require().True(gjson.Get(res, "items.0.bookmarked").Bool())
+	s.Require().Equal(date.Format(time.RFC3339), gjson.Get(res, "items.0.bookmarkedAt").String())
+	nextPageToken := gjson.Get(res, "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	// The next page must start after the last bookmark of the first page.
+	s.mockStore.On("ListBookmarkedArticles", mock.Anything, store.ListBookmarkedArticlesParams{
+		UserID: s.userID,
+		Limit:  3,
+		After: &store.BookmarkCursor{
+			BookmarkedAt: articles[1].BookmarkedAt,
+			ID:           articles[1].ID,
+		},
+	}).Return(articles[2:], nil).Once()
+
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/me/bookmarks?pageSize=2&pageToken="+nextPageToken, nil))
+
+	res = w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().False(gjson.Get(res, "nextPageToken").Exists())
+}
@@ -0,0 +1,391 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+const (
+	CodeCommentNotFound ErrorCode = "comment_not_found"
+)
+
+const (
+	defaultCommentsPageSize = 20
+)
+
+// CommentStore defines the store methods used by the comment controller.
+type CommentStore interface {
+	GetArticleIDBySlug(ctx context.Context, slug string) (uuid.UUID, error)
+	CreateComment(ctx context.Context, params store.CreateCommentParams) (*store.Comment, error)
+	ListComments(ctx context.Context, params store.ListCommentsParams) ([]store.Comment, error)
+	ListCommentReplies(ctx context.Context, commentIDs []uuid.UUID) ([]store.Comment, error)
+	UpdateComment(ctx context.Context, params store.UpdateCommentParams) (*store.Comment, error)
+	DeleteComment(ctx context.Context, id, userID uuid.UUID) error
+	SetCommentModerationStatus(ctx context.Context, id uuid.UUID, status store.CommentModerationStatus) (*store.Comment, error)
+}
+
+type CommentController struct {
+	store CommentStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
+}
+
+func NewCommentController(store CommentStore, pageTokenSecret []byte) *CommentController {
+	return &CommentController{store: store, pageTokenSecret: pageTokenSecret}
+}
+
+// CreateComment adds a comment to a published article, or a reply to another comment of the article.
+func (c *CommentController) CreateComment(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "CommentController.CreateComment")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ArticleCommentsURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req CreateCommentRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	articleID, err := c.store.GetArticleIDBySlug(ctx, uri.Slug)
+	if err != nil {
+		writeCommentErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	params := store.CreateCommentParams{
+		ArticleID: articleID,
+		UserID:    userID,
+		Body:      req.Body,
+	}
+	if req.ParentID != "" {
+		// The ID is validated when binding the request.
+		params.ParentID = uuid.NullUUID{UUID: uuid.MustParse(req.ParentID), Valid: true}
+	}
+
+	comment, err := c.store.CreateComment(ctx, params)
+	if err != nil {
+		writeCommentErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusCreated, newComment(*comment))
+}
+
+// ListComments returns the top-level comments of a published article, oldest first, each with all of its
+// replies nested below it.
+func (c *CommentController) ListComments(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "CommentController.ListComments")
+	defer span.End()
+
+	var req ListCommentsRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	articleID, err := c.store.GetArticleIDBySlug(ctx, req.Slug)
+	if err != nil {
+		writeCommentErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	pageSize := defaultCommentsPageSize
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+
+	// Fetch one extra item to find out whether there is a next page.
+	params := store.ListCommentsParams{
+		ArticleID: articleID,
+		Limit:     pageSize + 1,
+	}
+	if req.PageToken != "" {
+		var pageToken listCommentsPageToken
+		if err := utils.ParsePageToken(c.pageTokenSecret, req.PageToken, &pageToken); err != nil {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+		params.After = &store.CommentCursor{
+			CreatedAt: pageToken.CreatedAt,
+			ID:        pageToken.ID,
+		}
+	}
+
+	roots, err := c.store.ListComments(ctx, params)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	var nextPageToken string
+	if len(roots) > pageSize {
+		roots = roots[:pageSize]
+		last := roots[len(roots)-1]
+		nextPageToken, err = utils.GeneratePageToken(c.pageTokenSecret, listCommentsPageToken{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+		if err != nil {
+			writeUnknownErrorResponse(ginCtx, span, err)
+			return
+		}
+	}
+
+	rootIDs := make([]uuid.UUID, len(roots))
+	for i, root := range roots {
+		rootIDs[i] = root.ID
+	}
+	replies, err := c.store.ListCommentReplies(ctx, rootIDs)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, ListCommentsResponse{
+		Items:         buildCommentTree(roots, replies),
+		NextPageToken: nextPageToken,
+	})
+}
+
+// UpdateComment replaces the body of a comment written by the current user.
+func (c *CommentController) UpdateComment(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "CommentController.UpdateComment")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri CommentURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req UpdateCommentRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	comment, err := c.store.UpdateComment(ctx, store.UpdateCommentParams{
+		ID:     uuid.MustParse(uri.ID),
+		UserID: userID,
+		Body:   req.Body,
+	})
+	if err != nil {
+		writeCommentErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newComment(*comment))
+}
+
+// DeleteComment deletes a comment written by the current user. Its replies are kept.
+func (c *CommentController) DeleteComment(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "CommentController.DeleteComment")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri CommentURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.DeleteComment(ctx, uuid.MustParse(uri.ID), userID); err != nil {
+		writeCommentErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// Moderate changes the moderation status of a comment. It must only be reachable by moderators.
+func (c *CommentController) Moderate(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "CommentController.Moderate")
+	defer span.End()
+
+	var uri CommentURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req ModerateCommentRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The ID is validated when binding the request.
+	comment, err := c.store.SetCommentModerationStatus(ctx, uuid.MustParse(uri.ID),
+		store.CommentModerationStatus(req.Status))
+	if err != nil {
+		writeCommentErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newComment(*comment))
+}
+
+func writeCommentErrorResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	switch {
+	case errors.Is(err, store.ErrArticleNotFound):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeArticleNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+	case errors.Is(err, store.ErrCommentNotFound):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeCommentNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+	default:
+		writeUnknownErrorResponse(ginCtx, span, err)
+	}
+}
+
+// buildCommentTree nests each reply below its parent. Replies keep the order in which they are given.
+func buildCommentTree(roots, replies []store.Comment) []Comment {
+	children := make(map[uuid.UUID][]store.Comment)
+	for _, reply := range replies {
+		children[reply.ParentID.UUID] = append(children[reply.ParentID.UUID], reply)
+	}
+
+	var build func(comments []store.Comment) []Comment
+	build = func(comments []store.Comment) []Comment {
+		result := make([]Comment, len(comments))
+		for i, comment := range comments {
+			result[i] = newComment(comment)
+			result[i].Replies = build(children[comment.ID])
+		}
+		return result
+	}
+
+	return build(roots)
+}
+
+// newComment converts a store comment. The body and author of deleted or hidden comments are left out,
+// so that they are only shown as placeholders in their thread.
+func newComment(comment store.Comment) Comment {
+	result := Comment{
+		ID:               comment.ID,
+		ModerationStatus: string(comment.ModerationStatus),
+		Deleted:          comment.DeletedAt.Valid,
+		CreatedAt:        comment.CreatedAt,
+		EditedAt:         nullTimePtr(comment.EditedAt),
+		Replies:          []Comment{},
+	}
+	if comment.ParentID.Valid {
+		result.ParentID = &comment.ParentID.UUID
+	}
+	if result.Deleted || comment.ModerationStatus == store.CommentModerationStatusHidden {
+		return result
+	}
+
+	result.Body = comment.Body
+	result.Author = &CommentAuthor{
+		ID:          comment.AuthorID,
+		Username:    comment.AuthorUsername,
+		DisplayName: comment.AuthorDisplayName.String,
+		AvatarURL:   comment.AuthorAvatarURL.String,
+	}
+	return result
+}
+
+type CommentAuthor = ArticlePreviewAuthor
+
+type Comment struct {
+	ID       uuid.UUID  `json:"id"`
+	ParentID *uuid.UUID `json:"parentID,omitempty"`
+	// Body is empty if the comment is deleted or hidden.
+	Body string `json:"body"`
+	// Author is not set if the comment is deleted or hidden.
+	Author *CommentAuthor `json:"author,omitempty"`
+	// ModerationStatus is one of "visible", "flagged" or "hidden".
+	ModerationStatus string     `json:"moderationStatus"`
+	Deleted          bool       `json:"deleted"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	EditedAt         *time.Time `json:"editedAt,omitempty"`
+	Replies          []Comment  `json:"replies"`
+}
+
+// ArticleCommentsURI holds the path parameters of the endpoints under an article's comments.
+type ArticleCommentsURI struct {
+	Slug string `uri:"slug"`
+}
+
+type CreateCommentRequest struct {
+	// ParentID is the ID of the comment to reply to. It is empty for top-level comments.
+	ParentID string `json:"parentID" binding:"omitempty,uuid"`
+	Body     string `json:"body" binding:"required,max=10000"`
+}
+
+type ListCommentsRequest struct {
+	Slug      string `uri:"slug" form:"-"`
+	PageToken string `form:"pageToken"`
+	PageSize  *int   `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+type ListCommentsResponse struct {
+	Items []Comment `json:"items"`
+	// NextPageToken is empty if there are no more results.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// listCommentsPageToken is the content of the page token returned by ListComments.
+// It holds the sort key of the last top-level comment in the current page.
+type listCommentsPageToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// CommentURI holds the path parameters of the endpoints under a comment.
+type CommentURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,max=10000"`
+}
+
+type ModerateCommentRequest struct {
+	Status string `json:"status" binding:"required,oneof=visible flagged hidden"`
+}
@@ -0,0 +1,215 @@
+package controller_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestCommentController(t *testing.T) {
+	suite.Run(t, new(CommentControllerTestSuite))
+}
+
+type CommentControllerTestSuite struct {
+	suite.Suite
+	mockStore          *controller.MockCommentStore
+	mockModeratorStore *controller.MockModeratorStore
+	tokenIssuer        *token.AccessTokenIssuer
+	router             *gin.Engine
+	userID             uuid.UUID
+	articleID          uuid.UUID
+}
+
+func (s *CommentControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *CommentControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockCommentStore(s.T())
+	s.mockModeratorStore = controller.NewMockModeratorStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.articleID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewCommentController(s.mockStore, []byte("test-page-token-secret"))
+	authMiddleware := controller.AuthMiddleware(s.tokenIssuer)
+	s.router.GET("/v1/articles/:slug/comments", ctrl.ListComments)
+	s.router.POST("/v1/articles/:slug/comments", authMiddleware, ctrl.CreateComment)
+	s.router.PATCH("/v1/comments/:id", authMiddleware, ctrl.UpdateComment)
+	s.router.DELETE("/v1/comments/:id", authMiddleware, ctrl.DeleteComment)
+	s.router.PUT("/v1/comments/:id/moderation", authMiddleware,
+		controller.ModeratorMiddleware(s.mockModeratorStore), ctrl.Moderate)
+}
+
+func (s *CommentControllerTestSuite) TestCreateComment_Success() {
+	parentID := uuid.New()
+	s.mockStore.On("GetArticleIDBySlug", mock.Anything, "go-generics").Return(s.articleID, nil)
+	s.mockStore.On("CreateComment", mock.Anything, store.CreateCommentParams{
+		ArticleID: s.articleID,
+		ParentID:  uuid.NullUUID{UUID: parentID, Valid: true},
+		UserID:    s.userID,
+		Body:      "Great article!",
+	}).Return(&store.Comment{
+		ID:               uuid.New(),
+		ArticleID:        s.articleID,
+		ParentID:         uuid.NullUUID{UUID: parentID, Valid: true},
+		Body:             "Great article!",
+		ModerationStatus: store.CommentModerationStatusVisible,
+		AuthorID:         s.userID,
+		AuthorUsername:   "gopher",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/comments",
+		strings.NewReader(`{"parentID": "`+parentID.String()+`", "body": "Great article!"}`)))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusCreated, w.Code)
+	s.Require().Equal("Great article!", gjson.Get(res, "body").String())
+	s.Require().Equal(parentID.String(), gjson.Get(res, "parentID").String())
+	s.Require().Equal("gopher", gjson.Get(res, "author.username").String())
+}
+
+func (s *CommentControllerTestSuite) TestCreateComment_ArticleNotFound() {
+	s.mockStore.On("GetArticleIDBySlug", mock.Anything, "missing").Return(uuid.Nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/missing/comments", strings.NewReader(`{"body": "Hello"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *CommentControllerTestSuite) TestCreateComment_EmptyBody() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/comments", strings.NewReader(`{}`)))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *CommentControllerTestSuite) TestListComments_Threaded() {
+	now := time.Now()
+	root := store.Comment{ID: uuid.New(), Body: "Root", AuthorUsername: "alice", CreatedAt: now}
+	deletedReply := store.Comment{
+		ID:        uuid.New(),
+		ParentID:  uuid.NullUUID{UUID: root.ID, Valid: true},
+		Body:      "Removed",
+		DeletedAt: sql.NullTime{Time: now, Valid: true},
+	}
+	nestedReply := store.Comment{
+		ID:             uuid.New(),
+		ParentID:       uuid.NullUUID{UUID: deletedReply.ID, Valid: true},
+		Body:           "Nested",
+		AuthorUsername: "bob",
+	}
+	hiddenReply := store.Comment{
+		ID:               uuid.New(),
+		ParentID:         uuid.NullUUID{UUID: root.ID, Valid: true},
+		Body:             "Spam",
+		ModerationStatus: store.CommentModerationStatusHidden,
+	}
+	s.mockStore.On("GetArticleIDBySlug", mock.Anything, "go-generics").Return(s.articleID, nil)
+	s.mockStore.On("ListComments", mock.Anything, store.ListCommentsParams{
+		ArticleID: s.articleID,
+		Limit:     2,
+	}).Return([]store.Comment{root, {ID: uuid.New(), CreatedAt: now}}, nil)
+	s.mockStore.On("ListCommentReplies", mock.Anything, []uuid.UUID{root.ID}).
+		Return([]store.Comment{deletedReply, nestedReply, hiddenReply}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/comments?pageSize=1", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().Equal("Root", gjson.Get(res, "items.0.body").String())
+	s.Require().True(gjson.Get(res, "items.0.replies.0.deleted").Bool())
+	s.Require().Empty(gjson.Get(res, "items.0.replies.0.body").String())
+	s.Require().False(gjson.Get(res, "items.0.replies.0.author").Exists())
+	s.Require().Equal("Nested", gjson.Get(res, "items.0.replies.0.replies.0.body").String())
+	s.Require().Equal("hidden", gjson.Get(res, "items.0.replies.1.moderationStatus").String())
+	s.Require().Empty(gjson.Get(res, "items.0.replies.1.body").String())
+	s.Require().NotEmpty(gjson.Get(res, "nextPageToken").String())
+}
+
+func (s *CommentControllerTestSuite) TestListComments_InvalidPageToken() {
+	s.mockStore.On("GetArticleIDBySlug", mock.Anything, "go-generics").Return(s.articleID, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/comments?pageToken=invalid", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+	s.Require().Equal(string(controller.CodeInvalidPageToken), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *CommentControllerTestSuite) TestUpdateComment_NotAuthor() {
+	commentID := uuid.New()
+	s.mockStore.On("UpdateComment", mock.Anything, store.UpdateCommentParams{
+		ID:     commentID,
+		UserID: s.userID,
+		Body:   "Edited",
+	}).Return(nil, store.ErrCommentNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PATCH", "/v1/comments/"+commentID.String(), strings.NewReader(`{"body": "Edited"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeCommentNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *CommentControllerTestSuite) TestDeleteComment_Success() {
+	commentID := uuid.New()
+	s.mockStore.On("DeleteComment", mock.Anything, commentID, s.userID).Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"DELETE", "/v1/comments/"+commentID.String(), nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *CommentControllerTestSuite) TestModerate_Success() {
+	commentID := uuid.New()
+	s.mockModeratorStore.On("GetUserByID", mock.Anything, s.userID.String()).
+		Return(&store.User{ID: s.userID, Role: store.UserRoleModerator}, nil)
+	s.mockStore.On("SetCommentModerationStatus", mock.Anything, commentID, store.CommentModerationStatusHidden).
+		Return(&store.Comment{ID: commentID, ModerationStatus: store.CommentModerationStatusHidden}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/comments/"+commentID.String()+"/moderation", strings.NewReader(`{"status": "hidden"}`)))
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("hidden", gjson.Get(w.Body.String(), "moderationStatus").String())
+}
+
+func (s *CommentControllerTestSuite) TestModerate_NotModerator() {
+	s.mockModeratorStore.On("GetUserByID", mock.Anything, s.userID.String()).
+		Return(&store.User{ID: s.userID, Role: store.UserRoleMember}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/comments/"+uuid.NewString()+"/moderation", strings.NewReader(`{"status": "hidden"}`)))
+
+	s.Require().Equal(http.StatusForbidden, w.Code)
+	s.Require().Equal(string(controller.CodeForbidden), gjson.Get(w.Body.String(), "errorCode").String())
+}
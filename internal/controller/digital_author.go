@@ -13,7 +13,7 @@ import (
 )
 
 type DigitalAuthorStore interface {
-	ListDigitalAuthors(ctx context.Context) ([]*store.DigitalAuthor, error)
+	ListDigitalAuthors(ctx context.Context, viewerID uuid.UUID) ([]*store.DigitalAuthor, error)
 	CreateDigitalAuthor(ctx context.Context, params store.CreateDigitalAuthorParams) (*store.DigitalAuthor, error)
 }
 
@@ -32,7 +32,7 @@ func (c *DigitalAuthorController) ListDigitalAuthors(ginCtx *gin.Context) {
 		"DigitalAuthorController.ListDigitalAuthors")
 	defer span.End()
 
-	digitalAuthors, err := c.store.ListDigitalAuthors(ctx)
+	digitalAuthors, err := c.store.ListDigitalAuthors(ctx, getOptionalContextUserUUID(ginCtx, span))
 	if err != nil {
 		writeUnknownErrorResponse(ginCtx, span, err)
 		return
@@ -47,6 +47,8 @@ func (c *DigitalAuthorController) ListDigitalAuthors(ginCtx *gin.Context) {
 			DisplayName:  da.DisplayName,
 			SystemPrompt: da.SystemPrompt,
 			CreatedAt:    da.CreatedAt,
+			Followers:    da.FollowerCount,
+			Following:    da.ViewerFollowing,
 		})
 	}
 
@@ -58,15 +60,22 @@ func (c *DigitalAuthorController) CreateDigitalAuthor(ginCtx *gin.Context) {
 		"DigitalAuthorController.CreateDigitalAuthor")
 	defer span.End()
 
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
 	var req CreateDigitalAuthorRequest
 	if err := ginCtx.ShouldBindJSON(&req); err != nil {
 		writeBindingErrorResponse(ginCtx, span, err)
 		return
 	}
 
+	// The creator of a digital author becomes its owner, who reviews the articles it generates.
 	da, err := c.store.CreateDigitalAuthor(ctx, store.CreateDigitalAuthorParams{
 		DisplayName:  req.DisplayName,
 		SystemPrompt: req.SystemPrompt,
+		OwnerID:      userID,
 	})
 	if err != nil {
 		writeUnknownErrorResponse(ginCtx, span, err)
@@ -98,4 +107,8 @@ type DigitalAuthor struct {
 	// TODO: limit length
 	SystemPrompt string    `json:"systemPrompt"`
 	CreatedAt    time.Time `json:"createdAt"`
+	// Followers is the number of users following the digital author.
+	Followers int `json:"followers"`
+	// Following reports whether the current user follows the digital author. It is always false for anonymous users.
+	Following bool `json:"following"`
 }
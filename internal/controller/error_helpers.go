@@ -23,6 +23,7 @@ const (
 	CodeUnknown             ErrorCode = "unknown"
 	CodeBindingRequestError ErrorCode = "binding_request_error"
 	CodeUnauthorized        ErrorCode = "unauthorized"
+	CodeForbidden           ErrorCode = "forbidden"
 )
 
 // writeErrorResponse writes an HTTP response which signify that an error has occurred.
@@ -36,6 +37,11 @@ func writeErrorResponse(ginCtx *gin.Context, params writeErrorResponseParams) {
 	if statusCode == 0 {
 		statusCode = http.StatusBadRequest
 	}
+	// Errors may go away at any time, e.g. when a scheduled article is published or the server recovers, so
+	// caches must not keep them instead of retrying. This replaces the policy of CacheControlMiddleware.
+	if statusCode >= http.StatusBadRequest {
+		ginCtx.Header("Cache-Control", "no-store")
+	}
 
 	ginCtx.JSON(statusCode, params.Body)
 }
@@ -75,3 +81,16 @@ func writeUnknownErrorResponse(ginCtx *gin.Context, span trace.Span, err error)
 		StatusCode: http.StatusInternalServerError,
 	})
 }
+
+// writeInvalidPageTokenResponse writes an HTTP response when the page token in the request cannot be parsed.
+func writeInvalidPageTokenResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	writeErrorResponse(ginCtx, writeErrorResponseParams{
+		Body: ErrorResponse{
+			Code:    CodeInvalidPageToken,
+			Message: err.Error(),
+		},
+		Span:       span,
+		Err:        err,
+		StatusCode: http.StatusBadRequest,
+	})
+}
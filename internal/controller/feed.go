@@ -0,0 +1,250 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/feed"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const (
+	rssContentType  = "application/rss+xml; charset=utf-8"
+	atomContentType = "application/atom+xml; charset=utf-8"
+	// atomExtension is the extension of the file name in the URL of the feed of a digital author.
+	atomExtension = ".atom"
+	// articlesFeedID is the Atom ID of the feed of all articles. It must never change.
+	articlesFeedID = "urn:uuid:6f0d3b4e-2a7c-4e51-9d8a-1c5b7e3f9a20"
+)
+
+// FeedStore defines the store methods used by the feed controller.
+type FeedStore interface {
+	ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)
+	ListArticlesHTMLContent(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error)
+	GetDigitalAuthor(ctx context.Context, id uuid.UUID) (*store.DigitalAuthor, error)
+	GetArticlesLastUpdatedAt(ctx context.Context, authorID uuid.UUID) (time.Time, error)
+}
+
+// FeedController serves the latest published articles as RSS and Atom feeds, for feed readers.
+type FeedController struct {
+	store FeedStore
+	// baseURL is the URL of the website, which the links in the feeds point to.
+	baseURL string
+	// apiBaseURL is the public URL of the API, where the feeds are. The feeds link to themselves from it rather
+	// than from the headers of the request, since the feeds are cached for all readers.
+	apiBaseURL string
+	// itemCount is the number of articles in each feed.
+	itemCount int
+}
+
+func NewFeedController(store FeedStore, baseURL string, apiBaseURL string, itemCount int) *FeedController {
+	return &FeedController{
+		store:      store,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiBaseURL: strings.TrimSuffix(apiBaseURL, "/"),
+		itemCount:  itemCount,
+	}
+}
+
+// ArticlesRSS returns the latest published articles as an RSS 2.0 feed.
+func (c *FeedController) ArticlesRSS(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "FeedController.ArticlesRSS")
+	defer span.End()
+
+	f, err := c.articlesFeed(ctx, "/feeds/articles.rss")
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	writeFeed(ginCtx, span, f, feed.RSS, rssContentType)
+}
+
+// ArticlesAtom returns the latest published articles as an Atom 1.0 feed.
+func (c *FeedController) ArticlesAtom(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "FeedController.ArticlesAtom")
+	defer span.End()
+
+	f, err := c.articlesFeed(ctx, "/feeds/articles.atom")
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	writeFeed(ginCtx, span, f, feed.Atom, atomContentType)
+}
+
+// AuthorAtom returns the latest published articles of a digital author as an Atom 1.0 feed.
+func (c *FeedController) AuthorAtom(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "FeedController.AuthorAtom")
+	defer span.End()
+
+	var uri AuthorFeedURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	// The route matches the whole file name, as gin does not support a parameter followed by a suffix.
+	authorID, err := uuid.Parse(strings.TrimSuffix(uri.File, atomExtension))
+	if err != nil || !strings.HasSuffix(uri.File, atomExtension) {
+		writeDigitalAuthorNotFoundResponse(ginCtx, span, store.ErrDigitalAuthorNotFound)
+		return
+	}
+
+	author, err := c.store.GetDigitalAuthor(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, store.ErrDigitalAuthorNotFound) {
+			writeDigitalAuthorNotFoundResponse(ginCtx, span, err)
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	items, updated, err := c.listItems(ctx, authorID)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	writeFeed(ginCtx, span, feed.Feed{
+		ID:          "urn:uuid:" + author.ID.String(),
+		Title:       author.DisplayName + " on Brevity",
+		Link:        digitalAuthorPageURL(c.baseURL, author.ID.String()),
+		SelfLink:    c.apiBaseURL + "/feeds/authors/" + author.ID.String() + atomExtension,
+		Description: "The latest articles of " + author.DisplayName + " on Brevity",
+		Updated:     updated,
+		Items:       items,
+	}, feed.Atom, atomContentType)
+}
+
+// articlesFeed builds the feed of the latest published articles of all digital authors, which is served at
+// selfPath.
+func (c *FeedController) articlesFeed(ctx context.Context, selfPath string) (feed.Feed, error) {
+	items, updated, err := c.listItems(ctx, uuid.Nil)
+	if err != nil {
+		return feed.Feed{}, err
+	}
+
+	return feed.Feed{
+		ID:          articlesFeedID,
+		Title:       "Brevity",
+		Link:        c.baseURL,
+		SelfLink:    c.apiBaseURL + selfPath,
+		Description: "The latest articles on Brevity",
+		Updated:     updated,
+		Items:       items,
+	}, nil
+}
+
+// listItems returns the latest published articles, of the given digital author if authorID is not uuid.Nil,
+// as feed items, along with the last time the feed changed. Like other lists of articles, the feed changes when
+// any article which may be in it is updated, including articles which left it, see newCollectionCacheValidator.
+func (c *FeedController) listItems(ctx context.Context, authorID uuid.UUID) ([]feed.Item, time.Time, error) {
+	previews, err := c.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		AuthorID: authorID,
+		Limit:    c.itemCount,
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	ids := make([]uuid.UUID, len(previews))
+	for i, preview := range previews {
+		ids[i] = preview.ID
+	}
+	contents, err := c.store.ListArticlesHTMLContent(ctx, ids)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	lastUpdated, err := c.store.GetArticlesLastUpdatedAt(ctx, authorID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	items := make([]feed.Item, len(previews))
+	for i, preview := range previews {
+		categories := make([]string, len(preview.Tags))
+		for j, tag := range preview.Tags {
+			categories[j] = tag.Name
+		}
+		published := preview.ListedAt()
+		// Scheduled articles are published after their last update, which must not predate the publication.
+		updated := preview.UpdatedAt
+		if published.After(updated) {
+			updated = published
+		}
+
+		items[i] = feed.Item{
+			ID:          "urn:uuid:" + preview.ID.String(),
+			Title:       preview.Title,
+			Link:        articlePageURL(c.baseURL, preview.Slug),
+			Description: preview.Description,
+			ContentHTML: contents[preview.ID],
+			AuthorName:  preview.AuthorDisplayName.String,
+			Categories:  categories,
+			Published:   published.UTC(),
+			Updated:     updated.UTC(),
+		}
+		if updated.After(lastUpdated) {
+			lastUpdated = updated
+		}
+	}
+
+	return items, lastUpdated.UTC(), nil
+}
+
+// writeFeed renders the feed with the given function and writes it with its ETag and Last-Modified headers.
+// If the client already has the current feed, according to its conditional headers, 304 Not Modified is
+// returned instead.
+func writeFeed(ginCtx *gin.Context, span trace.Span, f feed.Feed, render func(feed.Feed) ([]byte, error),
+	contentType string) {
+	body, err := render(f)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	// HTTP dates have a precision of one second.
+	lastModified := f.LastUpdated().UTC().Truncate(time.Second)
+	ginCtx.Header("ETag", etag)
+	ginCtx.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+
+	if isNotModified(ginCtx.Request, etag, lastModified) {
+		ginCtx.Status(http.StatusNotModified)
+		return
+	}
+
+	ginCtx.Data(http.StatusOK, contentType, body)
+}
+
+func writeDigitalAuthorNotFoundResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	writeErrorResponse(ginCtx, writeErrorResponseParams{
+		Body: ErrorResponse{
+			Code:    CodeDigitalAuthorNotFound,
+			Message: err.Error(),
+		},
+		Span:       span,
+		Err:        err,
+		StatusCode: http.StatusNotFound,
+	})
+}
+
+type AuthorFeedURI struct {
+	// File is the file name of the feed, which is the ID of the digital author followed by ".atom".
+	File string `uri:"file" binding:"required"`
+}
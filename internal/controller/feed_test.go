@@ -0,0 +1,204 @@
+package controller_test
+
+import (
+	"database/sql"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestFeedController(t *testing.T) {
+	suite.Run(t, new(FeedControllerTestSuite))
+}
+
+type FeedControllerTestSuite struct {
+	suite.Suite
+	mockStore *controller.MockFeedStore
+	router    *gin.Engine
+	preview   store.ArticlePreview
+}
+
+func (s *FeedControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *FeedControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockFeedStore(s.T())
+	s.router = gin.Default()
+	ctrl := controller.NewFeedController(s.mockStore, "https://brevity.example.com/",
+		"https://api.brevity.example.com/", 10)
+	s.router.GET("/feeds/articles.rss", ctrl.ArticlesRSS)
+	s.router.GET("/feeds/articles.atom", ctrl.ArticlesAtom)
+	s.router.GET("/feeds/authors/:file", ctrl.AuthorAtom)
+
+	s.preview = store.ArticlePreview{
+		ID:                uuid.New(),
+		Slug:              "go-generics",
+		Title:             "Go generics",
+		Description:       "A short introduction",
+		AuthorID:          uuid.New(),
+		AuthorDisplayName: sql.NullString{String: "Gopher Bot", Valid: true},
+		Tags:              store.TagList{{ID: uuid.New(), Slug: "go", Name: "Go"}},
+		Status:            store.ArticleStatusPublished,
+		PublishedAt:       sql.NullTime{Time: time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC), Valid: true},
+		CreatedAt:         time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:         time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *FeedControllerTestSuite) mockArticles(authorID uuid.UUID) {
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		AuthorID: authorID,
+		Limit:    10,
+	}).Return([]store.ArticlePreview{s.preview}, nil)
+	s.mockStore.On("ListArticlesHTMLContent", mock.Anything, []uuid.UUID{s.preview.ID}).
+		Return(map[uuid.UUID]string{s.preview.ID: "<p>Type parameters.</p>"}, nil)
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, authorID).Return(s.preview.UpdatedAt, nil)
+}
+
+func (s *FeedControllerTestSuite) TestArticlesRSS() {
+	s.mockArticles(uuid.Nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/feeds/articles.rss", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	s.Require().NotEmpty(w.Header().Get("ETag"))
+	// The article was last updated when its scheduled publication came.
+	s.Require().Equal("Fri, 02 Oct 2026 09:00:00 GMT", w.Header().Get("Last-Modified"))
+
+	var doc struct {
+		Items []struct {
+			Link    string `xml:"link"`
+			PubDate string `xml:"pubDate"`
+			Content string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+		} `xml:"channel>item"`
+	}
+	s.Require().NoError(xml.Unmarshal(w.Body.Bytes(), &doc))
+	s.Require().Len(doc.Items, 1)
+	s.Require().Equal("https://brevity.example.com/articles/go-generics", doc.Items[0].Link)
+	s.Require().Equal("Fri, 02 Oct 2026 09:00:00 +0000", doc.Items[0].PubDate)
+	s.Require().Equal("<p>Type parameters.</p>", doc.Items[0].Content)
+}
+
+func (s *FeedControllerTestSuite) TestArticlesAtom_NotModified() {
+	s.mockArticles(uuid.Nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/feeds/articles.atom", nil)
+	s.router.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("application/atom+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	etag := w.Header().Get("ETag")
+
+	for _, header := range []http.Header{
+		{"If-None-Match": {etag}},
+		{"If-None-Match": {`"other", W/` + etag}},
+		{"If-Modified-Since": {"Fri, 02 Oct 2026 09:00:00 GMT"}},
+		{"If-Modified-Since": {"Sat, 03 Oct 2026 09:00:00 GMT"}},
+	} {
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("GET", "/feeds/articles.atom", nil)
+		req.Header = header
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusNotModified, w.Code, header)
+		s.Require().Empty(w.Body.String())
+		s.Require().Equal(etag, w.Header().Get("ETag"))
+	}
+}
+
+func (s *FeedControllerTestSuite) TestArticlesAtom_Modified() {
+	s.mockArticles(uuid.Nil)
+
+	for _, header := range []http.Header{
+		{"If-None-Match": {`"other"`}},
+		// If-Modified-Since is ignored when If-None-Match is given.
+		{"If-None-Match": {`"other"`}, "If-Modified-Since": {"Sat, 03 Oct 2026 09:00:00 GMT"}},
+		{"If-Modified-Since": {"Thu, 01 Oct 2026 09:00:00 GMT"}},
+		{"If-Modified-Since": {"not a date"}},
+	} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/feeds/articles.atom", nil)
+		req.Header = header
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusOK, w.Code, header)
+		s.Require().NotEmpty(w.Body.String())
+	}
+}
+
+func (s *FeedControllerTestSuite) TestArticlesAtom_ArticleLeftFeed() {
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{Limit: 10}).
+		Return([]store.ArticlePreview{}, nil)
+	s.mockStore.On("ListArticlesHTMLContent", mock.Anything, []uuid.UUID{}).Return(map[uuid.UUID]string{}, nil)
+	// The only article of the feed was deleted, which bumped its update time.
+	s.mockStore.On("GetArticlesLastUpdatedAt", mock.Anything, uuid.Nil).
+		Return(time.Date(2026, 10, 4, 9, 0, 0, 0, time.UTC), nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/feeds/articles.atom", nil)
+	req.Header.Set("If-Modified-Since", "Sat, 03 Oct 2026 09:00:00 GMT")
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Sun, 04 Oct 2026 09:00:00 GMT", w.Header().Get("Last-Modified"))
+}
+
+func (s *FeedControllerTestSuite) TestAuthorAtom() {
+	authorID := s.preview.AuthorID
+	s.mockStore.On("GetDigitalAuthor", mock.Anything, authorID).
+		Return(&store.DigitalAuthor{ID: authorID, DisplayName: "Gopher Bot"}, nil)
+	s.mockArticles(authorID)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/feeds/authors/"+authorID.String()+".atom", nil)
+	// The self link does not depend on the headers of the request, which may be forged.
+	req.Host = "attacker.example.com"
+	req.Header.Set("X-Forwarded-Proto", "gopher")
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	var doc struct {
+		ID    string `xml:"id"`
+		Title string `xml:"title"`
+		Links []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Entries []struct {
+			ID string `xml:"id"`
+		} `xml:"entry"`
+	}
+	s.Require().NoError(xml.Unmarshal(w.Body.Bytes(), &doc))
+	s.Require().Equal("urn:uuid:"+authorID.String(), doc.ID)
+	s.Require().Equal("Gopher Bot on Brevity", doc.Title)
+	s.Require().Contains(doc.Links, struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	}{Href: "https://api.brevity.example.com/feeds/authors/" + authorID.String() + ".atom", Rel: "self"})
+	s.Require().Len(doc.Entries, 1)
+	s.Require().Equal("urn:uuid:"+s.preview.ID.String(), doc.Entries[0].ID)
+}
+
+func (s *FeedControllerTestSuite) TestAuthorAtom_NotFound() {
+	authorID := uuid.New()
+	s.mockStore.On("GetDigitalAuthor", mock.Anything, authorID).Return(nil, store.ErrDigitalAuthorNotFound)
+
+	for _, file := range []string{authorID.String() + ".atom", authorID.String() + ".rss", "gopher.atom"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/feeds/authors/"+file, nil)
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusNotFound, w.Code, file)
+	}
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+// FollowStore defines the store methods used by the follow controller.
+type FollowStore interface {
+	FollowDigitalAuthor(ctx context.Context, userID, authorID uuid.UUID) error
+	UnfollowDigitalAuthor(ctx context.Context, userID, authorID uuid.UUID) error
+	ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)
+}
+
+// FollowController lets users follow digital authors and read the articles of the authors they follow.
+type FollowController struct {
+	store FollowStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
+}
+
+func NewFollowController(store FollowStore, pageTokenSecret []byte) *FollowController {
+	return &FollowController{store: store, pageTokenSecret: pageTokenSecret}
+}
+
+// Follow makes the current user follow a digital author. Following a digital author twice has no effect.
+func (c *FollowController) Follow(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "FollowController.Follow")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req FollowRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.FollowDigitalAuthor(ctx, userID, uuid.MustParse(req.ID)); err != nil {
+		if errors.Is(err, store.ErrDigitalAuthorNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeDigitalAuthorNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// Unfollow makes the current user stop following a digital author. Unfollowing a digital author which is not
+// followed has no effect.
+func (c *FollowController) Unfollow(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "FollowController.Unfollow")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req FollowRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.UnfollowDigitalAuthor(ctx, userID, uuid.MustParse(req.ID)); err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// ListFeed returns the previews of the articles of the digital authors followed by the current user, most recently
+// published first.
+func (c *FollowController) ListFeed(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "FollowController.ListFeed")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req PreviewsPageRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response, err := listPreviewsPage(ctx, c.store.ListArticlesPreviews, c.pageTokenSecret, req,
+		store.ListArticlesPreviewsParams{
+			FollowerID: userID,
+			ViewerID:   userID,
+		})
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidPageToken) {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+type FollowRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
@@ -0,0 +1,137 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestFollowController(t *testing.T) {
+	suite.Run(t, new(FollowControllerTestSuite))
+}
+
+type FollowControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockFollowStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *FollowControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *FollowControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockFollowStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewFollowController(s.mockStore, testPageTokenSecret)
+	authMiddleware := controller.AuthMiddleware(s.tokenIssuer)
+	s.router.POST("/v1/digital-authors/:id/follow", authMiddleware, ctrl.Follow)
+	s.router.DELETE("/v1/digital-authors/:id/follow", authMiddleware, ctrl.Unfollow)
+	s.router.GET("/v1/me/feed", authMiddleware, ctrl.ListFeed)
+}
+
+func (s *FollowControllerTestSuite) TestFollow_Success() {
+	authorID := uuid.New()
+	s.mockStore.On("FollowDigitalAuthor", mock.Anything, s.userID, authorID).Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/digital-authors/"+authorID.String()+"/follow", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *FollowControllerTestSuite) TestFollow_DigitalAuthorNotFound() {
+	authorID := uuid.New()
+	s.mockStore.On("FollowDigitalAuthor", mock.Anything, s.userID, authorID).Return(store.ErrDigitalAuthorNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/digital-authors/"+authorID.String()+"/follow", nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeDigitalAuthorNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *FollowControllerTestSuite) TestFollow_InvalidID() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/digital-authors/not-a-uuid/follow", nil))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *FollowControllerTestSuite) TestUnfollow_Success() {
+	authorID := uuid.New()
+	s.mockStore.On("UnfollowDigitalAuthor", mock.Anything, s.userID, authorID).Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"DELETE", "/v1/digital-authors/"+authorID.String()+"/follow", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *FollowControllerTestSuite) TestListFeed_Pagination() {
+	date := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	articles := make([]store.ArticlePreview, 3)
+	for i := range articles {
+		articles[i] = store.ArticlePreview{ID: uuid.New(), Slug: "article", CreatedAt: date.Add(-time.Duration(i) * time.Hour)}
+	}
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		FollowerID: s.userID,
+		ViewerID:   s.userID,
+		Limit:      3,
+	}).Return(articles, nil).Once()
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET", "/v1/me/feed?pageSize=2", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 2)
+	nextPageToken := gjson.Get(res, "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		FollowerID: s.userID,
+		ViewerID:   s.userID,
+		Limit:      3,
+		After: &store.ArticlePreviewCursor{
+			PublishedAt: articles[1].ListedAt(),
+			ID:          articles[1].ID,
+		},
+	}).Return(articles[2:], nil).Once()
+
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/me/feed?pageSize=2&pageToken="+nextPageToken, nil))
+
+	res = w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().False(gjson.Get(res, "nextPageToken").Exists())
+}
+
+func (s *FollowControllerTestSuite) TestListFeed_Unauthorized() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/me/feed", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusUnauthorized, w.Code)
+}
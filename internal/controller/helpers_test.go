@@ -0,0 +1,27 @@
+package controller_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+// newAuthenticatedRequest returns a request signed in as the given user with an access token from issuer.
+func newAuthenticatedRequest(t *testing.T, issuer *token.AccessTokenIssuer, userID uuid.UUID, method, url string,
+	body io.Reader) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, body)
+	require.NoError(t, err)
+
+	accessToken, err := issuer.Issue(userID.String())
+	require.NoError(t, err)
+	req.AddCookie(&http.Cookie{Name: "$access_token", Value: accessToken})
+
+	return req
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// privateCacheControl is the Cache-Control policy of the responses to signed-in users. They depend on the user,
+// so shared caches must not store them, and browsers must revalidate them with their ETag before reuse.
+const privateCacheControl = "private, no-cache"
+
+// CacheControlMiddleware sets the Cache-Control header of the responses to anonymous requests to policy, so that
+// browsers and CDNs can cache them. It must run after OptionalAuthMiddleware on routes which depend on the user.
+// No Cache-Control header is set if policy is empty. Error responses are never cached, see writeErrorResponse.
+func CacheControlMiddleware(policy string) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		// The access token cookie identifies the user the response was made for.
+		ginCtx.Header("Vary", "Cookie")
+		if _, err := getContextUserID(ginCtx); err == nil {
+			ginCtx.Header("Cache-Control", privateCacheControl)
+		} else if policy != "" {
+			ginCtx.Header("Cache-Control", policy)
+		}
+
+		ginCtx.Next()
+	}
+}
+
+// cacheValidator computes the ETag and Last-Modified validators of a response from the articles it is derived
+// from, so that conditional requests can be answered without building the response.
+type cacheValidator struct {
+	hash         hash.Hash
+	lastModified time.Time
+}
+
+// newCacheValidator returns the validators of a response about a single article.
+func newCacheValidator() *cacheValidator {
+	return &cacheValidator{hash: sha256.New(), lastModified: time.Unix(0, 0).UTC()}
+}
+
+// newCollectionCacheValidator returns the validators of a response listing articles. The articles in the list do
+// not tell when one left it, e.g. when it was deleted, so the Last-Modified time starts at lastUpdated, the latest
+// update time of all the articles which may be in the list, including hidden ones.
+func newCollectionCacheValidator(lastUpdated time.Time) *cacheValidator {
+	validator := newCacheValidator()
+	if lastUpdated.After(validator.lastModified) {
+		validator.lastModified = lastUpdated
+	}
+	return validator
+}
+
+// addArticle adds an article to the validators. The ETag changes whenever the article is updated, or one of
+// the state values changes. They must include everything in the response which does not bump updated_at, such as
+// the number of claps.
+func (v *cacheValidator) addArticle(id uuid.UUID, updatedAt time.Time, state ...any) {
+	fmt.Fprintf(v.hash, "%s|%d|%v\n", id, updatedAt.UnixNano(), state)
+	if updatedAt.After(v.lastModified) {
+		v.lastModified = updatedAt
+	}
+}
+
+// add adds values other than articles which the response depends on to the ETag, such as request parameters.
+func (v *cacheValidator) add(values ...any) {
+	fmt.Fprintf(v.hash, "%v\n", values)
+}
+
+// etag returns a strong ETag of the response.
+func (v *cacheValidator) etag() string {
+	return `"` + hex.EncodeToString(v.hash.Sum(nil)[:16]) + `"`
+}
+
+// lastModifiedTime returns the Last-Modified time of the response.
+func (v *cacheValidator) lastModifiedTime() time.Time {
+	// HTTP dates have a precision of one second.
+	return v.lastModified.UTC().Truncate(time.Second)
+}
+
+// writeCachedJSON writes the ETag and Last-Modified headers of a response, then writes 304 Not Modified if the
+// client already has the response according to its conditional headers, or else the response built by
+// newResponse.
+func writeCachedJSON(ginCtx *gin.Context, validator *cacheValidator, newResponse func() any) {
+	if writeValidators(ginCtx, validator) {
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newResponse())
+}
+
+// writeCachedData is like writeCachedJSON for responses of other content types. If newBody fails, its error is
+// returned without writing any header, so that the caller can write an error response.
+func writeCachedData(ginCtx *gin.Context, validator *cacheValidator, contentType string,
+	newBody func() ([]byte, error)) error {
+	var body []byte
+	if !isNotModified(ginCtx.Request, validator.etag(), validator.lastModifiedTime()) {
+		var err error
+		body, err = newBody()
+		if err != nil {
+			return err
+		}
+	}
+
+	if writeValidators(ginCtx, validator) {
+		return nil
+	}
+
+	ginCtx.Data(http.StatusOK, contentType, body)
+	return nil
+}
+
+// writeValidators writes the ETag and Last-Modified headers of a response. If the client already has the
+// response according to its conditional headers, it writes 304 Not Modified and returns true.
+func writeValidators(ginCtx *gin.Context, validator *cacheValidator) bool {
+	etag, lastModified := validator.etag(), validator.lastModifiedTime()
+	ginCtx.Header("ETag", etag)
+	ginCtx.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+
+	if isNotModified(ginCtx.Request, etag, lastModified) {
+		ginCtx.Status(http.StatusNotModified)
+		return true
+	}
+
+	return false
+}
+
+// isNotModified reports whether the client already has the representation with the given ETag and last
+// modification time. If-None-Match takes precedence over If-Modified-Since, as specified by RFC 9110.
+func isNotModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := req.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(ifModifiedSince)
+}
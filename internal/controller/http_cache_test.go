@@ -0,0 +1,56 @@
+package controller_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestCacheControlMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tokenIssuer := token.NewIssuer("test-access-token-secret")
+	router := gin.New()
+	router.GET("/ok", controller.OptionalAuthMiddleware(tokenIssuer),
+		controller.CacheControlMiddleware("public, max-age=60"), func(ginCtx *gin.Context) {
+			ginCtx.Status(http.StatusOK)
+		})
+	router.GET("/uncached", controller.CacheControlMiddleware(""), func(ginCtx *gin.Context) {
+		ginCtx.Status(http.StatusOK)
+	})
+	mockStore := controller.NewMockSitemapStore(t)
+	mockStore.On("CountSitemapEntries", mock.Anything).Return(0, errors.New("database is down"))
+	router.GET("/error", controller.CacheControlMiddleware("public, max-age=60"),
+		controller.NewSitemapController(mockStore, "https://brevity.example.com").Sitemap)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/ok", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, "public, max-age=60", w.Header().Get("Cache-Control"))
+	require.Equal(t, "Cookie", w.Header().Get("Vary"))
+
+	// Errors must not be cached.
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/error", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+
+	// Responses to signed-in users must not be stored by shared caches.
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, newAuthenticatedRequest(t, tokenIssuer, uuid.New(), "GET", "/ok", nil))
+	require.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/uncached", nil)
+	router.ServeHTTP(w, req)
+	require.Empty(t, w.Header().Get("Cache-Control"))
+}
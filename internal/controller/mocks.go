@@ -6,11 +6,174 @@ package controller
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/tuananhlai/brevity-go/internal/store"
 )
 
+// NewMockAnalyticsStore creates a new instance of MockAnalyticsStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsStore {
+	mock := &MockAnalyticsStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsStore is an autogenerated mock type for the AnalyticsStore type
+type MockAnalyticsStore struct {
+	mock.Mock
+}
+
+type MockAnalyticsStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsStore) EXPECT() *MockAnalyticsStore_Expecter {
+	return &MockAnalyticsStore_Expecter{mock: &_m.Mock}
+}
+
+// GetDigitalAuthorAnalytics provides a mock function for the type MockAnalyticsStore
+func (_mock *MockAnalyticsStore) GetDigitalAuthorAnalytics(ctx context.Context, params store.GetDigitalAuthorAnalyticsParams) (*store.AuthorAnalytics, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDigitalAuthorAnalytics")
+	}
+
+	var r0 *store.AuthorAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.GetDigitalAuthorAnalyticsParams) (*store.AuthorAnalytics, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.GetDigitalAuthorAnalyticsParams) *store.AuthorAnalytics); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.AuthorAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.GetDigitalAuthorAnalyticsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsStore_GetDigitalAuthorAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDigitalAuthorAnalytics'
+type MockAnalyticsStore_GetDigitalAuthorAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetDigitalAuthorAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.GetDigitalAuthorAnalyticsParams
+func (_e *MockAnalyticsStore_Expecter) GetDigitalAuthorAnalytics(ctx interface{}, params interface{}) *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call {
+	return &MockAnalyticsStore_GetDigitalAuthorAnalytics_Call{Call: _e.mock.On("GetDigitalAuthorAnalytics", ctx, params)}
+}
+
+func (_c *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call) Run(run func(ctx context.Context, params store.GetDigitalAuthorAnalyticsParams)) *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.GetDigitalAuthorAnalyticsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.GetDigitalAuthorAnalyticsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call) Return(authorAnalytics *store.AuthorAnalytics, err error) *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call {
+	_c.Call.Return(authorAnalytics, err)
+	return _c
+}
+
+func (_c *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call) RunAndReturn(run func(ctx context.Context, params store.GetDigitalAuthorAnalyticsParams) (*store.AuthorAnalytics, error)) *MockAnalyticsStore_GetDigitalAuthorAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordArticleEvent provides a mock function for the type MockAnalyticsStore
+func (_mock *MockAnalyticsStore) RecordArticleEvent(ctx context.Context, params store.RecordArticleEventParams) (bool, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordArticleEvent")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.RecordArticleEventParams) (bool, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.RecordArticleEventParams) bool); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.RecordArticleEventParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsStore_RecordArticleEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordArticleEvent'
+type MockAnalyticsStore_RecordArticleEvent_Call struct {
+	*mock.Call
+}
+
+// RecordArticleEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.RecordArticleEventParams
+func (_e *MockAnalyticsStore_Expecter) RecordArticleEvent(ctx interface{}, params interface{}) *MockAnalyticsStore_RecordArticleEvent_Call {
+	return &MockAnalyticsStore_RecordArticleEvent_Call{Call: _e.mock.On("RecordArticleEvent", ctx, params)}
+}
+
+func (_c *MockAnalyticsStore_RecordArticleEvent_Call) Run(run func(ctx context.Context, params store.RecordArticleEventParams)) *MockAnalyticsStore_RecordArticleEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.RecordArticleEventParams
+		if args[1] != nil {
+			arg1 = args[1].(store.RecordArticleEventParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsStore_RecordArticleEvent_Call) Return(b bool, err error) *MockAnalyticsStore_RecordArticleEvent_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAnalyticsStore_RecordArticleEvent_Call) RunAndReturn(run func(ctx context.Context, params store.RecordArticleEventParams) (bool, error)) *MockAnalyticsStore_RecordArticleEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockArticleStore creates a new instance of MockArticleStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockArticleStore(t interface {
@@ -34,48 +197,4050 @@ type MockArticleStore_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockArticleStore) EXPECT() *MockArticleStore_Expecter {
-	return &MockArticleStore_Expecter{mock: &_m.Mock}
+func (_m *MockArticleStore) EXPECT() *MockArticleStore_Expecter {
+	return &MockArticleStore_Expecter{mock: &_m.Mock}
+}
+
+// ClapArticle provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) ClapArticle(ctx context.Context, params store.ClapArticleParams) (*store.ArticleClaps, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClapArticle")
+	}
+
+	var r0 *store.ArticleClaps
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ClapArticleParams) (*store.ArticleClaps, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ClapArticleParams) *store.ArticleClaps); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleClaps)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ClapArticleParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_ClapArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClapArticle'
+type MockArticleStore_ClapArticle_Call struct {
+	*mock.Call
+}
+
+// ClapArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ClapArticleParams
+func (_e *MockArticleStore_Expecter) ClapArticle(ctx interface{}, params interface{}) *MockArticleStore_ClapArticle_Call {
+	return &MockArticleStore_ClapArticle_Call{Call: _e.mock.On("ClapArticle", ctx, params)}
+}
+
+func (_c *MockArticleStore_ClapArticle_Call) Run(run func(ctx context.Context, params store.ClapArticleParams)) *MockArticleStore_ClapArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ClapArticleParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ClapArticleParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_ClapArticle_Call) Return(articleClaps *store.ArticleClaps, err error) *MockArticleStore_ClapArticle_Call {
+	_c.Call.Return(articleClaps, err)
+	return _c
+}
+
+func (_c *MockArticleStore_ClapArticle_Call) RunAndReturn(run func(ctx context.Context, params store.ClapArticleParams) (*store.ArticleClaps, error)) *MockArticleStore_ClapArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateArticle provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) CreateArticle(ctx context.Context, article *store.Article) error {
+	ret := _mock.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *store.Article) error); ok {
+		r0 = returnFunc(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockArticleStore_CreateArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateArticle'
+type MockArticleStore_CreateArticle_Call struct {
+	*mock.Call
+}
+
+// CreateArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *store.Article
+func (_e *MockArticleStore_Expecter) CreateArticle(ctx interface{}, article interface{}) *MockArticleStore_CreateArticle_Call {
+	return &MockArticleStore_CreateArticle_Call{Call: _e.mock.On("CreateArticle", ctx, article)}
+}
+
+func (_c *MockArticleStore_CreateArticle_Call) Run(run func(ctx context.Context, article *store.Article)) *MockArticleStore_CreateArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *store.Article
+		if args[1] != nil {
+			arg1 = args[1].(*store.Article)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_CreateArticle_Call) Return(err error) *MockArticleStore_CreateArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockArticleStore_CreateArticle_Call) RunAndReturn(run func(ctx context.Context, article *store.Article) error) *MockArticleStore_CreateArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleBySlug provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error) {
+	ret := _mock.Called(ctx, slug, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleBySlug")
+	}
+
+	var r0 *store.ArticleDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*store.ArticleDetails, error)); ok {
+		return returnFunc(ctx, slug, viewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *store.ArticleDetails); ok {
+		r0 = returnFunc(ctx, slug, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, slug, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
+type MockArticleStore_GetArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - viewerID uuid.UUID
+func (_e *MockArticleStore_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}, viewerID interface{}) *MockArticleStore_GetArticleBySlug_Call {
+	return &MockArticleStore_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug, viewerID)}
+}
+
+func (_c *MockArticleStore_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string, viewerID uuid.UUID)) *MockArticleStore_GetArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleBySlug_Call) Return(articleDetails *store.ArticleDetails, err error) *MockArticleStore_GetArticleBySlug_Call {
+	_c.Call.Return(articleDetails, err)
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error)) *MockArticleStore_GetArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleRevision provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) GetArticleRevision(ctx context.Context, slug string, number int) (*store.ArticleRevision, error) {
+	ret := _mock.Called(ctx, slug, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleRevision")
+	}
+
+	var r0 *store.ArticleRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*store.ArticleRevision, error)); ok {
+		return returnFunc(ctx, slug, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *store.ArticleRevision); ok {
+		r0 = returnFunc(ctx, slug, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, slug, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_GetArticleRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleRevision'
+type MockArticleStore_GetArticleRevision_Call struct {
+	*mock.Call
+}
+
+// GetArticleRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - number int
+func (_e *MockArticleStore_Expecter) GetArticleRevision(ctx interface{}, slug interface{}, number interface{}) *MockArticleStore_GetArticleRevision_Call {
+	return &MockArticleStore_GetArticleRevision_Call{Call: _e.mock.On("GetArticleRevision", ctx, slug, number)}
+}
+
+func (_c *MockArticleStore_GetArticleRevision_Call) Run(run func(ctx context.Context, slug string, number int)) *MockArticleStore_GetArticleRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleRevision_Call) Return(articleRevision *store.ArticleRevision, err error) *MockArticleStore_GetArticleRevision_Call {
+	_c.Call.Return(articleRevision, err)
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleRevision_Call) RunAndReturn(run func(ctx context.Context, slug string, number int) (*store.ArticleRevision, error)) *MockArticleStore_GetArticleRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleSlugRedirect provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) GetArticleSlugRedirect(ctx context.Context, slug string) (string, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleSlugRedirect")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_GetArticleSlugRedirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleSlugRedirect'
+type MockArticleStore_GetArticleSlugRedirect_Call struct {
+	*mock.Call
+}
+
+// GetArticleSlugRedirect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockArticleStore_Expecter) GetArticleSlugRedirect(ctx interface{}, slug interface{}) *MockArticleStore_GetArticleSlugRedirect_Call {
+	return &MockArticleStore_GetArticleSlugRedirect_Call{Call: _e.mock.On("GetArticleSlugRedirect", ctx, slug)}
+}
+
+func (_c *MockArticleStore_GetArticleSlugRedirect_Call) Run(run func(ctx context.Context, slug string)) *MockArticleStore_GetArticleSlugRedirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleSlugRedirect_Call) Return(s string, err error) *MockArticleStore_GetArticleSlugRedirect_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleSlugRedirect_Call) RunAndReturn(run func(ctx context.Context, slug string) (string, error)) *MockArticleStore_GetArticleSlugRedirect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticlesLastUpdatedAt provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) GetArticlesLastUpdatedAt(ctx context.Context, authorID uuid.UUID) (time.Time, error) {
+	ret := _mock.Called(ctx, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticlesLastUpdatedAt")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (time.Time, error)); ok {
+		return returnFunc(ctx, authorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) time.Time); ok {
+		r0 = returnFunc(ctx, authorID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, authorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_GetArticlesLastUpdatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticlesLastUpdatedAt'
+type MockArticleStore_GetArticlesLastUpdatedAt_Call struct {
+	*mock.Call
+}
+
+// GetArticlesLastUpdatedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID uuid.UUID
+func (_e *MockArticleStore_Expecter) GetArticlesLastUpdatedAt(ctx interface{}, authorID interface{}) *MockArticleStore_GetArticlesLastUpdatedAt_Call {
+	return &MockArticleStore_GetArticlesLastUpdatedAt_Call{Call: _e.mock.On("GetArticlesLastUpdatedAt", ctx, authorID)}
+}
+
+func (_c *MockArticleStore_GetArticlesLastUpdatedAt_Call) Run(run func(ctx context.Context, authorID uuid.UUID)) *MockArticleStore_GetArticlesLastUpdatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticlesLastUpdatedAt_Call) Return(time1 time.Time, err error) *MockArticleStore_GetArticlesLastUpdatedAt_Call {
+	_c.Call.Return(time1, err)
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticlesLastUpdatedAt_Call) RunAndReturn(run func(ctx context.Context, authorID uuid.UUID) (time.Time, error)) *MockArticleStore_GetArticlesLastUpdatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticleRevisions provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) ListArticleRevisions(ctx context.Context, slug string) ([]store.ArticleRevisionSummary, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticleRevisions")
+	}
+
+	var r0 []store.ArticleRevisionSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]store.ArticleRevisionSummary, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []store.ArticleRevisionSummary); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticleRevisionSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_ListArticleRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticleRevisions'
+type MockArticleStore_ListArticleRevisions_Call struct {
+	*mock.Call
+}
+
+// ListArticleRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockArticleStore_Expecter) ListArticleRevisions(ctx interface{}, slug interface{}) *MockArticleStore_ListArticleRevisions_Call {
+	return &MockArticleStore_ListArticleRevisions_Call{Call: _e.mock.On("ListArticleRevisions", ctx, slug)}
+}
+
+func (_c *MockArticleStore_ListArticleRevisions_Call) Run(run func(ctx context.Context, slug string)) *MockArticleStore_ListArticleRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_ListArticleRevisions_Call) Return(articleRevisionSummarys []store.ArticleRevisionSummary, err error) *MockArticleStore_ListArticleRevisions_Call {
+	_c.Call.Return(articleRevisionSummarys, err)
+	return _c
+}
+
+func (_c *MockArticleStore_ListArticleRevisions_Call) RunAndReturn(run func(ctx context.Context, slug string) ([]store.ArticleRevisionSummary, error)) *MockArticleStore_ListArticleRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticlesPreviews provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticlesPreviews")
+	}
+
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListArticlesPreviewsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_ListArticlesPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesPreviews'
+type MockArticleStore_ListArticlesPreviews_Call struct {
+	*mock.Call
+}
+
+// ListArticlesPreviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListArticlesPreviewsParams
+func (_e *MockArticleStore_Expecter) ListArticlesPreviews(ctx interface{}, params interface{}) *MockArticleStore_ListArticlesPreviews_Call {
+	return &MockArticleStore_ListArticlesPreviews_Call{Call: _e.mock.On("ListArticlesPreviews", ctx, params)}
+}
+
+func (_c *MockArticleStore_ListArticlesPreviews_Call) Run(run func(ctx context.Context, params store.ListArticlesPreviewsParams)) *MockArticleStore_ListArticlesPreviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListArticlesPreviewsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListArticlesPreviewsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_ListArticlesPreviews_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockArticleStore_ListArticlesPreviews_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockArticleStore_ListArticlesPreviews_Call) RunAndReturn(run func(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)) *MockArticleStore_ListArticlesPreviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchArticles provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) SearchArticles(ctx context.Context, params store.SearchArticlesParams) ([]store.ArticleSearchResult, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchArticles")
+	}
+
+	var r0 []store.ArticleSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.SearchArticlesParams) ([]store.ArticleSearchResult, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.SearchArticlesParams) []store.ArticleSearchResult); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticleSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.SearchArticlesParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_SearchArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchArticles'
+type MockArticleStore_SearchArticles_Call struct {
+	*mock.Call
+}
+
+// SearchArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.SearchArticlesParams
+func (_e *MockArticleStore_Expecter) SearchArticles(ctx interface{}, params interface{}) *MockArticleStore_SearchArticles_Call {
+	return &MockArticleStore_SearchArticles_Call{Call: _e.mock.On("SearchArticles", ctx, params)}
+}
+
+func (_c *MockArticleStore_SearchArticles_Call) Run(run func(ctx context.Context, params store.SearchArticlesParams)) *MockArticleStore_SearchArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.SearchArticlesParams
+		if args[1] != nil {
+			arg1 = args[1].(store.SearchArticlesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_SearchArticles_Call) Return(articleSearchResults []store.ArticleSearchResult, err error) *MockArticleStore_SearchArticles_Call {
+	_c.Call.Return(articleSearchResults, err)
+	return _c
+}
+
+func (_c *MockArticleStore_SearchArticles_Call) RunAndReturn(run func(ctx context.Context, params store.SearchArticlesParams) ([]store.ArticleSearchResult, error)) *MockArticleStore_SearchArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockArticleExportStore creates a new instance of MockArticleExportStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleExportStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleExportStore {
+	mock := &MockArticleExportStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArticleExportStore is an autogenerated mock type for the ArticleExportStore type
+type MockArticleExportStore struct {
+	mock.Mock
+}
+
+type MockArticleExportStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleExportStore) EXPECT() *MockArticleExportStore_Expecter {
+	return &MockArticleExportStore_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleBySlug provides a mock function for the type MockArticleExportStore
+func (_mock *MockArticleExportStore) GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error) {
+	ret := _mock.Called(ctx, slug, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleBySlug")
+	}
+
+	var r0 *store.ArticleDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*store.ArticleDetails, error)); ok {
+		return returnFunc(ctx, slug, viewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *store.ArticleDetails); ok {
+		r0 = returnFunc(ctx, slug, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, slug, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleExportStore_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
+type MockArticleExportStore_GetArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - viewerID uuid.UUID
+func (_e *MockArticleExportStore_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}, viewerID interface{}) *MockArticleExportStore_GetArticleBySlug_Call {
+	return &MockArticleExportStore_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug, viewerID)}
+}
+
+func (_c *MockArticleExportStore_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string, viewerID uuid.UUID)) *MockArticleExportStore_GetArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleExportStore_GetArticleBySlug_Call) Return(articleDetails *store.ArticleDetails, err error) *MockArticleExportStore_GetArticleBySlug_Call {
+	_c.Call.Return(articleDetails, err)
+	return _c
+}
+
+func (_c *MockArticleExportStore_GetArticleBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error)) *MockArticleExportStore_GetArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockArticleMetaStore creates a new instance of MockArticleMetaStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleMetaStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleMetaStore {
+	mock := &MockArticleMetaStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArticleMetaStore is an autogenerated mock type for the ArticleMetaStore type
+type MockArticleMetaStore struct {
+	mock.Mock
+}
+
+type MockArticleMetaStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleMetaStore) EXPECT() *MockArticleMetaStore_Expecter {
+	return &MockArticleMetaStore_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleBySlug provides a mock function for the type MockArticleMetaStore
+func (_mock *MockArticleMetaStore) GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error) {
+	ret := _mock.Called(ctx, slug, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleBySlug")
+	}
+
+	var r0 *store.ArticleDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*store.ArticleDetails, error)); ok {
+		return returnFunc(ctx, slug, viewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *store.ArticleDetails); ok {
+		r0 = returnFunc(ctx, slug, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, slug, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleMetaStore_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
+type MockArticleMetaStore_GetArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - viewerID uuid.UUID
+func (_e *MockArticleMetaStore_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}, viewerID interface{}) *MockArticleMetaStore_GetArticleBySlug_Call {
+	return &MockArticleMetaStore_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug, viewerID)}
+}
+
+func (_c *MockArticleMetaStore_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string, viewerID uuid.UUID)) *MockArticleMetaStore_GetArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleMetaStore_GetArticleBySlug_Call) Return(articleDetails *store.ArticleDetails, err error) *MockArticleMetaStore_GetArticleBySlug_Call {
+	_c.Call.Return(articleDetails, err)
+	return _c
+}
+
+func (_c *MockArticleMetaStore_GetArticleBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string, viewerID uuid.UUID) (*store.ArticleDetails, error)) *MockArticleMetaStore_GetArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockArticleReviewStore creates a new instance of MockArticleReviewStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleReviewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleReviewStore {
+	mock := &MockArticleReviewStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArticleReviewStore is an autogenerated mock type for the ArticleReviewStore type
+type MockArticleReviewStore struct {
+	mock.Mock
+}
+
+type MockArticleReviewStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleReviewStore) EXPECT() *MockArticleReviewStore_Expecter {
+	return &MockArticleReviewStore_Expecter{mock: &_m.Mock}
+}
+
+// DeleteOwnedArticle provides a mock function for the type MockArticleReviewStore
+func (_mock *MockArticleReviewStore) DeleteOwnedArticle(ctx context.Context, ownerID uuid.UUID, slug string) error {
+	ret := _mock.Called(ctx, ownerID, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOwnedArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, ownerID, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockArticleReviewStore_DeleteOwnedArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOwnedArticle'
+type MockArticleReviewStore_DeleteOwnedArticle_Call struct {
+	*mock.Call
+}
+
+// DeleteOwnedArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+//   - slug string
+func (_e *MockArticleReviewStore_Expecter) DeleteOwnedArticle(ctx interface{}, ownerID interface{}, slug interface{}) *MockArticleReviewStore_DeleteOwnedArticle_Call {
+	return &MockArticleReviewStore_DeleteOwnedArticle_Call{Call: _e.mock.On("DeleteOwnedArticle", ctx, ownerID, slug)}
+}
+
+func (_c *MockArticleReviewStore_DeleteOwnedArticle_Call) Run(run func(ctx context.Context, ownerID uuid.UUID, slug string)) *MockArticleReviewStore_DeleteOwnedArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleReviewStore_DeleteOwnedArticle_Call) Return(err error) *MockArticleReviewStore_DeleteOwnedArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockArticleReviewStore_DeleteOwnedArticle_Call) RunAndReturn(run func(ctx context.Context, ownerID uuid.UUID, slug string) error) *MockArticleReviewStore_DeleteOwnedArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOwnedArticleBySlug provides a mock function for the type MockArticleReviewStore
+func (_mock *MockArticleReviewStore) GetOwnedArticleBySlug(ctx context.Context, ownerID uuid.UUID, slug string) (*store.ArticleDetails, error) {
+	ret := _mock.Called(ctx, ownerID, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwnedArticleBySlug")
+	}
+
+	var r0 *store.ArticleDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*store.ArticleDetails, error)); ok {
+		return returnFunc(ctx, ownerID, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *store.ArticleDetails); ok {
+		r0 = returnFunc(ctx, ownerID, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleDetails)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, ownerID, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleReviewStore_GetOwnedArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOwnedArticleBySlug'
+type MockArticleReviewStore_GetOwnedArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetOwnedArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+//   - slug string
+func (_e *MockArticleReviewStore_Expecter) GetOwnedArticleBySlug(ctx interface{}, ownerID interface{}, slug interface{}) *MockArticleReviewStore_GetOwnedArticleBySlug_Call {
+	return &MockArticleReviewStore_GetOwnedArticleBySlug_Call{Call: _e.mock.On("GetOwnedArticleBySlug", ctx, ownerID, slug)}
+}
+
+func (_c *MockArticleReviewStore_GetOwnedArticleBySlug_Call) Run(run func(ctx context.Context, ownerID uuid.UUID, slug string)) *MockArticleReviewStore_GetOwnedArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleReviewStore_GetOwnedArticleBySlug_Call) Return(articleDetails *store.ArticleDetails, err error) *MockArticleReviewStore_GetOwnedArticleBySlug_Call {
+	_c.Call.Return(articleDetails, err)
+	return _c
+}
+
+func (_c *MockArticleReviewStore_GetOwnedArticleBySlug_Call) RunAndReturn(run func(ctx context.Context, ownerID uuid.UUID, slug string) (*store.ArticleDetails, error)) *MockArticleReviewStore_GetOwnedArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticlesPreviews provides a mock function for the type MockArticleReviewStore
+func (_mock *MockArticleReviewStore) ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticlesPreviews")
+	}
+
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListArticlesPreviewsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleReviewStore_ListArticlesPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesPreviews'
+type MockArticleReviewStore_ListArticlesPreviews_Call struct {
+	*mock.Call
+}
+
+// ListArticlesPreviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListArticlesPreviewsParams
+func (_e *MockArticleReviewStore_Expecter) ListArticlesPreviews(ctx interface{}, params interface{}) *MockArticleReviewStore_ListArticlesPreviews_Call {
+	return &MockArticleReviewStore_ListArticlesPreviews_Call{Call: _e.mock.On("ListArticlesPreviews", ctx, params)}
+}
+
+func (_c *MockArticleReviewStore_ListArticlesPreviews_Call) Run(run func(ctx context.Context, params store.ListArticlesPreviewsParams)) *MockArticleReviewStore_ListArticlesPreviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListArticlesPreviewsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListArticlesPreviewsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleReviewStore_ListArticlesPreviews_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockArticleReviewStore_ListArticlesPreviews_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockArticleReviewStore_ListArticlesPreviews_Call) RunAndReturn(run func(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)) *MockArticleReviewStore_ListArticlesPreviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateArticleSlug provides a mock function for the type MockArticleReviewStore
+func (_mock *MockArticleReviewStore) UpdateArticleSlug(ctx context.Context, params store.UpdateArticleSlugParams) error {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateArticleSlug")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateArticleSlugParams) error); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockArticleReviewStore_UpdateArticleSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticleSlug'
+type MockArticleReviewStore_UpdateArticleSlug_Call struct {
+	*mock.Call
+}
+
+// UpdateArticleSlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.UpdateArticleSlugParams
+func (_e *MockArticleReviewStore_Expecter) UpdateArticleSlug(ctx interface{}, params interface{}) *MockArticleReviewStore_UpdateArticleSlug_Call {
+	return &MockArticleReviewStore_UpdateArticleSlug_Call{Call: _e.mock.On("UpdateArticleSlug", ctx, params)}
+}
+
+func (_c *MockArticleReviewStore_UpdateArticleSlug_Call) Run(run func(ctx context.Context, params store.UpdateArticleSlugParams)) *MockArticleReviewStore_UpdateArticleSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.UpdateArticleSlugParams
+		if args[1] != nil {
+			arg1 = args[1].(store.UpdateArticleSlugParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleReviewStore_UpdateArticleSlug_Call) Return(err error) *MockArticleReviewStore_UpdateArticleSlug_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockArticleReviewStore_UpdateArticleSlug_Call) RunAndReturn(run func(ctx context.Context, params store.UpdateArticleSlugParams) error) *MockArticleReviewStore_UpdateArticleSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateArticleStatus provides a mock function for the type MockArticleReviewStore
+func (_mock *MockArticleReviewStore) UpdateArticleStatus(ctx context.Context, params store.UpdateArticleStatusParams) (*store.ArticleState, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateArticleStatus")
+	}
+
+	var r0 *store.ArticleState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateArticleStatusParams) (*store.ArticleState, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateArticleStatusParams) *store.ArticleState); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.UpdateArticleStatusParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleReviewStore_UpdateArticleStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticleStatus'
+type MockArticleReviewStore_UpdateArticleStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateArticleStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.UpdateArticleStatusParams
+func (_e *MockArticleReviewStore_Expecter) UpdateArticleStatus(ctx interface{}, params interface{}) *MockArticleReviewStore_UpdateArticleStatus_Call {
+	return &MockArticleReviewStore_UpdateArticleStatus_Call{Call: _e.mock.On("UpdateArticleStatus", ctx, params)}
+}
+
+func (_c *MockArticleReviewStore_UpdateArticleStatus_Call) Run(run func(ctx context.Context, params store.UpdateArticleStatusParams)) *MockArticleReviewStore_UpdateArticleStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.UpdateArticleStatusParams
+		if args[1] != nil {
+			arg1 = args[1].(store.UpdateArticleStatusParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleReviewStore_UpdateArticleStatus_Call) Return(articleState *store.ArticleState, err error) *MockArticleReviewStore_UpdateArticleStatus_Call {
+	_c.Call.Return(articleState, err)
+	return _c
+}
+
+func (_c *MockArticleReviewStore_UpdateArticleStatus_Call) RunAndReturn(run func(ctx context.Context, params store.UpdateArticleStatusParams) (*store.ArticleState, error)) *MockArticleReviewStore_UpdateArticleStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthStore creates a new instance of MockAuthStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthStore {
+	mock := &MockAuthStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthStore is an autogenerated mock type for the AuthStore type
+type MockAuthStore struct {
+	mock.Mock
+}
+
+type MockAuthStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthStore) EXPECT() *MockAuthStore_Expecter {
+	return &MockAuthStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateUser provides a mock function for the type MockAuthStore
+func (_mock *MockAuthStore) CreateUser(ctx context.Context, params store.CreateUserParams) (*store.User, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *store.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateUserParams) (*store.User, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateUserParams) *store.User); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateUserParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockAuthStore_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.CreateUserParams
+func (_e *MockAuthStore_Expecter) CreateUser(ctx interface{}, params interface{}) *MockAuthStore_CreateUser_Call {
+	return &MockAuthStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, params)}
+}
+
+func (_c *MockAuthStore_CreateUser_Call) Run(run func(ctx context.Context, params store.CreateUserParams)) *MockAuthStore_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CreateUserParams
+		if args[1] != nil {
+			arg1 = args[1].(store.CreateUserParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthStore_CreateUser_Call) Return(user *store.User, err error) *MockAuthStore_CreateUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthStore_CreateUser_Call) RunAndReturn(run func(ctx context.Context, params store.CreateUserParams) (*store.User, error)) *MockAuthStore_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function for the type MockAuthStore
+func (_mock *MockAuthStore) GetUser(ctx context.Context, emailOrUsername string) (*store.User, error) {
+	ret := _mock.Called(ctx, emailOrUsername)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *store.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.User, error)); ok {
+		return returnFunc(ctx, emailOrUsername)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.User); ok {
+		r0 = returnFunc(ctx, emailOrUsername)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, emailOrUsername)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockAuthStore_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - emailOrUsername string
+func (_e *MockAuthStore_Expecter) GetUser(ctx interface{}, emailOrUsername interface{}) *MockAuthStore_GetUser_Call {
+	return &MockAuthStore_GetUser_Call{Call: _e.mock.On("GetUser", ctx, emailOrUsername)}
+}
+
+func (_c *MockAuthStore_GetUser_Call) Run(run func(ctx context.Context, emailOrUsername string)) *MockAuthStore_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthStore_GetUser_Call) Return(user *store.User, err error) *MockAuthStore_GetUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthStore_GetUser_Call) RunAndReturn(run func(ctx context.Context, emailOrUsername string) (*store.User, error)) *MockAuthStore_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function for the type MockAuthStore
+func (_mock *MockAuthStore) GetUserByID(ctx context.Context, userID string) (*store.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *store.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthStore_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockAuthStore_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthStore_Expecter) GetUserByID(ctx interface{}, userID interface{}) *MockAuthStore_GetUserByID_Call {
+	return &MockAuthStore_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+}
+
+func (_c *MockAuthStore_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthStore_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthStore_GetUserByID_Call) Return(user *store.User, err error) *MockAuthStore_GetUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuthStore_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*store.User, error)) *MockAuthStore_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModeratorStore creates a new instance of MockModeratorStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModeratorStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModeratorStore {
+	mock := &MockModeratorStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModeratorStore is an autogenerated mock type for the ModeratorStore type
+type MockModeratorStore struct {
+	mock.Mock
+}
+
+type MockModeratorStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModeratorStore) EXPECT() *MockModeratorStore_Expecter {
+	return &MockModeratorStore_Expecter{mock: &_m.Mock}
+}
+
+// GetUserByID provides a mock function for the type MockModeratorStore
+func (_mock *MockModeratorStore) GetUserByID(ctx context.Context, userID string) (*store.User, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *store.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.User, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModeratorStore_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockModeratorStore_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockModeratorStore_Expecter) GetUserByID(ctx interface{}, userID interface{}) *MockModeratorStore_GetUserByID_Call {
+	return &MockModeratorStore_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+}
+
+func (_c *MockModeratorStore_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *MockModeratorStore_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModeratorStore_GetUserByID_Call) Return(user *store.User, err error) *MockModeratorStore_GetUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockModeratorStore_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*store.User, error)) *MockModeratorStore_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBookmarkStore creates a new instance of MockBookmarkStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBookmarkStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBookmarkStore {
+	mock := &MockBookmarkStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBookmarkStore is an autogenerated mock type for the BookmarkStore type
+type MockBookmarkStore struct {
+	mock.Mock
+}
+
+type MockBookmarkStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBookmarkStore) EXPECT() *MockBookmarkStore_Expecter {
+	return &MockBookmarkStore_Expecter{mock: &_m.Mock}
+}
+
+// BookmarkArticle provides a mock function for the type MockBookmarkStore
+func (_mock *MockBookmarkStore) BookmarkArticle(ctx context.Context, userID uuid.UUID, slug string) error {
+	ret := _mock.Called(ctx, userID, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BookmarkArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookmarkStore_BookmarkArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BookmarkArticle'
+type MockBookmarkStore_BookmarkArticle_Call struct {
+	*mock.Call
+}
+
+// BookmarkArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - slug string
+func (_e *MockBookmarkStore_Expecter) BookmarkArticle(ctx interface{}, userID interface{}, slug interface{}) *MockBookmarkStore_BookmarkArticle_Call {
+	return &MockBookmarkStore_BookmarkArticle_Call{Call: _e.mock.On("BookmarkArticle", ctx, userID, slug)}
+}
+
+func (_c *MockBookmarkStore_BookmarkArticle_Call) Run(run func(ctx context.Context, userID uuid.UUID, slug string)) *MockBookmarkStore_BookmarkArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkStore_BookmarkArticle_Call) Return(err error) *MockBookmarkStore_BookmarkArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookmarkStore_BookmarkArticle_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, slug string) error) *MockBookmarkStore_BookmarkArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBookmark provides a mock function for the type MockBookmarkStore
+func (_mock *MockBookmarkStore) DeleteBookmark(ctx context.Context, userID uuid.UUID, slug string) error {
+	ret := _mock.Called(ctx, userID, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBookmark")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, userID, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBookmarkStore_DeleteBookmark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBookmark'
+type MockBookmarkStore_DeleteBookmark_Call struct {
+	*mock.Call
+}
+
+// DeleteBookmark is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - slug string
+func (_e *MockBookmarkStore_Expecter) DeleteBookmark(ctx interface{}, userID interface{}, slug interface{}) *MockBookmarkStore_DeleteBookmark_Call {
+	return &MockBookmarkStore_DeleteBookmark_Call{Call: _e.mock.On("DeleteBookmark", ctx, userID, slug)}
+}
+
+func (_c *MockBookmarkStore_DeleteBookmark_Call) Run(run func(ctx context.Context, userID uuid.UUID, slug string)) *MockBookmarkStore_DeleteBookmark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkStore_DeleteBookmark_Call) Return(err error) *MockBookmarkStore_DeleteBookmark_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBookmarkStore_DeleteBookmark_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, slug string) error) *MockBookmarkStore_DeleteBookmark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBookmarkedArticles provides a mock function for the type MockBookmarkStore
+func (_mock *MockBookmarkStore) ListBookmarkedArticles(ctx context.Context, params store.ListBookmarkedArticlesParams) ([]store.BookmarkedArticle, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBookmarkedArticles")
+	}
+
+	var r0 []store.BookmarkedArticle
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListBookmarkedArticlesParams) ([]store.BookmarkedArticle, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListBookmarkedArticlesParams) []store.BookmarkedArticle); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.BookmarkedArticle)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListBookmarkedArticlesParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBookmarkStore_ListBookmarkedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBookmarkedArticles'
+type MockBookmarkStore_ListBookmarkedArticles_Call struct {
+	*mock.Call
+}
+
+// ListBookmarkedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListBookmarkedArticlesParams
+func (_e *MockBookmarkStore_Expecter) ListBookmarkedArticles(ctx interface{}, params interface{}) *MockBookmarkStore_ListBookmarkedArticles_Call {
+	return &MockBookmarkStore_ListBookmarkedArticles_Call{Call: _e.mock.On("ListBookmarkedArticles", ctx, params)}
+}
+
+func (_c *MockBookmarkStore_ListBookmarkedArticles_Call) Run(run func(ctx context.Context, params store.ListBookmarkedArticlesParams)) *MockBookmarkStore_ListBookmarkedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListBookmarkedArticlesParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListBookmarkedArticlesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBookmarkStore_ListBookmarkedArticles_Call) Return(bookmarkedArticles []store.BookmarkedArticle, err error) *MockBookmarkStore_ListBookmarkedArticles_Call {
+	_c.Call.Return(bookmarkedArticles, err)
+	return _c
+}
+
+func (_c *MockBookmarkStore_ListBookmarkedArticles_Call) RunAndReturn(run func(ctx context.Context, params store.ListBookmarkedArticlesParams) ([]store.BookmarkedArticle, error)) *MockBookmarkStore_ListBookmarkedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommentStore creates a new instance of MockCommentStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentStore {
+	mock := &MockCommentStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCommentStore is an autogenerated mock type for the CommentStore type
+type MockCommentStore struct {
+	mock.Mock
+}
+
+type MockCommentStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentStore) EXPECT() *MockCommentStore_Expecter {
+	return &MockCommentStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) CreateComment(ctx context.Context, params store.CreateCommentParams) (*store.Comment, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *store.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateCommentParams) (*store.Comment, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateCommentParams) *store.Comment); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateCommentParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentStore_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockCommentStore_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.CreateCommentParams
+func (_e *MockCommentStore_Expecter) CreateComment(ctx interface{}, params interface{}) *MockCommentStore_CreateComment_Call {
+	return &MockCommentStore_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, params)}
+}
+
+func (_c *MockCommentStore_CreateComment_Call) Run(run func(ctx context.Context, params store.CreateCommentParams)) *MockCommentStore_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CreateCommentParams
+		if args[1] != nil {
+			arg1 = args[1].(store.CreateCommentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_CreateComment_Call) Return(comment *store.Comment, err error) *MockCommentStore_CreateComment_Call {
+	_c.Call.Return(comment, err)
+	return _c
+}
+
+func (_c *MockCommentStore_CreateComment_Call) RunAndReturn(run func(ctx context.Context, params store.CreateCommentParams) (*store.Comment, error)) *MockCommentStore_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCommentStore_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockCommentStore_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userID uuid.UUID
+func (_e *MockCommentStore_Expecter) DeleteComment(ctx interface{}, id interface{}, userID interface{}) *MockCommentStore_DeleteComment_Call {
+	return &MockCommentStore_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, id, userID)}
+}
+
+func (_c *MockCommentStore_DeleteComment_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *MockCommentStore_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_DeleteComment_Call) Return(err error) *MockCommentStore_DeleteComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentStore_DeleteComment_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID) error) *MockCommentStore_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleIDBySlug provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) GetArticleIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleIDBySlug")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (uuid.UUID, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) uuid.UUID); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentStore_GetArticleIDBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleIDBySlug'
+type MockCommentStore_GetArticleIDBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleIDBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockCommentStore_Expecter) GetArticleIDBySlug(ctx interface{}, slug interface{}) *MockCommentStore_GetArticleIDBySlug_Call {
+	return &MockCommentStore_GetArticleIDBySlug_Call{Call: _e.mock.On("GetArticleIDBySlug", ctx, slug)}
+}
+
+func (_c *MockCommentStore_GetArticleIDBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockCommentStore_GetArticleIDBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_GetArticleIDBySlug_Call) Return(uUID uuid.UUID, err error) *MockCommentStore_GetArticleIDBySlug_Call {
+	_c.Call.Return(uUID, err)
+	return _c
+}
+
+func (_c *MockCommentStore_GetArticleIDBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (uuid.UUID, error)) *MockCommentStore_GetArticleIDBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCommentReplies provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) ListCommentReplies(ctx context.Context, commentIDs []uuid.UUID) ([]store.Comment, error) {
+	ret := _mock.Called(ctx, commentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommentReplies")
+	}
+
+	var r0 []store.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]store.Comment, error)); ok {
+		return returnFunc(ctx, commentIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []store.Comment); ok {
+		r0 = returnFunc(ctx, commentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, commentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentStore_ListCommentReplies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommentReplies'
+type MockCommentStore_ListCommentReplies_Call struct {
+	*mock.Call
+}
+
+// ListCommentReplies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentIDs []uuid.UUID
+func (_e *MockCommentStore_Expecter) ListCommentReplies(ctx interface{}, commentIDs interface{}) *MockCommentStore_ListCommentReplies_Call {
+	return &MockCommentStore_ListCommentReplies_Call{Call: _e.mock.On("ListCommentReplies", ctx, commentIDs)}
+}
+
+func (_c *MockCommentStore_ListCommentReplies_Call) Run(run func(ctx context.Context, commentIDs []uuid.UUID)) *MockCommentStore_ListCommentReplies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_ListCommentReplies_Call) Return(comments []store.Comment, err error) *MockCommentStore_ListCommentReplies_Call {
+	_c.Call.Return(comments, err)
+	return _c
+}
+
+func (_c *MockCommentStore_ListCommentReplies_Call) RunAndReturn(run func(ctx context.Context, commentIDs []uuid.UUID) ([]store.Comment, error)) *MockCommentStore_ListCommentReplies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) ListComments(ctx context.Context, params store.ListCommentsParams) ([]store.Comment, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []store.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListCommentsParams) ([]store.Comment, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListCommentsParams) []store.Comment); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListCommentsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentStore_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockCommentStore_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListCommentsParams
+func (_e *MockCommentStore_Expecter) ListComments(ctx interface{}, params interface{}) *MockCommentStore_ListComments_Call {
+	return &MockCommentStore_ListComments_Call{Call: _e.mock.On("ListComments", ctx, params)}
+}
+
+func (_c *MockCommentStore_ListComments_Call) Run(run func(ctx context.Context, params store.ListCommentsParams)) *MockCommentStore_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListCommentsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListCommentsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_ListComments_Call) Return(comments []store.Comment, err error) *MockCommentStore_ListComments_Call {
+	_c.Call.Return(comments, err)
+	return _c
+}
+
+func (_c *MockCommentStore_ListComments_Call) RunAndReturn(run func(ctx context.Context, params store.ListCommentsParams) ([]store.Comment, error)) *MockCommentStore_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCommentModerationStatus provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) SetCommentModerationStatus(ctx context.Context, id uuid.UUID, status store.CommentModerationStatus) (*store.Comment, error) {
+	ret := _mock.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCommentModerationStatus")
+	}
+
+	var r0 *store.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, store.CommentModerationStatus) (*store.Comment, error)); ok {
+		return returnFunc(ctx, id, status)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, store.CommentModerationStatus) *store.Comment); ok {
+		r0 = returnFunc(ctx, id, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, store.CommentModerationStatus) error); ok {
+		r1 = returnFunc(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentStore_SetCommentModerationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCommentModerationStatus'
+type MockCommentStore_SetCommentModerationStatus_Call struct {
+	*mock.Call
+}
+
+// SetCommentModerationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - status store.CommentModerationStatus
+func (_e *MockCommentStore_Expecter) SetCommentModerationStatus(ctx interface{}, id interface{}, status interface{}) *MockCommentStore_SetCommentModerationStatus_Call {
+	return &MockCommentStore_SetCommentModerationStatus_Call{Call: _e.mock.On("SetCommentModerationStatus", ctx, id, status)}
+}
+
+func (_c *MockCommentStore_SetCommentModerationStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, status store.CommentModerationStatus)) *MockCommentStore_SetCommentModerationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 store.CommentModerationStatus
+		if args[2] != nil {
+			arg2 = args[2].(store.CommentModerationStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_SetCommentModerationStatus_Call) Return(comment *store.Comment, err error) *MockCommentStore_SetCommentModerationStatus_Call {
+	_c.Call.Return(comment, err)
+	return _c
+}
+
+func (_c *MockCommentStore_SetCommentModerationStatus_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, status store.CommentModerationStatus) (*store.Comment, error)) *MockCommentStore_SetCommentModerationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function for the type MockCommentStore
+func (_mock *MockCommentStore) UpdateComment(ctx context.Context, params store.UpdateCommentParams) (*store.Comment, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 *store.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateCommentParams) (*store.Comment, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateCommentParams) *store.Comment); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.UpdateCommentParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCommentStore_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockCommentStore_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.UpdateCommentParams
+func (_e *MockCommentStore_Expecter) UpdateComment(ctx interface{}, params interface{}) *MockCommentStore_UpdateComment_Call {
+	return &MockCommentStore_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, params)}
+}
+
+func (_c *MockCommentStore_UpdateComment_Call) Run(run func(ctx context.Context, params store.UpdateCommentParams)) *MockCommentStore_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.UpdateCommentParams
+		if args[1] != nil {
+			arg1 = args[1].(store.UpdateCommentParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCommentStore_UpdateComment_Call) Return(comment *store.Comment, err error) *MockCommentStore_UpdateComment_Call {
+	_c.Call.Return(comment, err)
+	return _c
+}
+
+func (_c *MockCommentStore_UpdateComment_Call) RunAndReturn(run func(ctx context.Context, params store.UpdateCommentParams) (*store.Comment, error)) *MockCommentStore_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDigitalAuthorStore creates a new instance of MockDigitalAuthorStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDigitalAuthorStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDigitalAuthorStore {
+	mock := &MockDigitalAuthorStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDigitalAuthorStore is an autogenerated mock type for the DigitalAuthorStore type
+type MockDigitalAuthorStore struct {
+	mock.Mock
+}
+
+type MockDigitalAuthorStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDigitalAuthorStore) EXPECT() *MockDigitalAuthorStore_Expecter {
+	return &MockDigitalAuthorStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDigitalAuthor provides a mock function for the type MockDigitalAuthorStore
+func (_mock *MockDigitalAuthorStore) CreateDigitalAuthor(ctx context.Context, params store.CreateDigitalAuthorParams) (*store.DigitalAuthor, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDigitalAuthor")
+	}
+
+	var r0 *store.DigitalAuthor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateDigitalAuthorParams) (*store.DigitalAuthor, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateDigitalAuthorParams) *store.DigitalAuthor); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.DigitalAuthor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateDigitalAuthorParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDigitalAuthorStore_CreateDigitalAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDigitalAuthor'
+type MockDigitalAuthorStore_CreateDigitalAuthor_Call struct {
+	*mock.Call
+}
+
+// CreateDigitalAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.CreateDigitalAuthorParams
+func (_e *MockDigitalAuthorStore_Expecter) CreateDigitalAuthor(ctx interface{}, params interface{}) *MockDigitalAuthorStore_CreateDigitalAuthor_Call {
+	return &MockDigitalAuthorStore_CreateDigitalAuthor_Call{Call: _e.mock.On("CreateDigitalAuthor", ctx, params)}
+}
+
+func (_c *MockDigitalAuthorStore_CreateDigitalAuthor_Call) Run(run func(ctx context.Context, params store.CreateDigitalAuthorParams)) *MockDigitalAuthorStore_CreateDigitalAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CreateDigitalAuthorParams
+		if args[1] != nil {
+			arg1 = args[1].(store.CreateDigitalAuthorParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDigitalAuthorStore_CreateDigitalAuthor_Call) Return(digitalAuthor *store.DigitalAuthor, err error) *MockDigitalAuthorStore_CreateDigitalAuthor_Call {
+	_c.Call.Return(digitalAuthor, err)
+	return _c
+}
+
+func (_c *MockDigitalAuthorStore_CreateDigitalAuthor_Call) RunAndReturn(run func(ctx context.Context, params store.CreateDigitalAuthorParams) (*store.DigitalAuthor, error)) *MockDigitalAuthorStore_CreateDigitalAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDigitalAuthors provides a mock function for the type MockDigitalAuthorStore
+func (_mock *MockDigitalAuthorStore) ListDigitalAuthors(ctx context.Context, viewerID uuid.UUID) ([]*store.DigitalAuthor, error) {
+	ret := _mock.Called(ctx, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDigitalAuthors")
+	}
+
+	var r0 []*store.DigitalAuthor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*store.DigitalAuthor, error)); ok {
+		return returnFunc(ctx, viewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*store.DigitalAuthor); ok {
+		r0 = returnFunc(ctx, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*store.DigitalAuthor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDigitalAuthorStore_ListDigitalAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDigitalAuthors'
+type MockDigitalAuthorStore_ListDigitalAuthors_Call struct {
+	*mock.Call
+}
+
+// ListDigitalAuthors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID uuid.UUID
+func (_e *MockDigitalAuthorStore_Expecter) ListDigitalAuthors(ctx interface{}, viewerID interface{}) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
+	return &MockDigitalAuthorStore_ListDigitalAuthors_Call{Call: _e.mock.On("ListDigitalAuthors", ctx, viewerID)}
+}
+
+func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) Run(run func(ctx context.Context, viewerID uuid.UUID)) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) Return(digitalAuthors []*store.DigitalAuthor, err error) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
+	_c.Call.Return(digitalAuthors, err)
+	return _c
+}
+
+func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) RunAndReturn(run func(ctx context.Context, viewerID uuid.UUID) ([]*store.DigitalAuthor, error)) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFeedStore creates a new instance of MockFeedStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFeedStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFeedStore {
+	mock := &MockFeedStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFeedStore is an autogenerated mock type for the FeedStore type
+type MockFeedStore struct {
+	mock.Mock
+}
+
+type MockFeedStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFeedStore) EXPECT() *MockFeedStore_Expecter {
+	return &MockFeedStore_Expecter{mock: &_m.Mock}
+}
+
+// GetArticlesLastUpdatedAt provides a mock function for the type MockFeedStore
+func (_mock *MockFeedStore) GetArticlesLastUpdatedAt(ctx context.Context, authorID uuid.UUID) (time.Time, error) {
+	ret := _mock.Called(ctx, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticlesLastUpdatedAt")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (time.Time, error)); ok {
+		return returnFunc(ctx, authorID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) time.Time); ok {
+		r0 = returnFunc(ctx, authorID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, authorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFeedStore_GetArticlesLastUpdatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticlesLastUpdatedAt'
+type MockFeedStore_GetArticlesLastUpdatedAt_Call struct {
+	*mock.Call
+}
+
+// GetArticlesLastUpdatedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID uuid.UUID
+func (_e *MockFeedStore_Expecter) GetArticlesLastUpdatedAt(ctx interface{}, authorID interface{}) *MockFeedStore_GetArticlesLastUpdatedAt_Call {
+	return &MockFeedStore_GetArticlesLastUpdatedAt_Call{Call: _e.mock.On("GetArticlesLastUpdatedAt", ctx, authorID)}
+}
+
+func (_c *MockFeedStore_GetArticlesLastUpdatedAt_Call) Run(run func(ctx context.Context, authorID uuid.UUID)) *MockFeedStore_GetArticlesLastUpdatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_GetArticlesLastUpdatedAt_Call) Return(time1 time.Time, err error) *MockFeedStore_GetArticlesLastUpdatedAt_Call {
+	_c.Call.Return(time1, err)
+	return _c
+}
+
+func (_c *MockFeedStore_GetArticlesLastUpdatedAt_Call) RunAndReturn(run func(ctx context.Context, authorID uuid.UUID) (time.Time, error)) *MockFeedStore_GetArticlesLastUpdatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDigitalAuthor provides a mock function for the type MockFeedStore
+func (_mock *MockFeedStore) GetDigitalAuthor(ctx context.Context, id uuid.UUID) (*store.DigitalAuthor, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDigitalAuthor")
+	}
+
+	var r0 *store.DigitalAuthor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*store.DigitalAuthor, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *store.DigitalAuthor); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.DigitalAuthor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFeedStore_GetDigitalAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDigitalAuthor'
+type MockFeedStore_GetDigitalAuthor_Call struct {
+	*mock.Call
+}
+
+// GetDigitalAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockFeedStore_Expecter) GetDigitalAuthor(ctx interface{}, id interface{}) *MockFeedStore_GetDigitalAuthor_Call {
+	return &MockFeedStore_GetDigitalAuthor_Call{Call: _e.mock.On("GetDigitalAuthor", ctx, id)}
+}
+
+func (_c *MockFeedStore_GetDigitalAuthor_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockFeedStore_GetDigitalAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_GetDigitalAuthor_Call) Return(digitalAuthor *store.DigitalAuthor, err error) *MockFeedStore_GetDigitalAuthor_Call {
+	_c.Call.Return(digitalAuthor, err)
+	return _c
+}
+
+func (_c *MockFeedStore_GetDigitalAuthor_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*store.DigitalAuthor, error)) *MockFeedStore_GetDigitalAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticlesHTMLContent provides a mock function for the type MockFeedStore
+func (_mock *MockFeedStore) ListArticlesHTMLContent(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticlesHTMLContent")
+	}
+
+	var r0 map[uuid.UUID]string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) (map[uuid.UUID]string, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) map[uuid.UUID]string); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFeedStore_ListArticlesHTMLContent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesHTMLContent'
+type MockFeedStore_ListArticlesHTMLContent_Call struct {
+	*mock.Call
+}
+
+// ListArticlesHTMLContent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *MockFeedStore_Expecter) ListArticlesHTMLContent(ctx interface{}, ids interface{}) *MockFeedStore_ListArticlesHTMLContent_Call {
+	return &MockFeedStore_ListArticlesHTMLContent_Call{Call: _e.mock.On("ListArticlesHTMLContent", ctx, ids)}
+}
+
+func (_c *MockFeedStore_ListArticlesHTMLContent_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockFeedStore_ListArticlesHTMLContent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].([]uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_ListArticlesHTMLContent_Call) Return(uUIDToString map[uuid.UUID]string, err error) *MockFeedStore_ListArticlesHTMLContent_Call {
+	_c.Call.Return(uUIDToString, err)
+	return _c
+}
+
+func (_c *MockFeedStore_ListArticlesHTMLContent_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error)) *MockFeedStore_ListArticlesHTMLContent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticlesPreviews provides a mock function for the type MockFeedStore
+func (_mock *MockFeedStore) ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticlesPreviews")
+	}
+
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListArticlesPreviewsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFeedStore_ListArticlesPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesPreviews'
+type MockFeedStore_ListArticlesPreviews_Call struct {
+	*mock.Call
+}
+
+// ListArticlesPreviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListArticlesPreviewsParams
+func (_e *MockFeedStore_Expecter) ListArticlesPreviews(ctx interface{}, params interface{}) *MockFeedStore_ListArticlesPreviews_Call {
+	return &MockFeedStore_ListArticlesPreviews_Call{Call: _e.mock.On("ListArticlesPreviews", ctx, params)}
+}
+
+func (_c *MockFeedStore_ListArticlesPreviews_Call) Run(run func(ctx context.Context, params store.ListArticlesPreviewsParams)) *MockFeedStore_ListArticlesPreviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListArticlesPreviewsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListArticlesPreviewsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFeedStore_ListArticlesPreviews_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockFeedStore_ListArticlesPreviews_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockFeedStore_ListArticlesPreviews_Call) RunAndReturn(run func(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)) *MockFeedStore_ListArticlesPreviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFollowStore creates a new instance of MockFollowStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFollowStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFollowStore {
+	mock := &MockFollowStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFollowStore is an autogenerated mock type for the FollowStore type
+type MockFollowStore struct {
+	mock.Mock
+}
+
+type MockFollowStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFollowStore) EXPECT() *MockFollowStore_Expecter {
+	return &MockFollowStore_Expecter{mock: &_m.Mock}
+}
+
+// FollowDigitalAuthor provides a mock function for the type MockFollowStore
+func (_mock *MockFollowStore) FollowDigitalAuthor(ctx context.Context, userID uuid.UUID, authorID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowDigitalAuthor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, authorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFollowStore_FollowDigitalAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowDigitalAuthor'
+type MockFollowStore_FollowDigitalAuthor_Call struct {
+	*mock.Call
+}
+
+// FollowDigitalAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - authorID uuid.UUID
+func (_e *MockFollowStore_Expecter) FollowDigitalAuthor(ctx interface{}, userID interface{}, authorID interface{}) *MockFollowStore_FollowDigitalAuthor_Call {
+	return &MockFollowStore_FollowDigitalAuthor_Call{Call: _e.mock.On("FollowDigitalAuthor", ctx, userID, authorID)}
+}
+
+func (_c *MockFollowStore_FollowDigitalAuthor_Call) Run(run func(ctx context.Context, userID uuid.UUID, authorID uuid.UUID)) *MockFollowStore_FollowDigitalAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowStore_FollowDigitalAuthor_Call) Return(err error) *MockFollowStore_FollowDigitalAuthor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFollowStore_FollowDigitalAuthor_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, authorID uuid.UUID) error) *MockFollowStore_FollowDigitalAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListArticlesPreviews provides a mock function for the type MockFollowStore
+func (_mock *MockFollowStore) ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticlesPreviews")
+	}
+
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListArticlesPreviewsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFollowStore_ListArticlesPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesPreviews'
+type MockFollowStore_ListArticlesPreviews_Call struct {
+	*mock.Call
+}
+
+// ListArticlesPreviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListArticlesPreviewsParams
+func (_e *MockFollowStore_Expecter) ListArticlesPreviews(ctx interface{}, params interface{}) *MockFollowStore_ListArticlesPreviews_Call {
+	return &MockFollowStore_ListArticlesPreviews_Call{Call: _e.mock.On("ListArticlesPreviews", ctx, params)}
+}
+
+func (_c *MockFollowStore_ListArticlesPreviews_Call) Run(run func(ctx context.Context, params store.ListArticlesPreviewsParams)) *MockFollowStore_ListArticlesPreviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListArticlesPreviewsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListArticlesPreviewsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowStore_ListArticlesPreviews_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockFollowStore_ListArticlesPreviews_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockFollowStore_ListArticlesPreviews_Call) RunAndReturn(run func(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)) *MockFollowStore_ListArticlesPreviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnfollowDigitalAuthor provides a mock function for the type MockFollowStore
+func (_mock *MockFollowStore) UnfollowDigitalAuthor(ctx context.Context, userID uuid.UUID, authorID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, authorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfollowDigitalAuthor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, authorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFollowStore_UnfollowDigitalAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfollowDigitalAuthor'
+type MockFollowStore_UnfollowDigitalAuthor_Call struct {
+	*mock.Call
+}
+
+// UnfollowDigitalAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - authorID uuid.UUID
+func (_e *MockFollowStore_Expecter) UnfollowDigitalAuthor(ctx interface{}, userID interface{}, authorID interface{}) *MockFollowStore_UnfollowDigitalAuthor_Call {
+	return &MockFollowStore_UnfollowDigitalAuthor_Call{Call: _e.mock.On("UnfollowDigitalAuthor", ctx, userID, authorID)}
+}
+
+func (_c *MockFollowStore_UnfollowDigitalAuthor_Call) Run(run func(ctx context.Context, userID uuid.UUID, authorID uuid.UUID)) *MockFollowStore_UnfollowDigitalAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowStore_UnfollowDigitalAuthor_Call) Return(err error) *MockFollowStore_UnfollowDigitalAuthor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFollowStore_UnfollowDigitalAuthor_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, authorID uuid.UUID) error) *MockFollowStore_UnfollowDigitalAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockModerationStore creates a new instance of MockModerationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockModerationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockModerationStore {
+	mock := &MockModerationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockModerationStore is an autogenerated mock type for the ModerationStore type
+type MockModerationStore struct {
+	mock.Mock
+}
+
+type MockModerationStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockModerationStore) EXPECT() *MockModerationStore_Expecter {
+	return &MockModerationStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateReport provides a mock function for the type MockModerationStore
+func (_mock *MockModerationStore) CreateReport(ctx context.Context, params store.CreateReportParams) (*store.Report, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReport")
+	}
+
+	var r0 *store.Report
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateReportParams) (*store.Report, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateReportParams) *store.Report); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Report)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateReportParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationStore_CreateReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReport'
+type MockModerationStore_CreateReport_Call struct {
+	*mock.Call
+}
+
+// CreateReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.CreateReportParams
+func (_e *MockModerationStore_Expecter) CreateReport(ctx interface{}, params interface{}) *MockModerationStore_CreateReport_Call {
+	return &MockModerationStore_CreateReport_Call{Call: _e.mock.On("CreateReport", ctx, params)}
+}
+
+func (_c *MockModerationStore_CreateReport_Call) Run(run func(ctx context.Context, params store.CreateReportParams)) *MockModerationStore_CreateReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CreateReportParams
+		if args[1] != nil {
+			arg1 = args[1].(store.CreateReportParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationStore_CreateReport_Call) Return(report *store.Report, err error) *MockModerationStore_CreateReport_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *MockModerationStore_CreateReport_Call) RunAndReturn(run func(ctx context.Context, params store.CreateReportParams) (*store.Report, error)) *MockModerationStore_CreateReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReports provides a mock function for the type MockModerationStore
+func (_mock *MockModerationStore) ListReports(ctx context.Context, params store.ListReportsParams) ([]store.Report, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReports")
+	}
+
+	var r0 []store.Report
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListReportsParams) ([]store.Report, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListReportsParams) []store.Report); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.Report)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListReportsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationStore_ListReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReports'
+type MockModerationStore_ListReports_Call struct {
+	*mock.Call
+}
+
+// ListReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListReportsParams
+func (_e *MockModerationStore_Expecter) ListReports(ctx interface{}, params interface{}) *MockModerationStore_ListReports_Call {
+	return &MockModerationStore_ListReports_Call{Call: _e.mock.On("ListReports", ctx, params)}
+}
+
+func (_c *MockModerationStore_ListReports_Call) Run(run func(ctx context.Context, params store.ListReportsParams)) *MockModerationStore_ListReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListReportsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListReportsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationStore_ListReports_Call) Return(reports []store.Report, err error) *MockModerationStore_ListReports_Call {
+	_c.Call.Return(reports, err)
+	return _c
+}
+
+func (_c *MockModerationStore_ListReports_Call) RunAndReturn(run func(ctx context.Context, params store.ListReportsParams) ([]store.Report, error)) *MockModerationStore_ListReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveReport provides a mock function for the type MockModerationStore
+func (_mock *MockModerationStore) ResolveReport(ctx context.Context, params store.ResolveReportParams) (*store.Report, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReport")
+	}
+
+	var r0 *store.Report
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ResolveReportParams) (*store.Report, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ResolveReportParams) *store.Report); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Report)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ResolveReportParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockModerationStore_ResolveReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveReport'
+type MockModerationStore_ResolveReport_Call struct {
+	*mock.Call
+}
+
+// ResolveReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ResolveReportParams
+func (_e *MockModerationStore_Expecter) ResolveReport(ctx interface{}, params interface{}) *MockModerationStore_ResolveReport_Call {
+	return &MockModerationStore_ResolveReport_Call{Call: _e.mock.On("ResolveReport", ctx, params)}
+}
+
+func (_c *MockModerationStore_ResolveReport_Call) Run(run func(ctx context.Context, params store.ResolveReportParams)) *MockModerationStore_ResolveReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ResolveReportParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ResolveReportParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationStore_ResolveReport_Call) Return(report *store.Report, err error) *MockModerationStore_ResolveReport_Call {
+	_c.Call.Return(report, err)
+	return _c
+}
+
+func (_c *MockModerationStore_ResolveReport_Call) RunAndReturn(run func(ctx context.Context, params store.ResolveReportParams) (*store.Report, error)) *MockModerationStore_ResolveReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreArticle provides a mock function for the type MockModerationStore
+func (_mock *MockModerationStore) RestoreArticle(ctx context.Context, slug string) error {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationStore_RestoreArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreArticle'
+type MockModerationStore_RestoreArticle_Call struct {
+	*mock.Call
+}
+
+// RestoreArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockModerationStore_Expecter) RestoreArticle(ctx interface{}, slug interface{}) *MockModerationStore_RestoreArticle_Call {
+	return &MockModerationStore_RestoreArticle_Call{Call: _e.mock.On("RestoreArticle", ctx, slug)}
+}
+
+func (_c *MockModerationStore_RestoreArticle_Call) Run(run func(ctx context.Context, slug string)) *MockModerationStore_RestoreArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationStore_RestoreArticle_Call) Return(err error) *MockModerationStore_RestoreArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationStore_RestoreArticle_Call) RunAndReturn(run func(ctx context.Context, slug string) error) *MockModerationStore_RestoreArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TakeDownArticle provides a mock function for the type MockModerationStore
+func (_mock *MockModerationStore) TakeDownArticle(ctx context.Context, params store.TakeDownArticleParams) error {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeDownArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.TakeDownArticleParams) error); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockModerationStore_TakeDownArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TakeDownArticle'
+type MockModerationStore_TakeDownArticle_Call struct {
+	*mock.Call
+}
+
+// TakeDownArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.TakeDownArticleParams
+func (_e *MockModerationStore_Expecter) TakeDownArticle(ctx interface{}, params interface{}) *MockModerationStore_TakeDownArticle_Call {
+	return &MockModerationStore_TakeDownArticle_Call{Call: _e.mock.On("TakeDownArticle", ctx, params)}
+}
+
+func (_c *MockModerationStore_TakeDownArticle_Call) Run(run func(ctx context.Context, params store.TakeDownArticleParams)) *MockModerationStore_TakeDownArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.TakeDownArticleParams
+		if args[1] != nil {
+			arg1 = args[1].(store.TakeDownArticleParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockModerationStore_TakeDownArticle_Call) Return(err error) *MockModerationStore_TakeDownArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockModerationStore_TakeDownArticle_Call) RunAndReturn(run func(ctx context.Context, params store.TakeDownArticleParams) error) *MockModerationStore_TakeDownArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReadingListStore creates a new instance of MockReadingListStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReadingListStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReadingListStore {
+	mock := &MockReadingListStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReadingListStore is an autogenerated mock type for the ReadingListStore type
+type MockReadingListStore struct {
+	mock.Mock
+}
+
+type MockReadingListStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReadingListStore) EXPECT() *MockReadingListStore_Expecter {
+	return &MockReadingListStore_Expecter{mock: &_m.Mock}
+}
+
+// AddReadingListArticle provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) AddReadingListArticle(ctx context.Context, params store.ReadingListArticleParams) error {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReadingListArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ReadingListArticleParams) error); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReadingListStore_AddReadingListArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReadingListArticle'
+type MockReadingListStore_AddReadingListArticle_Call struct {
+	*mock.Call
+}
+
+// AddReadingListArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ReadingListArticleParams
+func (_e *MockReadingListStore_Expecter) AddReadingListArticle(ctx interface{}, params interface{}) *MockReadingListStore_AddReadingListArticle_Call {
+	return &MockReadingListStore_AddReadingListArticle_Call{Call: _e.mock.On("AddReadingListArticle", ctx, params)}
+}
+
+func (_c *MockReadingListStore_AddReadingListArticle_Call) Run(run func(ctx context.Context, params store.ReadingListArticleParams)) *MockReadingListStore_AddReadingListArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ReadingListArticleParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ReadingListArticleParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_AddReadingListArticle_Call) Return(err error) *MockReadingListStore_AddReadingListArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReadingListStore_AddReadingListArticle_Call) RunAndReturn(run func(ctx context.Context, params store.ReadingListArticleParams) error) *MockReadingListStore_AddReadingListArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReadingList provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) CreateReadingList(ctx context.Context, params store.CreateReadingListParams) (*store.ReadingList, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReadingList")
+	}
+
+	var r0 *store.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateReadingListParams) (*store.ReadingList, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateReadingListParams) *store.ReadingList); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateReadingListParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReadingListStore_CreateReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReadingList'
+type MockReadingListStore_CreateReadingList_Call struct {
+	*mock.Call
+}
+
+// CreateReadingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.CreateReadingListParams
+func (_e *MockReadingListStore_Expecter) CreateReadingList(ctx interface{}, params interface{}) *MockReadingListStore_CreateReadingList_Call {
+	return &MockReadingListStore_CreateReadingList_Call{Call: _e.mock.On("CreateReadingList", ctx, params)}
+}
+
+func (_c *MockReadingListStore_CreateReadingList_Call) Run(run func(ctx context.Context, params store.CreateReadingListParams)) *MockReadingListStore_CreateReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CreateReadingListParams
+		if args[1] != nil {
+			arg1 = args[1].(store.CreateReadingListParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_CreateReadingList_Call) Return(readingList *store.ReadingList, err error) *MockReadingListStore_CreateReadingList_Call {
+	_c.Call.Return(readingList, err)
+	return _c
+}
+
+func (_c *MockReadingListStore_CreateReadingList_Call) RunAndReturn(run func(ctx context.Context, params store.CreateReadingListParams) (*store.ReadingList, error)) *MockReadingListStore_CreateReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReadingList provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) DeleteReadingList(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) error {
+	ret := _mock.Called(ctx, id, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReadingList")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id, ownerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReadingListStore_DeleteReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReadingList'
+type MockReadingListStore_DeleteReadingList_Call struct {
+	*mock.Call
+}
+
+// DeleteReadingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - ownerID uuid.UUID
+func (_e *MockReadingListStore_Expecter) DeleteReadingList(ctx interface{}, id interface{}, ownerID interface{}) *MockReadingListStore_DeleteReadingList_Call {
+	return &MockReadingListStore_DeleteReadingList_Call{Call: _e.mock.On("DeleteReadingList", ctx, id, ownerID)}
+}
+
+func (_c *MockReadingListStore_DeleteReadingList_Call) Run(run func(ctx context.Context, id uuid.UUID, ownerID uuid.UUID)) *MockReadingListStore_DeleteReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_DeleteReadingList_Call) Return(err error) *MockReadingListStore_DeleteReadingList_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReadingListStore_DeleteReadingList_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, ownerID uuid.UUID) error) *MockReadingListStore_DeleteReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadingList provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) GetReadingList(ctx context.Context, id uuid.UUID, viewerID uuid.UUID) (*store.ReadingList, error) {
+	ret := _mock.Called(ctx, id, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadingList")
+	}
+
+	var r0 *store.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*store.ReadingList, error)); ok {
+		return returnFunc(ctx, id, viewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *store.ReadingList); ok {
+		r0 = returnFunc(ctx, id, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReadingListStore_GetReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadingList'
+type MockReadingListStore_GetReadingList_Call struct {
+	*mock.Call
+}
+
+// GetReadingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - viewerID uuid.UUID
+func (_e *MockReadingListStore_Expecter) GetReadingList(ctx interface{}, id interface{}, viewerID interface{}) *MockReadingListStore_GetReadingList_Call {
+	return &MockReadingListStore_GetReadingList_Call{Call: _e.mock.On("GetReadingList", ctx, id, viewerID)}
+}
+
+func (_c *MockReadingListStore_GetReadingList_Call) Run(run func(ctx context.Context, id uuid.UUID, viewerID uuid.UUID)) *MockReadingListStore_GetReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_GetReadingList_Call) Return(readingList *store.ReadingList, err error) *MockReadingListStore_GetReadingList_Call {
+	_c.Call.Return(readingList, err)
+	return _c
+}
+
+func (_c *MockReadingListStore_GetReadingList_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, viewerID uuid.UUID) (*store.ReadingList, error)) *MockReadingListStore_GetReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReadingListArticles provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) ListReadingListArticles(ctx context.Context, readingListID uuid.UUID, viewerID uuid.UUID) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, readingListID, viewerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReadingListArticles")
+	}
+
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, readingListID, viewerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, readingListID, viewerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, readingListID, viewerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReadingListStore_ListReadingListArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReadingListArticles'
+type MockReadingListStore_ListReadingListArticles_Call struct {
+	*mock.Call
+}
+
+// ListReadingListArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readingListID uuid.UUID
+//   - viewerID uuid.UUID
+func (_e *MockReadingListStore_Expecter) ListReadingListArticles(ctx interface{}, readingListID interface{}, viewerID interface{}) *MockReadingListStore_ListReadingListArticles_Call {
+	return &MockReadingListStore_ListReadingListArticles_Call{Call: _e.mock.On("ListReadingListArticles", ctx, readingListID, viewerID)}
+}
+
+func (_c *MockReadingListStore_ListReadingListArticles_Call) Run(run func(ctx context.Context, readingListID uuid.UUID, viewerID uuid.UUID)) *MockReadingListStore_ListReadingListArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_ListReadingListArticles_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockReadingListStore_ListReadingListArticles_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockReadingListStore_ListReadingListArticles_Call) RunAndReturn(run func(ctx context.Context, readingListID uuid.UUID, viewerID uuid.UUID) ([]store.ArticlePreview, error)) *MockReadingListStore_ListReadingListArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReadingLists provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) ListReadingLists(ctx context.Context, ownerID uuid.UUID) ([]store.ReadingList, error) {
+	ret := _mock.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReadingLists")
+	}
+
+	var r0 []store.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]store.ReadingList, error)); ok {
+		return returnFunc(ctx, ownerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []store.ReadingList); ok {
+		r0 = returnFunc(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReadingListStore_ListReadingLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReadingLists'
+type MockReadingListStore_ListReadingLists_Call struct {
+	*mock.Call
+}
+
+// ListReadingLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+func (_e *MockReadingListStore_Expecter) ListReadingLists(ctx interface{}, ownerID interface{}) *MockReadingListStore_ListReadingLists_Call {
+	return &MockReadingListStore_ListReadingLists_Call{Call: _e.mock.On("ListReadingLists", ctx, ownerID)}
+}
+
+func (_c *MockReadingListStore_ListReadingLists_Call) Run(run func(ctx context.Context, ownerID uuid.UUID)) *MockReadingListStore_ListReadingLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_ListReadingLists_Call) Return(readingLists []store.ReadingList, err error) *MockReadingListStore_ListReadingLists_Call {
+	_c.Call.Return(readingLists, err)
+	return _c
+}
+
+func (_c *MockReadingListStore_ListReadingLists_Call) RunAndReturn(run func(ctx context.Context, ownerID uuid.UUID) ([]store.ReadingList, error)) *MockReadingListStore_ListReadingLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReadingListArticle provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) RemoveReadingListArticle(ctx context.Context, params store.ReadingListArticleParams) error {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReadingListArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ReadingListArticleParams) error); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReadingListStore_RemoveReadingListArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReadingListArticle'
+type MockReadingListStore_RemoveReadingListArticle_Call struct {
+	*mock.Call
+}
+
+// RemoveReadingListArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ReadingListArticleParams
+func (_e *MockReadingListStore_Expecter) RemoveReadingListArticle(ctx interface{}, params interface{}) *MockReadingListStore_RemoveReadingListArticle_Call {
+	return &MockReadingListStore_RemoveReadingListArticle_Call{Call: _e.mock.On("RemoveReadingListArticle", ctx, params)}
+}
+
+func (_c *MockReadingListStore_RemoveReadingListArticle_Call) Run(run func(ctx context.Context, params store.ReadingListArticleParams)) *MockReadingListStore_RemoveReadingListArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ReadingListArticleParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ReadingListArticleParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_RemoveReadingListArticle_Call) Return(err error) *MockReadingListStore_RemoveReadingListArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReadingListStore_RemoveReadingListArticle_Call) RunAndReturn(run func(ctx context.Context, params store.ReadingListArticleParams) error) *MockReadingListStore_RemoveReadingListArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReadingList provides a mock function for the type MockReadingListStore
+func (_mock *MockReadingListStore) UpdateReadingList(ctx context.Context, params store.UpdateReadingListParams) (*store.ReadingList, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReadingList")
+	}
+
+	var r0 *store.ReadingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateReadingListParams) (*store.ReadingList, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateReadingListParams) *store.ReadingList); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ReadingList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.UpdateReadingListParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReadingListStore_UpdateReadingList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReadingList'
+type MockReadingListStore_UpdateReadingList_Call struct {
+	*mock.Call
+}
+
+// UpdateReadingList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.UpdateReadingListParams
+func (_e *MockReadingListStore_Expecter) UpdateReadingList(ctx interface{}, params interface{}) *MockReadingListStore_UpdateReadingList_Call {
+	return &MockReadingListStore_UpdateReadingList_Call{Call: _e.mock.On("UpdateReadingList", ctx, params)}
+}
+
+func (_c *MockReadingListStore_UpdateReadingList_Call) Run(run func(ctx context.Context, params store.UpdateReadingListParams)) *MockReadingListStore_UpdateReadingList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.UpdateReadingListParams
+		if args[1] != nil {
+			arg1 = args[1].(store.UpdateReadingListParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadingListStore_UpdateReadingList_Call) Return(readingList *store.ReadingList, err error) *MockReadingListStore_UpdateReadingList_Call {
+	_c.Call.Return(readingList, err)
+	return _c
+}
+
+func (_c *MockReadingListStore_UpdateReadingList_Call) RunAndReturn(run func(ctx context.Context, params store.UpdateReadingListParams) (*store.ReadingList, error)) *MockReadingListStore_UpdateReadingList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRelatedArticleStore creates a new instance of MockRelatedArticleStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRelatedArticleStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRelatedArticleStore {
+	mock := &MockRelatedArticleStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRelatedArticleStore is an autogenerated mock type for the RelatedArticleStore type
+type MockRelatedArticleStore struct {
+	mock.Mock
+}
+
+type MockRelatedArticleStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRelatedArticleStore) EXPECT() *MockRelatedArticleStore_Expecter {
+	return &MockRelatedArticleStore_Expecter{mock: &_m.Mock}
+}
+
+// ListRelatedArticles provides a mock function for the type MockRelatedArticleStore
+func (_mock *MockRelatedArticleStore) ListRelatedArticles(ctx context.Context, params store.ListRelatedArticlesParams) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelatedArticles")
+	}
+
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListRelatedArticlesParams) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListRelatedArticlesParams) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListRelatedArticlesParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRelatedArticleStore_ListRelatedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRelatedArticles'
+type MockRelatedArticleStore_ListRelatedArticles_Call struct {
+	*mock.Call
+}
+
+// ListRelatedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListRelatedArticlesParams
+func (_e *MockRelatedArticleStore_Expecter) ListRelatedArticles(ctx interface{}, params interface{}) *MockRelatedArticleStore_ListRelatedArticles_Call {
+	return &MockRelatedArticleStore_ListRelatedArticles_Call{Call: _e.mock.On("ListRelatedArticles", ctx, params)}
+}
+
+func (_c *MockRelatedArticleStore_ListRelatedArticles_Call) Run(run func(ctx context.Context, params store.ListRelatedArticlesParams)) *MockRelatedArticleStore_ListRelatedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListRelatedArticlesParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListRelatedArticlesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelatedArticleStore_ListRelatedArticles_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockRelatedArticleStore_ListRelatedArticles_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockRelatedArticleStore_ListRelatedArticles_Call) RunAndReturn(run func(ctx context.Context, params store.ListRelatedArticlesParams) ([]store.ArticlePreview, error)) *MockRelatedArticleStore_ListRelatedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSeriesStore creates a new instance of MockSeriesStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSeriesStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSeriesStore {
+	mock := &MockSeriesStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSeriesStore is an autogenerated mock type for the SeriesStore type
+type MockSeriesStore struct {
+	mock.Mock
+}
+
+type MockSeriesStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSeriesStore) EXPECT() *MockSeriesStore_Expecter {
+	return &MockSeriesStore_Expecter{mock: &_m.Mock}
+}
+
+// CompleteSeries provides a mock function for the type MockSeriesStore
+func (_mock *MockSeriesStore) CompleteSeries(ctx context.Context, params store.CompleteSeriesParams) (*store.Series, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteSeries")
+	}
+
+	var r0 *store.Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CompleteSeriesParams) (*store.Series, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CompleteSeriesParams) *store.Series); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Series)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CompleteSeriesParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSeriesStore_CompleteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSeries'
+type MockSeriesStore_CompleteSeries_Call struct {
+	*mock.Call
+}
+
+// CompleteSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.CompleteSeriesParams
+func (_e *MockSeriesStore_Expecter) CompleteSeries(ctx interface{}, params interface{}) *MockSeriesStore_CompleteSeries_Call {
+	return &MockSeriesStore_CompleteSeries_Call{Call: _e.mock.On("CompleteSeries", ctx, params)}
+}
+
+func (_c *MockSeriesStore_CompleteSeries_Call) Run(run func(ctx context.Context, params store.CompleteSeriesParams)) *MockSeriesStore_CompleteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CompleteSeriesParams
+		if args[1] != nil {
+			arg1 = args[1].(store.CompleteSeriesParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSeriesStore_CompleteSeries_Call) Return(series *store.Series, err error) *MockSeriesStore_CompleteSeries_Call {
+	_c.Call.Return(series, err)
+	return _c
+}
+
+func (_c *MockSeriesStore_CompleteSeries_Call) RunAndReturn(run func(ctx context.Context, params store.CompleteSeriesParams) (*store.Series, error)) *MockSeriesStore_CompleteSeries_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// CreateArticle provides a mock function for the type MockArticleStore
-func (_mock *MockArticleStore) CreateArticle(ctx context.Context, article *store.Article) error {
-	ret := _mock.Called(ctx, article)
+// CreateSeries provides a mock function for the type MockSeriesStore
+func (_mock *MockSeriesStore) CreateSeries(ctx context.Context, params store.CreateSeriesParams) (*store.Series, error) {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateArticle")
+		panic("no return value specified for CreateSeries")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *store.Article) error); ok {
-		r0 = returnFunc(ctx, article)
+	var r0 *store.Series
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateSeriesParams) (*store.Series, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateSeriesParams) *store.Series); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.Series)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateSeriesParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockArticleStore_CreateArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateArticle'
-type MockArticleStore_CreateArticle_Call struct {
+// MockSeriesStore_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockSeriesStore_CreateSeries_Call struct {
 	*mock.Call
 }
 
-// CreateArticle is a helper method to define mock.On call
+// CreateSeries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - article *store.Article
-func (_e *MockArticleStore_Expecter) CreateArticle(ctx interface{}, article interface{}) *MockArticleStore_CreateArticle_Call {
-	return &MockArticleStore_CreateArticle_Call{Call: _e.mock.On("CreateArticle", ctx, article)}
+//   - params store.CreateSeriesParams
+func (_e *MockSeriesStore_Expecter) CreateSeries(ctx interface{}, params interface{}) *MockSeriesStore_CreateSeries_Call {
+	return &MockSeriesStore_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, params)}
 }
 
-func (_c *MockArticleStore_CreateArticle_Call) Run(run func(ctx context.Context, article *store.Article)) *MockArticleStore_CreateArticle_Call {
+func (_c *MockSeriesStore_CreateSeries_Call) Run(run func(ctx context.Context, params store.CreateSeriesParams)) *MockSeriesStore_CreateSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *store.Article
+		var arg1 store.CreateSeriesParams
 		if args[1] != nil {
-			arg1 = args[1].(*store.Article)
+			arg1 = args[1].(store.CreateSeriesParams)
 		}
 		run(
 			arg0,
@@ -85,65 +4250,65 @@ func (_c *MockArticleStore_CreateArticle_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockArticleStore_CreateArticle_Call) Return(err error) *MockArticleStore_CreateArticle_Call {
-	_c.Call.Return(err)
+func (_c *MockSeriesStore_CreateSeries_Call) Return(series *store.Series, err error) *MockSeriesStore_CreateSeries_Call {
+	_c.Call.Return(series, err)
 	return _c
 }
 
-func (_c *MockArticleStore_CreateArticle_Call) RunAndReturn(run func(ctx context.Context, article *store.Article) error) *MockArticleStore_CreateArticle_Call {
+func (_c *MockSeriesStore_CreateSeries_Call) RunAndReturn(run func(ctx context.Context, params store.CreateSeriesParams) (*store.Series, error)) *MockSeriesStore_CreateSeries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetArticleBySlug provides a mock function for the type MockArticleStore
-func (_mock *MockArticleStore) GetArticleBySlug(ctx context.Context, slug string) (*store.ArticleDetails, error) {
-	ret := _mock.Called(ctx, slug)
+// GetSeries provides a mock function for the type MockSeriesStore
+func (_mock *MockSeriesStore) GetSeries(ctx context.Context, id uuid.UUID) (*store.Series, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetArticleBySlug")
+		panic("no return value specified for GetSeries")
 	}
 
-	var r0 *store.ArticleDetails
+	var r0 *store.Series
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.ArticleDetails, error)); ok {
-		return returnFunc(ctx, slug)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*store.Series, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.ArticleDetails); ok {
-		r0 = returnFunc(ctx, slug)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *store.Series); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*store.ArticleDetails)
+			r0 = ret.Get(0).(*store.Series)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, slug)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockArticleStore_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
-type MockArticleStore_GetArticleBySlug_Call struct {
+// MockSeriesStore_GetSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeries'
+type MockSeriesStore_GetSeries_Call struct {
 	*mock.Call
 }
 
-// GetArticleBySlug is a helper method to define mock.On call
+// GetSeries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - slug string
-func (_e *MockArticleStore_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}) *MockArticleStore_GetArticleBySlug_Call {
-	return &MockArticleStore_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug)}
+//   - id uuid.UUID
+func (_e *MockSeriesStore_Expecter) GetSeries(ctx interface{}, id interface{}) *MockSeriesStore_GetSeries_Call {
+	return &MockSeriesStore_GetSeries_Call{Call: _e.mock.On("GetSeries", ctx, id)}
 }
 
-func (_c *MockArticleStore_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockArticleStore_GetArticleBySlug_Call {
+func (_c *MockSeriesStore_GetSeries_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockSeriesStore_GetSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 uuid.UUID
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
@@ -153,85 +4318,91 @@ func (_c *MockArticleStore_GetArticleBySlug_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockArticleStore_GetArticleBySlug_Call) Return(articleDetails *store.ArticleDetails, err error) *MockArticleStore_GetArticleBySlug_Call {
-	_c.Call.Return(articleDetails, err)
+func (_c *MockSeriesStore_GetSeries_Call) Return(series *store.Series, err error) *MockSeriesStore_GetSeries_Call {
+	_c.Call.Return(series, err)
 	return _c
 }
 
-func (_c *MockArticleStore_GetArticleBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*store.ArticleDetails, error)) *MockArticleStore_GetArticleBySlug_Call {
+func (_c *MockSeriesStore_GetSeries_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*store.Series, error)) *MockSeriesStore_GetSeries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListArticlesPreviews provides a mock function for the type MockArticleStore
-func (_mock *MockArticleStore) ListArticlesPreviews(ctx context.Context) ([]store.ArticlePreview, error) {
-	ret := _mock.Called(ctx)
+// ListSeriesArticles provides a mock function for the type MockSeriesStore
+func (_mock *MockSeriesStore) ListSeriesArticles(ctx context.Context, seriesID uuid.UUID) ([]store.SeriesArticle, error) {
+	ret := _mock.Called(ctx, seriesID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListArticlesPreviews")
+		panic("no return value specified for ListSeriesArticles")
 	}
 
-	var r0 []store.ArticlePreview
+	var r0 []store.SeriesArticle
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]store.ArticlePreview, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]store.SeriesArticle, error)); ok {
+		return returnFunc(ctx, seriesID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []store.ArticlePreview); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []store.SeriesArticle); ok {
+		r0 = returnFunc(ctx, seriesID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]store.ArticlePreview)
+			r0 = ret.Get(0).([]store.SeriesArticle)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, seriesID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockArticleStore_ListArticlesPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesPreviews'
-type MockArticleStore_ListArticlesPreviews_Call struct {
+// MockSeriesStore_ListSeriesArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeriesArticles'
+type MockSeriesStore_ListSeriesArticles_Call struct {
 	*mock.Call
 }
 
-// ListArticlesPreviews is a helper method to define mock.On call
+// ListSeriesArticles is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockArticleStore_Expecter) ListArticlesPreviews(ctx interface{}) *MockArticleStore_ListArticlesPreviews_Call {
-	return &MockArticleStore_ListArticlesPreviews_Call{Call: _e.mock.On("ListArticlesPreviews", ctx)}
+//   - seriesID uuid.UUID
+func (_e *MockSeriesStore_Expecter) ListSeriesArticles(ctx interface{}, seriesID interface{}) *MockSeriesStore_ListSeriesArticles_Call {
+	return &MockSeriesStore_ListSeriesArticles_Call{Call: _e.mock.On("ListSeriesArticles", ctx, seriesID)}
 }
 
-func (_c *MockArticleStore_ListArticlesPreviews_Call) Run(run func(ctx context.Context)) *MockArticleStore_ListArticlesPreviews_Call {
+func (_c *MockSeriesStore_ListSeriesArticles_Call) Run(run func(ctx context.Context, seriesID uuid.UUID)) *MockSeriesStore_ListSeriesArticles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockArticleStore_ListArticlesPreviews_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockArticleStore_ListArticlesPreviews_Call {
-	_c.Call.Return(articlePreviews, err)
+func (_c *MockSeriesStore_ListSeriesArticles_Call) Return(seriesArticles []store.SeriesArticle, err error) *MockSeriesStore_ListSeriesArticles_Call {
+	_c.Call.Return(seriesArticles, err)
 	return _c
 }
 
-func (_c *MockArticleStore_ListArticlesPreviews_Call) RunAndReturn(run func(ctx context.Context) ([]store.ArticlePreview, error)) *MockArticleStore_ListArticlesPreviews_Call {
+func (_c *MockSeriesStore_ListSeriesArticles_Call) RunAndReturn(run func(ctx context.Context, seriesID uuid.UUID) ([]store.SeriesArticle, error)) *MockSeriesStore_ListSeriesArticles_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockAuthStore creates a new instance of MockAuthStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockSitemapStore creates a new instance of MockSitemapStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockAuthStore(t interface {
+func NewMockSitemapStore(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockAuthStore {
-	mock := &MockAuthStore{}
+}) *MockSitemapStore {
+	mock := &MockSitemapStore{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -239,136 +4410,128 @@ func NewMockAuthStore(t interface {
 	return mock
 }
 
-// MockAuthStore is an autogenerated mock type for the AuthStore type
-type MockAuthStore struct {
+// MockSitemapStore is an autogenerated mock type for the SitemapStore type
+type MockSitemapStore struct {
 	mock.Mock
 }
 
-type MockAuthStore_Expecter struct {
+type MockSitemapStore_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockAuthStore) EXPECT() *MockAuthStore_Expecter {
-	return &MockAuthStore_Expecter{mock: &_m.Mock}
+func (_m *MockSitemapStore) EXPECT() *MockSitemapStore_Expecter {
+	return &MockSitemapStore_Expecter{mock: &_m.Mock}
 }
 
-// CreateUser provides a mock function for the type MockAuthStore
-func (_mock *MockAuthStore) CreateUser(ctx context.Context, params store.CreateUserParams) (*store.User, error) {
-	ret := _mock.Called(ctx, params)
+// CountSitemapEntries provides a mock function for the type MockSitemapStore
+func (_mock *MockSitemapStore) CountSitemapEntries(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for CountSitemapEntries")
 	}
 
-	var r0 *store.User
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateUserParams) (*store.User, error)); ok {
-		return returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateUserParams) *store.User); ok {
-		r0 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*store.User)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, store.CreateUserParams) error); ok {
-		r1 = returnFunc(ctx, params)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockAuthStore_CreateUser_Call struct {
+// MockSitemapStore_CountSitemapEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSitemapEntries'
+type MockSitemapStore_CountSitemapEntries_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// CountSitemapEntries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params store.CreateUserParams
-func (_e *MockAuthStore_Expecter) CreateUser(ctx interface{}, params interface{}) *MockAuthStore_CreateUser_Call {
-	return &MockAuthStore_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, params)}
+func (_e *MockSitemapStore_Expecter) CountSitemapEntries(ctx interface{}) *MockSitemapStore_CountSitemapEntries_Call {
+	return &MockSitemapStore_CountSitemapEntries_Call{Call: _e.mock.On("CountSitemapEntries", ctx)}
 }
 
-func (_c *MockAuthStore_CreateUser_Call) Run(run func(ctx context.Context, params store.CreateUserParams)) *MockAuthStore_CreateUser_Call {
+func (_c *MockSitemapStore_CountSitemapEntries_Call) Run(run func(ctx context.Context)) *MockSitemapStore_CountSitemapEntries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 store.CreateUserParams
-		if args[1] != nil {
-			arg1 = args[1].(store.CreateUserParams)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockAuthStore_CreateUser_Call) Return(user *store.User, err error) *MockAuthStore_CreateUser_Call {
-	_c.Call.Return(user, err)
+func (_c *MockSitemapStore_CountSitemapEntries_Call) Return(n int, err error) *MockSitemapStore_CountSitemapEntries_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockAuthStore_CreateUser_Call) RunAndReturn(run func(ctx context.Context, params store.CreateUserParams) (*store.User, error)) *MockAuthStore_CreateUser_Call {
+func (_c *MockSitemapStore_CountSitemapEntries_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockSitemapStore_CountSitemapEntries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function for the type MockAuthStore
-func (_mock *MockAuthStore) GetUser(ctx context.Context, emailOrUsername string) (*store.User, error) {
-	ret := _mock.Called(ctx, emailOrUsername)
+// ListSitemapEntries provides a mock function for the type MockSitemapStore
+func (_mock *MockSitemapStore) ListSitemapEntries(ctx context.Context, params store.ListSitemapEntriesParams) ([]store.SitemapEntry, error) {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
+		panic("no return value specified for ListSitemapEntries")
 	}
 
-	var r0 *store.User
+	var r0 []store.SitemapEntry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.User, error)); ok {
-		return returnFunc(ctx, emailOrUsername)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListSitemapEntriesParams) ([]store.SitemapEntry, error)); ok {
+		return returnFunc(ctx, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.User); ok {
-		r0 = returnFunc(ctx, emailOrUsername)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListSitemapEntriesParams) []store.SitemapEntry); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*store.User)
+			r0 = ret.Get(0).([]store.SitemapEntry)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, emailOrUsername)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListSitemapEntriesParams) error); ok {
+		r1 = returnFunc(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockAuthStore_GetUser_Call struct {
+// MockSitemapStore_ListSitemapEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSitemapEntries'
+type MockSitemapStore_ListSitemapEntries_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// ListSitemapEntries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - emailOrUsername string
-func (_e *MockAuthStore_Expecter) GetUser(ctx interface{}, emailOrUsername interface{}) *MockAuthStore_GetUser_Call {
-	return &MockAuthStore_GetUser_Call{Call: _e.mock.On("GetUser", ctx, emailOrUsername)}
+//   - params store.ListSitemapEntriesParams
+func (_e *MockSitemapStore_Expecter) ListSitemapEntries(ctx interface{}, params interface{}) *MockSitemapStore_ListSitemapEntries_Call {
+	return &MockSitemapStore_ListSitemapEntries_Call{Call: _e.mock.On("ListSitemapEntries", ctx, params)}
 }
 
-func (_c *MockAuthStore_GetUser_Call) Run(run func(ctx context.Context, emailOrUsername string)) *MockAuthStore_GetUser_Call {
+func (_c *MockSitemapStore_ListSitemapEntries_Call) Run(run func(ctx context.Context, params store.ListSitemapEntriesParams)) *MockSitemapStore_ListSitemapEntries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 store.ListSitemapEntriesParams
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(store.ListSitemapEntriesParams)
 		}
 		run(
 			arg0,
@@ -378,57 +4541,84 @@ func (_c *MockAuthStore_GetUser_Call) Run(run func(ctx context.Context, emailOrU
 	return _c
 }
 
-func (_c *MockAuthStore_GetUser_Call) Return(user *store.User, err error) *MockAuthStore_GetUser_Call {
-	_c.Call.Return(user, err)
+func (_c *MockSitemapStore_ListSitemapEntries_Call) Return(sitemapEntrys []store.SitemapEntry, err error) *MockSitemapStore_ListSitemapEntries_Call {
+	_c.Call.Return(sitemapEntrys, err)
 	return _c
 }
 
-func (_c *MockAuthStore_GetUser_Call) RunAndReturn(run func(ctx context.Context, emailOrUsername string) (*store.User, error)) *MockAuthStore_GetUser_Call {
+func (_c *MockSitemapStore_ListSitemapEntries_Call) RunAndReturn(run func(ctx context.Context, params store.ListSitemapEntriesParams) ([]store.SitemapEntry, error)) *MockSitemapStore_ListSitemapEntries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByID provides a mock function for the type MockAuthStore
-func (_mock *MockAuthStore) GetUserByID(ctx context.Context, userID string) (*store.User, error) {
-	ret := _mock.Called(ctx, userID)
+// NewMockTagStore creates a new instance of MockTagStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagStore {
+	mock := &MockTagStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTagStore is an autogenerated mock type for the TagStore type
+type MockTagStore struct {
+	mock.Mock
+}
+
+type MockTagStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagStore) EXPECT() *MockTagStore_Expecter {
+	return &MockTagStore_Expecter{mock: &_m.Mock}
+}
+
+// GetTagBySlug provides a mock function for the type MockTagStore
+func (_mock *MockTagStore) GetTagBySlug(ctx context.Context, slug string) (*store.Tag, error) {
+	ret := _mock.Called(ctx, slug)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for GetTagBySlug")
 	}
 
-	var r0 *store.User
+	var r0 *store.Tag
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.User, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.Tag, error)); ok {
+		return returnFunc(ctx, slug)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.User); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.Tag); ok {
+		r0 = returnFunc(ctx, slug)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*store.User)
+			r0 = ret.Get(0).(*store.Tag)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, userID)
+		r1 = returnFunc(ctx, slug)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockAuthStore_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type MockAuthStore_GetUserByID_Call struct {
+// MockTagStore_GetTagBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagBySlug'
+type MockTagStore_GetTagBySlug_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
+// GetTagBySlug is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-func (_e *MockAuthStore_Expecter) GetUserByID(ctx interface{}, userID interface{}) *MockAuthStore_GetUserByID_Call {
-	return &MockAuthStore_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
+//   - slug string
+func (_e *MockTagStore_Expecter) GetTagBySlug(ctx interface{}, slug interface{}) *MockTagStore_GetTagBySlug_Call {
+	return &MockTagStore_GetTagBySlug_Call{Call: _e.mock.On("GetTagBySlug", ctx, slug)}
 }
 
-func (_c *MockAuthStore_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthStore_GetUserByID_Call {
+func (_c *MockTagStore_GetTagBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockTagStore_GetTagBySlug_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -446,61 +4636,102 @@ func (_c *MockAuthStore_GetUserByID_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockAuthStore_GetUserByID_Call) Return(user *store.User, err error) *MockAuthStore_GetUserByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockTagStore_GetTagBySlug_Call) Return(tag *store.Tag, err error) *MockTagStore_GetTagBySlug_Call {
+	_c.Call.Return(tag, err)
 	return _c
 }
 
-func (_c *MockAuthStore_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*store.User, error)) *MockAuthStore_GetUserByID_Call {
+func (_c *MockTagStore_GetTagBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*store.Tag, error)) *MockTagStore_GetTagBySlug_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockDigitalAuthorStore creates a new instance of MockDigitalAuthorStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockDigitalAuthorStore(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockDigitalAuthorStore {
-	mock := &MockDigitalAuthorStore{}
-	mock.Mock.Test(t)
+// ListArticlesPreviews provides a mock function for the type MockTagStore
+func (_mock *MockTagStore) ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error) {
+	ret := _mock.Called(ctx, params)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for ListArticlesPreviews")
+	}
 
-	return mock
+	var r0 []store.ArticlePreview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.ListArticlesPreviewsParams) []store.ArticlePreview); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.ArticlePreview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.ListArticlesPreviewsParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockDigitalAuthorStore is an autogenerated mock type for the DigitalAuthorStore type
-type MockDigitalAuthorStore struct {
-	mock.Mock
+// MockTagStore_ListArticlesPreviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListArticlesPreviews'
+type MockTagStore_ListArticlesPreviews_Call struct {
+	*mock.Call
 }
 
-type MockDigitalAuthorStore_Expecter struct {
-	mock *mock.Mock
+// ListArticlesPreviews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.ListArticlesPreviewsParams
+func (_e *MockTagStore_Expecter) ListArticlesPreviews(ctx interface{}, params interface{}) *MockTagStore_ListArticlesPreviews_Call {
+	return &MockTagStore_ListArticlesPreviews_Call{Call: _e.mock.On("ListArticlesPreviews", ctx, params)}
 }
 
-func (_m *MockDigitalAuthorStore) EXPECT() *MockDigitalAuthorStore_Expecter {
-	return &MockDigitalAuthorStore_Expecter{mock: &_m.Mock}
+func (_c *MockTagStore_ListArticlesPreviews_Call) Run(run func(ctx context.Context, params store.ListArticlesPreviewsParams)) *MockTagStore_ListArticlesPreviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.ListArticlesPreviewsParams
+		if args[1] != nil {
+			arg1 = args[1].(store.ListArticlesPreviewsParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-// ListDigitalAuthors provides a mock function for the type MockDigitalAuthorStore
-func (_mock *MockDigitalAuthorStore) ListDigitalAuthors(ctx context.Context) ([]*store.DigitalAuthor, error) {
+func (_c *MockTagStore_ListArticlesPreviews_Call) Return(articlePreviews []store.ArticlePreview, err error) *MockTagStore_ListArticlesPreviews_Call {
+	_c.Call.Return(articlePreviews, err)
+	return _c
+}
+
+func (_c *MockTagStore_ListArticlesPreviews_Call) RunAndReturn(run func(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)) *MockTagStore_ListArticlesPreviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function for the type MockTagStore
+func (_mock *MockTagStore) ListTags(ctx context.Context) ([]store.TagSummary, error) {
 	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListDigitalAuthors")
+		panic("no return value specified for ListTags")
 	}
 
-	var r0 []*store.DigitalAuthor
+	var r0 []store.TagSummary
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*store.DigitalAuthor, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]store.TagSummary, error)); ok {
 		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []*store.DigitalAuthor); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []store.TagSummary); ok {
 		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*store.DigitalAuthor)
+			r0 = ret.Get(0).([]store.TagSummary)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
@@ -511,18 +4742,18 @@ func (_mock *MockDigitalAuthorStore) ListDigitalAuthors(ctx context.Context) ([]
 	return r0, r1
 }
 
-// MockDigitalAuthorStore_ListDigitalAuthors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDigitalAuthors'
-type MockDigitalAuthorStore_ListDigitalAuthors_Call struct {
+// MockTagStore_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockTagStore_ListTags_Call struct {
 	*mock.Call
 }
 
-// ListDigitalAuthors is a helper method to define mock.On call
+// ListTags is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDigitalAuthorStore_Expecter) ListDigitalAuthors(ctx interface{}) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
-	return &MockDigitalAuthorStore_ListDigitalAuthors_Call{Call: _e.mock.On("ListDigitalAuthors", ctx)}
+func (_e *MockTagStore_Expecter) ListTags(ctx interface{}) *MockTagStore_ListTags_Call {
+	return &MockTagStore_ListTags_Call{Call: _e.mock.On("ListTags", ctx)}
 }
 
-func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) Run(run func(ctx context.Context)) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
+func (_c *MockTagStore_ListTags_Call) Run(run func(ctx context.Context)) *MockTagStore_ListTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -535,12 +4766,12 @@ func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) Return(digitalAuthors []*store.DigitalAuthor, err error) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
-	_c.Call.Return(digitalAuthors, err)
+func (_c *MockTagStore_ListTags_Call) Return(tagSummarys []store.TagSummary, err error) *MockTagStore_ListTags_Call {
+	_c.Call.Return(tagSummarys, err)
 	return _c
 }
 
-func (_c *MockDigitalAuthorStore_ListDigitalAuthors_Call) RunAndReturn(run func(ctx context.Context) ([]*store.DigitalAuthor, error)) *MockDigitalAuthorStore_ListDigitalAuthors_Call {
+func (_c *MockTagStore_ListTags_Call) RunAndReturn(run func(ctx context.Context) ([]store.TagSummary, error)) *MockTagStore_ListTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
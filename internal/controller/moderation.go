@@ -0,0 +1,413 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+const (
+	CodeReportNotFound        ErrorCode = "report_not_found"
+	CodeReportAlreadyResolved ErrorCode = "report_already_resolved"
+)
+
+const (
+	defaultReportsPageSize = 20
+)
+
+// ModerationStore defines the store methods used by the moderation controller.
+type ModerationStore interface {
+	CreateReport(ctx context.Context, params store.CreateReportParams) (*store.Report, error)
+	ListReports(ctx context.Context, params store.ListReportsParams) ([]store.Report, error)
+	ResolveReport(ctx context.Context, params store.ResolveReportParams) (*store.Report, error)
+	TakeDownArticle(ctx context.Context, params store.TakeDownArticleParams) error
+	RestoreArticle(ctx context.Context, slug string) error
+}
+
+// ModerationController lets readers report articles and comments, and moderators review the reports and take
+// content down.
+type ModerationController struct {
+	store ModerationStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
+}
+
+func NewModerationController(store ModerationStore, pageTokenSecret []byte) *ModerationController {
+	return &ModerationController{store: store, pageTokenSecret: pageTokenSecret}
+}
+
+// ReportArticle reports a published article to the moderators on behalf of the current user.
+func (c *ModerationController) ReportArticle(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModerationController.ReportArticle")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ArticleReportsURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req CreateReportRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	c.createReport(ctx, ginCtx, span, store.CreateReportParams{
+		ReporterID:  userID,
+		ArticleSlug: uri.Slug,
+		Reason:      store.ReportReason(req.Reason),
+		Details:     req.Details,
+	})
+}
+
+// ReportComment reports a comment to the moderators on behalf of the current user. The comment is flagged until
+// the report is resolved.
+func (c *ModerationController) ReportComment(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModerationController.ReportComment")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri CommentURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req CreateReportRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The ID is validated when binding the request.
+	c.createReport(ctx, ginCtx, span, store.CreateReportParams{
+		ReporterID: userID,
+		CommentID:  uuid.MustParse(uri.ID),
+		Reason:     store.ReportReason(req.Reason),
+		Details:    req.Details,
+	})
+}
+
+func (c *ModerationController) createReport(ctx context.Context, ginCtx *gin.Context, span trace.Span,
+	params store.CreateReportParams,
+) {
+	report, err := c.store.CreateReport(ctx, params)
+	if err != nil {
+		writeModerationErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusCreated, newReport(*report))
+}
+
+// ListReports lists the reports in the moderation queue, oldest first. Only open reports are listed unless
+// another status is requested. It must only be reachable by moderators.
+func (c *ModerationController) ListReports(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModerationController.ListReports")
+	defer span.End()
+
+	var req ListReportsRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	pageSize := defaultReportsPageSize
+	if req.PageSize != nil {
+		pageSize = *req.PageSize
+	}
+	status := store.ReportStatusOpen
+	if req.Status != "" {
+		status = store.ReportStatus(req.Status)
+	}
+
+	// Fetch one extra item to find out whether there is a next page.
+	params := store.ListReportsParams{
+		Status: status,
+		Limit:  pageSize + 1,
+	}
+	if req.PageToken != "" {
+		var pageToken listReportsPageToken
+		if err := utils.ParsePageToken(c.pageTokenSecret, req.PageToken, &pageToken); err != nil {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+		params.After = &store.ReportCursor{
+			CreatedAt: pageToken.CreatedAt,
+			ID:        pageToken.ID,
+		}
+	}
+
+	reports, err := c.store.ListReports(ctx, params)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	var nextPageToken string
+	if len(reports) > pageSize {
+		reports = reports[:pageSize]
+		last := reports[len(reports)-1]
+		nextPageToken, err = utils.GeneratePageToken(c.pageTokenSecret, listReportsPageToken{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+		if err != nil {
+			writeUnknownErrorResponse(ginCtx, span, err)
+			return
+		}
+	}
+
+	response := ListReportsResponse{
+		Items:         make([]Report, len(reports)),
+		NextPageToken: nextPageToken,
+	}
+	for i, report := range reports {
+		response.Items[i] = newReport(report)
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// ResolveReport resolves an open report. Upholding it takes the reported article down or hides the reported
+// comment, and dismissing it keeps the content. It must only be reachable by moderators.
+func (c *ModerationController) ResolveReport(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModerationController.ResolveReport")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ReportURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req ResolveReportRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The ID is validated when binding the request.
+	report, err := c.store.ResolveReport(ctx, store.ResolveReportParams{
+		ID:          uuid.MustParse(uri.ID),
+		ModeratorID: userID,
+		Status:      store.ReportStatus(req.Resolution),
+		Note:        req.Note,
+	})
+	if err != nil {
+		writeModerationErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newReport(*report))
+}
+
+// TakeDownArticle hides an article from the public until it is restored. It must only be reachable by
+// moderators.
+func (c *ModerationController) TakeDownArticle(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModerationController.TakeDownArticle")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ArticleTakedownURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req TakeDownArticleRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	err := c.store.TakeDownArticle(ctx, store.TakeDownArticleParams{
+		Slug:        uri.Slug,
+		ModeratorID: userID,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		writeModerationErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// RestoreArticle reverts the takedown of an article. It must only be reachable by moderators.
+func (c *ModerationController) RestoreArticle(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ModerationController.RestoreArticle")
+	defer span.End()
+
+	var uri ArticleTakedownURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.RestoreArticle(ctx, uri.Slug); err != nil {
+		writeModerationErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+func writeModerationErrorResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	switch {
+	case errors.Is(err, store.ErrArticleNotFound), errors.Is(err, store.ErrCommentNotFound):
+		writeCommentErrorResponse(ginCtx, span, err)
+	case errors.Is(err, store.ErrReportNotFound):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeReportNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+	case errors.Is(err, store.ErrReportAlreadyResolved):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeReportAlreadyResolved,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusConflict,
+		})
+	default:
+		writeUnknownErrorResponse(ginCtx, span, err)
+	}
+}
+
+func newReport(report store.Report) Report {
+	result := Report{
+		ID:         report.ID,
+		ReporterID: report.ReporterID,
+		Reason:     string(report.Reason),
+		Details:    report.Details,
+		Status:     string(report.Status),
+		Article: ReportedArticle{
+			ID:    report.ArticleID,
+			Slug:  report.ArticleSlug,
+			Title: report.ArticleTitle,
+		},
+		ResolutionNote: report.ResolutionNote,
+		CreatedAt:      report.CreatedAt,
+		ResolvedAt:     nullTimePtr(report.ResolvedAt),
+	}
+	if report.CommentID.Valid {
+		result.Comment = &ReportedComment{
+			ID:   report.CommentID.UUID,
+			Body: report.CommentBody.String,
+		}
+	}
+	if report.ResolvedBy.Valid {
+		result.ResolvedBy = &report.ResolvedBy.UUID
+	}
+	return result
+}
+
+type Report struct {
+	ID         uuid.UUID `json:"id"`
+	ReporterID uuid.UUID `json:"reporterID"`
+	// Reason is one of "spam", "harassment", "misinformation", "inappropriate", "copyright" or "other".
+	Reason  string `json:"reason"`
+	Details string `json:"details"`
+	// Status is one of "open", "upheld" or "dismissed".
+	Status string `json:"status"`
+	// Article is the reported article, or the article of the reported comment.
+	Article ReportedArticle `json:"article"`
+	// Comment is only set for reports of comments.
+	Comment        *ReportedComment `json:"comment,omitempty"`
+	ResolvedBy     *uuid.UUID       `json:"resolvedBy,omitempty"`
+	ResolutionNote string           `json:"resolutionNote,omitempty"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	ResolvedAt     *time.Time       `json:"resolvedAt,omitempty"`
+}
+
+type ReportedArticle struct {
+	ID    uuid.UUID `json:"id"`
+	Slug  string    `json:"slug"`
+	Title string    `json:"title"`
+}
+
+type ReportedComment struct {
+	ID   uuid.UUID `json:"id"`
+	Body string    `json:"body"`
+}
+
+// ArticleReportsURI holds the path parameters of the endpoint reporting an article.
+type ArticleReportsURI struct {
+	Slug string `uri:"slug"`
+}
+
+type CreateReportRequest struct {
+	Reason  string `json:"reason" binding:"required,oneof=spam harassment misinformation inappropriate copyright other"`
+	Details string `json:"details" binding:"max=2000"`
+}
+
+type ListReportsRequest struct {
+	// Status defaults to "open".
+	Status    string `form:"status" binding:"omitempty,oneof=open upheld dismissed"`
+	PageToken string `form:"pageToken"`
+	PageSize  *int   `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+type ListReportsResponse struct {
+	Items []Report `json:"items"`
+	// NextPageToken is empty if there are no more results.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// listReportsPageToken is the content of the page token returned by ListReports.
+// It holds the sort key of the last report in the current page.
+type listReportsPageToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// ReportURI holds the path parameters of the endpoints under a report.
+type ReportURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type ResolveReportRequest struct {
+	// Resolution is "upheld" to hide the reported content, or "dismissed" to keep it.
+	Resolution string `json:"resolution" binding:"required,oneof=upheld dismissed"`
+	Note       string `json:"note" binding:"max=2000"`
+}
+
+// ArticleTakedownURI holds the path parameters of the endpoints taking an article down.
+type ArticleTakedownURI struct {
+	Slug string `uri:"slug"`
+}
+
+type TakeDownArticleRequest struct {
+	Reason string `json:"reason" binding:"required,max=2000"`
+}
@@ -0,0 +1,276 @@
+package controller_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestModerationController(t *testing.T) {
+	suite.Run(t, new(ModerationControllerTestSuite))
+}
+
+type ModerationControllerTestSuite struct {
+	suite.Suite
+	mockStore          *controller.MockModerationStore
+	mockModeratorStore *controller.MockModeratorStore
+	tokenIssuer        *token.AccessTokenIssuer
+	router             *gin.Engine
+	userID             uuid.UUID
+}
+
+func (s *ModerationControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *ModerationControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockModerationStore(s.T())
+	s.mockModeratorStore = controller.NewMockModeratorStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewModerationController(s.mockStore, testPageTokenSecret)
+	authMiddleware := controller.AuthMiddleware(s.tokenIssuer)
+	moderatorMiddleware := controller.ModeratorMiddleware(s.mockModeratorStore)
+	s.router.POST("/v1/articles/:slug/reports", authMiddleware, ctrl.ReportArticle)
+	s.router.POST("/v1/comments/:id/reports", authMiddleware, ctrl.ReportComment)
+	s.router.PUT("/v1/articles/:slug/takedown", authMiddleware, moderatorMiddleware, ctrl.TakeDownArticle)
+	s.router.DELETE("/v1/articles/:slug/takedown", authMiddleware, moderatorMiddleware, ctrl.RestoreArticle)
+	s.router.GET("/v1/moderation/reports", authMiddleware, moderatorMiddleware, ctrl.ListReports)
+	s.router.POST("/v1/moderation/reports/:id/resolve", authMiddleware, moderatorMiddleware, ctrl.ResolveReport)
+}
+
+func (s *ModerationControllerTestSuite) TestReportArticle_Success() {
+	articleID := uuid.New()
+	s.mockStore.On("CreateReport", mock.Anything, store.CreateReportParams{
+		ReporterID:  s.userID,
+		ArticleSlug: "go-generics",
+		Reason:      store.ReportReasonSpam,
+		Details:     "Links to a scam",
+	}).Return(&store.Report{
+		ID:           uuid.New(),
+		ReporterID:   s.userID,
+		ArticleID:    articleID,
+		Reason:       store.ReportReasonSpam,
+		Details:      "Links to a scam",
+		Status:       store.ReportStatusOpen,
+		ArticleSlug:  "go-generics",
+		ArticleTitle: "Go Generics",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/reports",
+		strings.NewReader(`{"reason": "spam", "details": "Links to a scam"}`)))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusCreated, w.Code)
+	s.Require().Equal("open", gjson.Get(res, "status").String())
+	s.Require().Equal(articleID.String(), gjson.Get(res, "article.id").String())
+	s.Require().Equal("go-generics", gjson.Get(res, "article.slug").String())
+	s.Require().False(gjson.Get(res, "comment").Exists())
+}
+
+func (s *ModerationControllerTestSuite) TestReportArticle_InvalidReason() {
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/go-generics/reports", strings.NewReader(`{"reason": "boring"}`)))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *ModerationControllerTestSuite) TestReportArticle_NotFound() {
+	s.mockStore.On("CreateReport", mock.Anything, mock.Anything).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/articles/deleted-article/reports", strings.NewReader(`{"reason": "other"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ModerationControllerTestSuite) TestReportComment_Success() {
+	commentID := uuid.New()
+	s.mockStore.On("CreateReport", mock.Anything, store.CreateReportParams{
+		ReporterID: s.userID,
+		CommentID:  commentID,
+		Reason:     store.ReportReasonHarassment,
+	}).Return(&store.Report{
+		ID:          uuid.New(),
+		ReporterID:  s.userID,
+		CommentID:   uuid.NullUUID{UUID: commentID, Valid: true},
+		Reason:      store.ReportReasonHarassment,
+		Status:      store.ReportStatusOpen,
+		CommentBody: sql.NullString{String: "You are wrong", Valid: true},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/comments/"+commentID.String()+"/reports", strings.NewReader(`{"reason": "harassment"}`)))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusCreated, w.Code)
+	s.Require().Equal(commentID.String(), gjson.Get(res, "comment.id").String())
+	s.Require().Equal("You are wrong", gjson.Get(res, "comment.body").String())
+}
+
+func (s *ModerationControllerTestSuite) TestListReports_Success() {
+	s.mockModerator()
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reports := []store.Report{
+		{ID: uuid.New(), Status: store.ReportStatusOpen, CreatedAt: createdAt},
+		{ID: uuid.New(), Status: store.ReportStatusOpen, CreatedAt: createdAt.Add(time.Minute)},
+	}
+	s.mockStore.On("ListReports", mock.Anything, store.ListReportsParams{
+		Status: store.ReportStatusOpen,
+		Limit:  2,
+	}).Return(reports, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/moderation/reports?pageSize=1", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().Equal(reports[0].ID.String(), gjson.Get(res, "items.0.id").String())
+	nextPageToken := gjson.Get(res, "nextPageToken").String()
+	s.Require().NotEmpty(nextPageToken)
+
+	s.mockStore.On("ListReports", mock.Anything, store.ListReportsParams{
+		Status: store.ReportStatusOpen,
+		Limit:  2,
+		After:  &store.ReportCursor{CreatedAt: createdAt, ID: reports[0].ID},
+	}).Return(reports[1:], nil)
+
+	w = httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/moderation/reports?pageSize=1&pageToken="+nextPageToken, nil))
+
+	res = w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(reports[1].ID.String(), gjson.Get(res, "items.0.id").String())
+	s.Require().False(gjson.Get(res, "nextPageToken").Exists())
+}
+
+func (s *ModerationControllerTestSuite) TestListReports_NotModerator() {
+	s.mockModeratorStore.On("GetUserByID", mock.Anything, s.userID.String()).
+		Return(&store.User{ID: s.userID, Role: store.UserRoleMember}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET", "/v1/moderation/reports", nil))
+
+	s.Require().Equal(http.StatusForbidden, w.Code)
+	s.Require().Equal(string(controller.CodeForbidden), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ModerationControllerTestSuite) TestResolveReport_Success() {
+	s.mockModerator()
+	reportID := uuid.New()
+	resolvedAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	s.mockStore.On("ResolveReport", mock.Anything, store.ResolveReportParams{
+		ID:          reportID,
+		ModeratorID: s.userID,
+		Status:      store.ReportStatusUpheld,
+		Note:        "Spam links",
+	}).Return(&store.Report{
+		ID:             reportID,
+		Status:         store.ReportStatusUpheld,
+		ResolvedBy:     uuid.NullUUID{UUID: s.userID, Valid: true},
+		ResolvedAt:     sql.NullTime{Time: resolvedAt, Valid: true},
+		ResolutionNote: "Spam links",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/moderation/reports/"+reportID.String()+"/resolve",
+		strings.NewReader(`{"resolution": "upheld", "note": "Spam links"}`)))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("upheld", gjson.Get(res, "status").String())
+	s.Require().Equal(s.userID.String(), gjson.Get(res, "resolvedBy").String())
+	s.Require().Equal(resolvedAt.Format(time.RFC3339), gjson.Get(res, "resolvedAt").String())
+}
+
+func (s *ModerationControllerTestSuite) TestResolveReport_AlreadyResolved() {
+	s.mockModerator()
+	s.mockStore.On("ResolveReport", mock.Anything, mock.Anything).Return(nil, store.ErrReportAlreadyResolved)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/moderation/reports/"+uuid.NewString()+"/resolve",
+		strings.NewReader(`{"resolution": "dismissed"}`)))
+
+	s.Require().Equal(http.StatusConflict, w.Code)
+	s.Require().Equal(string(controller.CodeReportAlreadyResolved), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ModerationControllerTestSuite) TestResolveReport_NotFound() {
+	s.mockModerator()
+	s.mockStore.On("ResolveReport", mock.Anything, mock.Anything).Return(nil, store.ErrReportNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/moderation/reports/"+uuid.NewString()+"/resolve",
+		strings.NewReader(`{"resolution": "dismissed"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeReportNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ModerationControllerTestSuite) TestTakeDownArticle_Success() {
+	s.mockModerator()
+	s.mockStore.On("TakeDownArticle", mock.Anything, store.TakeDownArticleParams{
+		Slug:        "go-generics",
+		ModeratorID: s.userID,
+		Reason:      "Plagiarism",
+	}).Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/articles/go-generics/takedown", strings.NewReader(`{"reason": "Plagiarism"}`)))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *ModerationControllerTestSuite) TestTakeDownArticle_MissingReason() {
+	s.mockModerator()
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/articles/go-generics/takedown", strings.NewReader(`{}`)))
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *ModerationControllerTestSuite) TestRestoreArticle_Success() {
+	s.mockModerator()
+	s.mockStore.On("RestoreArticle", mock.Anything, "go-generics").Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"DELETE", "/v1/articles/go-generics/takedown", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+// mockModerator makes s.userID a moderator.
+func (s *ModerationControllerTestSuite) mockModerator() {
+	s.mockModeratorStore.On("GetUserByID", mock.Anything, s.userID.String()).
+		Return(&store.User{ID: s.userID, Role: store.UserRoleModerator}, nil)
+}
@@ -0,0 +1,324 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const (
+	CodeReadingListNotFound  ErrorCode = "reading_list_not_found"
+	CodeReadingListNameTaken ErrorCode = "reading_list_name_taken"
+)
+
+// ReadingListStore defines the store methods used by the reading list controller.
+type ReadingListStore interface {
+	CreateReadingList(ctx context.Context, params store.CreateReadingListParams) (*store.ReadingList, error)
+	GetReadingList(ctx context.Context, id, viewerID uuid.UUID) (*store.ReadingList, error)
+	ListReadingLists(ctx context.Context, ownerID uuid.UUID) ([]store.ReadingList, error)
+	UpdateReadingList(ctx context.Context, params store.UpdateReadingListParams) (*store.ReadingList, error)
+	DeleteReadingList(ctx context.Context, id, ownerID uuid.UUID) error
+	AddReadingListArticle(ctx context.Context, params store.ReadingListArticleParams) error
+	RemoveReadingListArticle(ctx context.Context, params store.ReadingListArticleParams) error
+	ListReadingListArticles(ctx context.Context, readingListID, viewerID uuid.UUID) ([]store.ArticlePreview, error)
+}
+
+// ReadingListController lets users curate named collections of articles. Reading lists are private to their
+// owner unless they are made public.
+type ReadingListController struct {
+	store ReadingListStore
+}
+
+func NewReadingListController(store ReadingListStore) *ReadingListController {
+	return &ReadingListController{store: store}
+}
+
+// CreateReadingList creates a reading list for the current user.
+func (c *ReadingListController) CreateReadingList(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.CreateReadingList")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req ReadingListRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	readingList, err := c.store.CreateReadingList(ctx, store.CreateReadingListParams{
+		OwnerID:     userID,
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.Public,
+	})
+	if err != nil {
+		writeReadingListErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusCreated, newReadingList(readingList))
+}
+
+// ListOwnReadingLists lists the reading lists of the current user, sorted by name.
+func (c *ReadingListController) ListOwnReadingLists(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.ListOwnReadingLists")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	readingLists, err := c.store.ListReadingLists(ctx, userID)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := ListReadingListsResponse{
+		Items: make([]ReadingList, len(readingLists)),
+	}
+	for i := range readingLists {
+		response.Items[i] = newReadingList(&readingLists[i])
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// GetReadingList returns a reading list along with its published articles, most recently added first.
+// Private reading lists can only be viewed by their owner.
+func (c *ReadingListController) GetReadingList(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.GetReadingList")
+	defer span.End()
+
+	var uri ReadingListURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	viewerID := getOptionalContextUserUUID(ginCtx, span)
+	// The ID is validated when binding the request.
+	readingList, err := c.store.GetReadingList(ctx, uuid.MustParse(uri.ID), viewerID)
+	if err != nil {
+		writeReadingListErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	articles, err := c.store.ListReadingListArticles(ctx, readingList.ID, viewerID)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := GetReadingListResponse{
+		ReadingList: newReadingList(readingList),
+		Items:       make([]ArticlePreview, len(articles)),
+	}
+	for i, article := range articles {
+		response.Items[i] = newArticlePreview(article)
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// UpdateReadingList replaces the name, description and visibility of a reading list of the current user.
+func (c *ReadingListController) UpdateReadingList(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.UpdateReadingList")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ReadingListURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req ReadingListRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	readingList, err := c.store.UpdateReadingList(ctx, store.UpdateReadingListParams{
+		ID:          uuid.MustParse(uri.ID),
+		OwnerID:     userID,
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.Public,
+	})
+	if err != nil {
+		writeReadingListErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newReadingList(readingList))
+}
+
+// DeleteReadingList deletes a reading list of the current user.
+func (c *ReadingListController) DeleteReadingList(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.DeleteReadingList")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ReadingListURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	if err := c.store.DeleteReadingList(ctx, uuid.MustParse(uri.ID), userID); err != nil {
+		writeReadingListErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+// AddArticle adds a published article to a reading list of the current user.
+func (c *ReadingListController) AddArticle(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.AddArticle")
+	defer span.End()
+
+	c.changeArticles(ctx, ginCtx, span, c.store.AddReadingListArticle)
+}
+
+// RemoveArticle removes an article from a reading list of the current user.
+func (c *ReadingListController) RemoveArticle(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "ReadingListController.RemoveArticle")
+	defer span.End()
+
+	c.changeArticles(ctx, ginCtx, span, c.store.RemoveReadingListArticle)
+}
+
+func (c *ReadingListController) changeArticles(ctx context.Context, ginCtx *gin.Context, span trace.Span,
+	change func(ctx context.Context, params store.ReadingListArticleParams) error,
+) {
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var uri ReadingListArticleURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	err := change(ctx, store.ReadingListArticleParams{
+		ReadingListID: uuid.MustParse(uri.ID),
+		OwnerID:       userID,
+		Slug:          uri.Slug,
+	})
+	if err != nil {
+		writeReadingListErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Status(http.StatusNoContent)
+}
+
+func writeReadingListErrorResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	switch {
+	case errors.Is(err, store.ErrReadingListNotFound):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeReadingListNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+	case errors.Is(err, store.ErrArticleNotFound):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeArticleNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+	case errors.Is(err, store.ErrReadingListNameTaken):
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeReadingListNameTaken,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusConflict,
+		})
+	default:
+		writeUnknownErrorResponse(ginCtx, span, err)
+	}
+}
+
+func newReadingList(readingList *store.ReadingList) ReadingList {
+	return ReadingList{
+		ID:           readingList.ID,
+		OwnerID:      readingList.OwnerID,
+		Name:         readingList.Name,
+		Description:  readingList.Description,
+		Public:       readingList.IsPublic,
+		ArticleCount: readingList.ArticleCount,
+		CreatedAt:    readingList.CreatedAt,
+		UpdatedAt:    readingList.UpdatedAt,
+	}
+}
+
+type ReadingList struct {
+	ID          uuid.UUID `json:"id"`
+	OwnerID     uuid.UUID `json:"ownerID"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	// Public reports whether anyone can view the reading list, instead of only its owner.
+	Public bool `json:"public"`
+	// ArticleCount is the number of articles in the reading list, including unpublished ones.
+	ArticleCount int       `json:"articleCount"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+type ReadingListRequest struct {
+	Name        string `json:"name" binding:"required,max=255"`
+	Description string `json:"description" binding:"max=500"`
+	Public      bool   `json:"public"`
+}
+
+// ReadingListURI holds the path parameters of the endpoints under a reading list.
+type ReadingListURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type ReadingListArticleURI struct {
+	ID   string `uri:"id" binding:"required,uuid"`
+	Slug string `uri:"slug"`
+}
+
+type ListReadingListsResponse struct {
+	Items []ReadingList `json:"items"`
+}
+
+type GetReadingListResponse struct {
+	ReadingList
+	Items []ArticlePreview `json:"items"`
+}
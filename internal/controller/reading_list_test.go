@@ -0,0 +1,133 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestReadingListController(t *testing.T) {
+	suite.Run(t, new(ReadingListControllerTestSuite))
+}
+
+type ReadingListControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockReadingListStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *ReadingListControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *ReadingListControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockReadingListStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewReadingListController(s.mockStore)
+	authMiddleware := controller.AuthMiddleware(s.tokenIssuer)
+	s.router.POST("/v1/reading-lists", authMiddleware, ctrl.CreateReadingList)
+	s.router.GET("/v1/reading-lists/:id", controller.OptionalAuthMiddleware(s.tokenIssuer), ctrl.GetReadingList)
+	s.router.PUT("/v1/reading-lists/:id/articles/:slug", authMiddleware, ctrl.AddArticle)
+	s.router.GET("/v1/me/reading-lists", authMiddleware, ctrl.ListOwnReadingLists)
+}
+
+func (s *ReadingListControllerTestSuite) TestCreateReadingList_Success() {
+	s.mockStore.On("CreateReadingList", mock.Anything, store.CreateReadingListParams{
+		OwnerID:  s.userID,
+		Name:     "Weekend reads",
+		IsPublic: true,
+	}).Return(&store.ReadingList{ID: uuid.New(), OwnerID: s.userID, Name: "Weekend reads", IsPublic: true}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "POST", "/v1/reading-lists",
+		strings.NewReader(`{"name": "Weekend reads", "public": true}`)))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusCreated, w.Code)
+	s.Require().Equal("Weekend reads", gjson.Get(res, "name").String())
+	s.Require().True(gjson.Get(res, "public").Bool())
+}
+
+func (s *ReadingListControllerTestSuite) TestCreateReadingList_NameTaken() {
+	s.mockStore.On("CreateReadingList", mock.Anything, mock.Anything).Return(nil, store.ErrReadingListNameTaken)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/reading-lists", strings.NewReader(`{"name": "Weekend reads"}`)))
+
+	s.Require().Equal(http.StatusConflict, w.Code)
+	s.Require().Equal(string(controller.CodeReadingListNameTaken), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ReadingListControllerTestSuite) TestGetReadingList_Anonymous() {
+	readingList := &store.ReadingList{ID: uuid.New(), Name: "Weekend reads", IsPublic: true}
+	s.mockStore.On("GetReadingList", mock.Anything, readingList.ID, uuid.Nil).Return(readingList, nil)
+	s.mockStore.On("ListReadingListArticles", mock.Anything, readingList.ID, uuid.Nil).
+		Return([]store.ArticlePreview{{ID: uuid.New(), Slug: "go-generics"}}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/reading-lists/"+readingList.ID.String(), nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Weekend reads", gjson.Get(res, "name").String())
+	s.Require().Equal("go-generics", gjson.Get(res, "items.0.slug").String())
+}
+
+func (s *ReadingListControllerTestSuite) TestGetReadingList_Private() {
+	id := uuid.New()
+	s.mockStore.On("GetReadingList", mock.Anything, id, s.userID).Return(nil, store.ErrReadingListNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"GET", "/v1/reading-lists/"+id.String(), nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeReadingListNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *ReadingListControllerTestSuite) TestAddArticle_Success() {
+	id := uuid.New()
+	s.mockStore.On("AddReadingListArticle", mock.Anything, store.ReadingListArticleParams{
+		ReadingListID: id,
+		OwnerID:       s.userID,
+		Slug:          "go-generics",
+	}).Return(nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"PUT", "/v1/reading-lists/"+id.String()+"/articles/go-generics", nil))
+
+	s.Require().Equal(http.StatusNoContent, w.Code)
+}
+
+func (s *ReadingListControllerTestSuite) TestListOwnReadingLists_Success() {
+	s.mockStore.On("ListReadingLists", mock.Anything, s.userID).Return([]store.ReadingList{
+		{ID: uuid.New(), Name: "Later", ArticleCount: 3},
+		{ID: uuid.New(), Name: "Weekend reads"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET", "/v1/me/reading-lists", nil))
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 2)
+	s.Require().Equal(int64(3), gjson.Get(res, "items.0.articleCount").Int())
+}
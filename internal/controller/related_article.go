@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// defaultRelatedArticlesLimit is the number of related articles returned if the limit is not given.
+const defaultRelatedArticlesLimit = 5
+
+// RelatedArticleStore defines the store methods used by the related article controller.
+type RelatedArticleStore interface {
+	ListRelatedArticles(ctx context.Context, params store.ListRelatedArticlesParams) ([]store.ArticlePreview, error)
+}
+
+// RelatedArticleController suggests articles to read next, at the bottom of an article.
+type RelatedArticleController struct {
+	store RelatedArticleStore
+	// cacheTTL is how long the related articles of an article are reused before they are computed again.
+	cacheTTL time.Duration
+}
+
+func NewRelatedArticleController(store RelatedArticleStore, cacheTTL time.Duration) *RelatedArticleController {
+	return &RelatedArticleController{store: store, cacheTTL: cacheTTL}
+}
+
+// ListRelated returns the published articles most similar to a published article, most similar first.
+func (c *RelatedArticleController) ListRelated(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "RelatedArticleController.ListRelated")
+	defer span.End()
+
+	var uri RelatedArticlesURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	var req RelatedArticlesRequest
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	limit := defaultRelatedArticlesLimit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	articles, err := c.store.ListRelatedArticles(ctx, store.ListRelatedArticlesParams{
+		Slug:     uri.Slug,
+		ViewerID: getOptionalContextUserUUID(ginCtx, span),
+		Limit:    limit,
+		Now:      time.Now(),
+		MaxAge:   c.cacheTTL,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrArticleNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeArticleNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := RelatedArticlesResponse{Items: make([]ArticlePreview, len(articles))}
+	for i, article := range articles {
+		response.Items[i] = newArticlePreview(article)
+	}
+
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+type RelatedArticlesURI struct {
+	Slug string `uri:"slug"`
+}
+
+type RelatedArticlesRequest struct {
+	// Limit is the maximum number of related articles to return.
+	Limit *int `form:"limit" binding:"omitempty,min=1,max=20"`
+}
+
+type RelatedArticlesResponse struct {
+	Items []ArticlePreview `json:"items"`
+}
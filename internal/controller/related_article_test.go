@@ -0,0 +1,97 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestRelatedArticleController(t *testing.T) {
+	suite.Run(t, new(RelatedArticleControllerTestSuite))
+}
+
+type RelatedArticleControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockRelatedArticleStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *RelatedArticleControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *RelatedArticleControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockRelatedArticleStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewRelatedArticleController(s.mockStore, 6*time.Hour)
+	s.router.GET("/v1/articles/:slug/related", controller.OptionalAuthMiddleware(s.tokenIssuer), ctrl.ListRelated)
+}
+
+func (s *RelatedArticleControllerTestSuite) TestListRelated() {
+	var params store.ListRelatedArticlesParams
+	s.mockStore.On("ListRelatedArticles", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { params = args.Get(1).(store.ListRelatedArticlesParams) }).
+		Return([]store.ArticlePreview{{ID: uuid.New(), Slug: "channels"}, {ID: uuid.New(), Slug: "select"}}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID, "GET",
+		"/v1/articles/go-generics/related", nil))
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	res := w.Body.String()
+	s.Require().Equal("channels", gjson.Get(res, "items.0.slug").String())
+	s.Require().Equal("select", gjson.Get(res, "items.1.slug").String())
+	s.Require().Equal("go-generics", params.Slug)
+	s.Require().Equal(s.userID, params.ViewerID)
+	s.Require().Equal(5, params.Limit)
+	s.Require().Equal(6*time.Hour, params.MaxAge)
+	s.Require().WithinDuration(time.Now(), params.Now, time.Minute)
+}
+
+func (s *RelatedArticleControllerTestSuite) TestListRelated_Limit() {
+	s.mockStore.On("ListRelatedArticles", mock.Anything, mock.MatchedBy(func(params store.ListRelatedArticlesParams) bool {
+		return params.Limit == 3 && params.ViewerID == uuid.Nil
+	})).Return([]store.ArticlePreview{}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/go-generics/related?limit=3", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(`{"items":[]}`, w.Body.String())
+}
+
+func (s *RelatedArticleControllerTestSuite) TestListRelated_InvalidLimit() {
+	for _, limit := range []string{"0", "21", "many"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/v1/articles/go-generics/related?limit="+limit, nil)
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusBadRequest, w.Code, limit)
+	}
+}
+
+func (s *RelatedArticleControllerTestSuite) TestListRelated_NotFound() {
+	s.mockStore.On("ListRelatedArticles", mock.Anything, mock.Anything).Return(nil, store.ErrArticleNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/articles/missing/related", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeArticleNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
@@ -0,0 +1,246 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const (
+	CodeSeriesNotFound        ErrorCode = "series_not_found"
+	CodeDigitalAuthorNotFound ErrorCode = "digital_author_not_found"
+)
+
+// SeriesStore defines the store methods used by the series controller.
+type SeriesStore interface {
+	CreateSeries(ctx context.Context, params store.CreateSeriesParams) (*store.Series, error)
+	GetSeries(ctx context.Context, id uuid.UUID) (*store.Series, error)
+	ListSeriesArticles(ctx context.Context, seriesID uuid.UUID) ([]store.SeriesArticle, error)
+	CompleteSeries(ctx context.Context, params store.CompleteSeriesParams) (*store.Series, error)
+}
+
+type SeriesController struct {
+	store SeriesStore
+}
+
+func NewSeriesController(store SeriesStore) *SeriesController {
+	return &SeriesController{store: store}
+}
+
+// CreateSeries creates a series for a digital author of the current user. The next articles generated by
+// the digital author continue the series, until it is completed.
+func (c *SeriesController) CreateSeries(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "SeriesController.CreateSeries")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req CreateSeriesRequest
+	if err := ginCtx.ShouldBindJSON(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	series, err := c.store.CreateSeries(ctx, store.CreateSeriesParams{
+		OwnerID:     userID,
+		AuthorID:    req.AuthorID,
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrDigitalAuthorNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeDigitalAuthorNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusCreated, newSeries(series))
+}
+
+// GetSeries returns a series along with its published articles in order.
+func (c *SeriesController) GetSeries(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "SeriesController.GetSeries")
+	defer span.End()
+
+	var req GetSeriesRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The ID is validated when binding the request.
+	series, err := c.store.GetSeries(ctx, uuid.MustParse(req.ID))
+	if err != nil {
+		writeSeriesErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	articles, err := c.store.ListSeriesArticles(ctx, series.ID)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := GetSeriesResponse{
+		Series: newSeries(series),
+		Items:  make([]SeriesArticle, len(articles)),
+	}
+	for i, article := range articles {
+		response.Items[i] = SeriesArticle{
+			ArticlePreview: newArticlePreview(article.ArticlePreview),
+			Position:       article.Position,
+		}
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// CompleteSeries ends a series of a digital author of the current user. The next articles generated by the
+// digital author are standalone articles, until another series is created.
+func (c *SeriesController) CompleteSeries(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "SeriesController.CompleteSeries")
+	defer span.End()
+
+	userID, ok := getContextUserUUID(ginCtx, span)
+	if !ok {
+		return
+	}
+
+	var req GetSeriesRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// The ID is validated when binding the request.
+	series, err := c.store.CompleteSeries(ctx, store.CompleteSeriesParams{
+		ID:      uuid.MustParse(req.ID),
+		OwnerID: userID,
+	})
+	if err != nil {
+		writeSeriesErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, newSeries(series))
+}
+
+func writeSeriesErrorResponse(ginCtx *gin.Context, span trace.Span, err error) {
+	if errors.Is(err, store.ErrSeriesNotFound) {
+		writeErrorResponse(ginCtx, writeErrorResponseParams{
+			Body: ErrorResponse{
+				Code:    CodeSeriesNotFound,
+				Message: err.Error(),
+			},
+			Span:       span,
+			Err:        err,
+			StatusCode: http.StatusNotFound,
+		})
+		return
+	}
+
+	writeUnknownErrorResponse(ginCtx, span, err)
+}
+
+func newSeries(series *store.Series) Series {
+	return Series{
+		ID:                series.ID,
+		AuthorID:          series.AuthorID,
+		AuthorDisplayName: series.AuthorDisplayName.String,
+		Title:             series.Title,
+		Description:       series.Description,
+		CompletedAt:       nullTimePtr(series.CompletedAt),
+		CreatedAt:         series.CreatedAt,
+		UpdatedAt:         series.UpdatedAt,
+	}
+}
+
+// newArticleSeries describes the series of an article. It returns nil for standalone articles.
+func newArticleSeries(info store.ArticleSeriesInfo) *ArticleSeries {
+	if !info.SeriesID.Valid {
+		return nil
+	}
+
+	series := &ArticleSeries{
+		ID:       info.SeriesID.UUID,
+		Title:    info.SeriesTitle.String,
+		Position: int(info.Position.Int32),
+	}
+	if info.PreviousSlug.Valid {
+		series.Previous = &SeriesLink{Slug: info.PreviousSlug.String, Title: info.PreviousTitle.String}
+	}
+	if info.NextSlug.Valid {
+		series.Next = &SeriesLink{Slug: info.NextSlug.String, Title: info.NextTitle.String}
+	}
+	return series
+}
+
+type CreateSeriesRequest struct {
+	AuthorID    uuid.UUID `json:"authorID" binding:"required"`
+	Title       string    `json:"title" binding:"required,max=255"`
+	Description string    `json:"description" binding:"max=500"`
+}
+
+type GetSeriesRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type Series struct {
+	ID                uuid.UUID `json:"id"`
+	AuthorID          uuid.UUID `json:"authorID"`
+	AuthorDisplayName string    `json:"authorDisplayName,omitempty"`
+	Title             string    `json:"title"`
+	Description       string    `json:"description"`
+	// CompletedAt is nil while new articles of the digital author continue the series.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+type GetSeriesResponse struct {
+	Series
+	Items []SeriesArticle `json:"items"`
+}
+
+type SeriesArticle struct {
+	ArticlePreview
+	// Position is the position of the article within the series, starting at 1.
+	Position int `json:"position"`
+}
+
+// ArticleSeries describes the series an article is a part of.
+type ArticleSeries struct {
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Position int       `json:"position"`
+	// Previous is the published part right before the article. It is nil for the first published part.
+	Previous *SeriesLink `json:"previous,omitempty"`
+	// Next is the published part right after the article. It is nil for the last published part.
+	Next *SeriesLink `json:"next,omitempty"`
+}
+
+type SeriesLink struct {
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
@@ -0,0 +1,169 @@
+package controller_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/token"
+)
+
+func TestSeriesController(t *testing.T) {
+	suite.Run(t, new(SeriesControllerTestSuite))
+}
+
+type SeriesControllerTestSuite struct {
+	suite.Suite
+	mockStore   *controller.MockSeriesStore
+	tokenIssuer *token.AccessTokenIssuer
+	router      *gin.Engine
+	userID      uuid.UUID
+}
+
+func (s *SeriesControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *SeriesControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockSeriesStore(s.T())
+	s.tokenIssuer = token.NewIssuer("test-access-token-secret")
+	s.userID = uuid.New()
+	s.router = gin.Default()
+	ctrl := controller.NewSeriesController(s.mockStore)
+	s.router.POST("/v1/series", controller.AuthMiddleware(s.tokenIssuer), ctrl.CreateSeries)
+	s.router.GET("/v1/series/:id", ctrl.GetSeries)
+	s.router.POST("/v1/series/:id/complete", controller.AuthMiddleware(s.tokenIssuer), ctrl.CompleteSeries)
+}
+
+func (s *SeriesControllerTestSuite) TestCreateSeries_Success() {
+	authorID := uuid.New()
+	s.mockStore.On("CreateSeries", mock.Anything, store.CreateSeriesParams{
+		OwnerID:     s.userID,
+		AuthorID:    authorID,
+		Title:       "Learn Go Concurrency",
+		Description: "From goroutines to pipelines",
+	}).Return(&store.Series{
+		ID:          uuid.New(),
+		AuthorID:    authorID,
+		Title:       "Learn Go Concurrency",
+		Description: "From goroutines to pipelines",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/series", strings.NewReader(`{
+		"authorID": "`+authorID.String()+`",
+		"title": "Learn Go Concurrency",
+		"description": "From goroutines to pipelines"
+	}`)))
+
+	s.Require().Equal(http.StatusCreated, w.Code)
+	s.Require().Equal("Learn Go Concurrency", gjson.Get(w.Body.String(), "title").String())
+}
+
+func (s *SeriesControllerTestSuite) TestCreateSeries_DigitalAuthorNotOwned() {
+	s.mockStore.On("CreateSeries", mock.Anything, mock.Anything).Return(nil, store.ErrDigitalAuthorNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/series",
+		strings.NewReader(`{"authorID": "`+uuid.NewString()+`", "title": "Someone Else's Series"}`)))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeDigitalAuthorNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *SeriesControllerTestSuite) TestGetSeries_Success() {
+	series := &store.Series{
+		ID:                uuid.New(),
+		AuthorID:          uuid.New(),
+		AuthorDisplayName: sql.NullString{String: "Gopher Bot", Valid: true},
+		Title:             "Learn Go Concurrency",
+	}
+	s.mockStore.On("GetSeries", mock.Anything, series.ID).Return(series, nil)
+	s.mockStore.On("ListSeriesArticles", mock.Anything, series.ID).Return([]store.SeriesArticle{
+		{ArticlePreview: store.ArticlePreview{ID: uuid.New(), Slug: "goroutines"}, Position: 1},
+		{ArticlePreview: store.ArticlePreview{ID: uuid.New(), Slug: "channels"}, Position: 3},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/series/"+series.ID.String(), nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Learn Go Concurrency", gjson.Get(res, "title").String())
+	s.Require().Equal("Gopher Bot", gjson.Get(res, "authorDisplayName").String())
+	s.Require().Equal("goroutines", gjson.Get(res, "items.0.slug").String())
+	s.Require().Equal(int64(3), gjson.Get(res, "items.1.position").Int())
+}
+
+func (s *SeriesControllerTestSuite) TestGetSeries_NotFound() {
+	s.mockStore.On("GetSeries", mock.Anything, mock.Anything).Return(nil, store.ErrSeriesNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/series/"+uuid.NewString(), nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeSeriesNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *SeriesControllerTestSuite) TestGetSeries_InvalidID() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/series/not-a-uuid", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *SeriesControllerTestSuite) TestCompleteSeries_Success() {
+	seriesID := uuid.New()
+	completedAt := time.Date(2026, 10, 27, 14, 0, 0, 0, time.UTC)
+	s.mockStore.On("CompleteSeries", mock.Anything, store.CompleteSeriesParams{
+		ID:      seriesID,
+		OwnerID: s.userID,
+	}).Return(&store.Series{
+		ID:          seriesID,
+		AuthorID:    uuid.New(),
+		Title:       "Learn Go Concurrency",
+		CompletedAt: sql.NullTime{Time: completedAt, Valid: true},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/series/"+seriesID.String()+"/complete", nil))
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal(completedAt, gjson.Get(w.Body.String(), "completedAt").Time())
+}
+
+func (s *SeriesControllerTestSuite) TestCompleteSeries_NotOwned() {
+	s.mockStore.On("CompleteSeries", mock.Anything, mock.Anything).Return(nil, store.ErrSeriesNotFound)
+
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, newAuthenticatedRequest(s.T(), s.tokenIssuer, s.userID,
+		"POST", "/v1/series/"+uuid.NewString()+"/complete", nil))
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeSeriesNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
+
+func (s *SeriesControllerTestSuite) TestCompleteSeries_Unauthenticated() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/v1/series/"+uuid.NewString()+"/complete", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusUnauthorized, w.Code)
+}
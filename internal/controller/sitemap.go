@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tuananhlai/brevity-go/internal/sitemap"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const (
+	CodeSitemapNotFound ErrorCode = "sitemap_not_found"
+)
+
+const (
+	sitemapContentType = "application/xml; charset=utf-8"
+	robotsContentType  = "text/plain; charset=utf-8"
+	// sitemapExtension is the extension of the file name in the URL of a sitemap listed in the sitemap index.
+	sitemapExtension = ".xml"
+)
+
+// SitemapStore defines the store methods used by the sitemap controller.
+type SitemapStore interface {
+	CountSitemapEntries(ctx context.Context) (int, error)
+	ListSitemapEntries(ctx context.Context, params store.ListSitemapEntriesParams) ([]store.SitemapEntry, error)
+}
+
+// SitemapController serves the sitemaps and the robots.txt file of the website, for search engines.
+type SitemapController struct {
+	store SitemapStore
+	// baseURL is the URL of the website, which the URLs in the sitemaps point to.
+	baseURL string
+	// pageSize is the maximum number of URLs in a sitemap. It is only changed in tests.
+	pageSize int
+}
+
+func NewSitemapController(store SitemapStore, baseURL string) *SitemapController {
+	return &SitemapController{store: store, baseURL: strings.TrimSuffix(baseURL, "/"), pageSize: sitemap.MaxURLs}
+}
+
+// Sitemap returns the sitemap of the website. If there are more pages than a sitemap can hold, a sitemap
+// index of the sitemaps served by SitemapPage is returned instead.
+func (c *SitemapController) Sitemap(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "SitemapController.Sitemap")
+	defer span.End()
+
+	count, err := c.store.CountSitemapEntries(ctx)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	var body []byte
+	if count <= c.pageSize {
+		body, err = c.renderPage(ctx, 0)
+	} else {
+		sitemaps := make([]sitemap.Sitemap, (count+c.pageSize-1)/c.pageSize)
+		for i := range sitemaps {
+			sitemaps[i] = sitemap.Sitemap{Loc: fmt.Sprintf("%s/sitemaps/%d%s", c.baseURL, i+1, sitemapExtension)}
+		}
+		body, err = sitemap.Index(sitemaps)
+	}
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Data(http.StatusOK, sitemapContentType, body)
+}
+
+// SitemapPage returns one of the sitemaps listed in the sitemap index, which are numbered from 1.
+func (c *SitemapController) SitemapPage(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "SitemapController.SitemapPage")
+	defer span.End()
+
+	var uri SitemapPageURI
+	if err := ginCtx.ShouldBindUri(&uri); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	// The route matches the whole file name, as gin does not support a parameter followed by a suffix.
+	page, err := strconv.Atoi(strings.TrimSuffix(uri.File, sitemapExtension))
+	if err != nil || page < 1 || !strings.HasSuffix(uri.File, sitemapExtension) {
+		writeSitemapNotFoundResponse(ginCtx, span, uri.File)
+		return
+	}
+
+	count, err := c.store.CountSitemapEntries(ctx)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+	if (page-1)*c.pageSize >= count && page > 1 {
+		writeSitemapNotFoundResponse(ginCtx, span, uri.File)
+		return
+	}
+
+	body, err := c.renderPage(ctx, page-1)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.Data(http.StatusOK, sitemapContentType, body)
+}
+
+// Robots returns the robots.txt file of the website, which points crawlers to the sitemap.
+func (c *SitemapController) Robots(ginCtx *gin.Context) {
+	_, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "SitemapController.Robots")
+	defer span.End()
+
+	ginCtx.Data(http.StatusOK, robotsContentType, sitemap.Robots(c.baseURL+"/sitemap.xml"))
+}
+
+// renderPage renders the sitemap of the entries in the page with the given zero-based index.
+func (c *SitemapController) renderPage(ctx context.Context, index int) ([]byte, error) {
+	entries, err := c.store.ListSitemapEntries(ctx, store.ListSitemapEntriesParams{
+		Offset: index * c.pageSize,
+		Limit:  c.pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]sitemap.URL, 0, len(entries))
+	for _, entry := range entries {
+		var loc string
+		switch entry.Kind {
+		case store.SitemapEntryArticle:
+			loc = articlePageURL(c.baseURL, entry.Key)
+		case store.SitemapEntryDigitalAuthor:
+			loc = digitalAuthorPageURL(c.baseURL, entry.Key)
+		default:
+			continue
+		}
+		urls = append(urls, sitemap.URL{Loc: loc, LastMod: entry.UpdatedAt})
+	}
+
+	return sitemap.URLSet(urls)
+}
+
+// articlePageURL returns the URL of the page of the article with the given slug on the website.
+func articlePageURL(baseURL, slug string) string {
+	return baseURL + "/articles/" + slug
+}
+
+// digitalAuthorPageURL returns the URL of the page of the digital author with the given ID on the website.
+func digitalAuthorPageURL(baseURL, id string) string {
+	return baseURL + "/digital-authors/" + id
+}
+
+func writeSitemapNotFoundResponse(ginCtx *gin.Context, span trace.Span, file string) {
+	writeErrorResponse(ginCtx, writeErrorResponseParams{
+		Body: ErrorResponse{
+			Code:    CodeSitemapNotFound,
+			Message: "sitemap " + file + " not found",
+		},
+		Span:       span,
+		StatusCode: http.StatusNotFound,
+	})
+}
+
+type SitemapPageURI struct {
+	// File is the file name of the sitemap, which is its number followed by ".xml".
+	File string `uri:"file" binding:"required"`
+}
@@ -0,0 +1,134 @@
+package controller_test
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestSitemapController(t *testing.T) {
+	suite.Run(t, new(SitemapControllerTestSuite))
+}
+
+type SitemapControllerTestSuite struct {
+	suite.Suite
+	mockStore *controller.MockSitemapStore
+	router    *gin.Engine
+}
+
+func (s *SitemapControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *SitemapControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockSitemapStore(s.T())
+	s.router = gin.Default()
+	ctrl := controller.NewSitemapController(s.mockStore, "https://brevity.example.com/")
+	s.router.GET("/sitemap.xml", ctrl.Sitemap)
+	s.router.GET("/sitemaps/:file", ctrl.SitemapPage)
+	s.router.GET("/robots.txt", ctrl.Robots)
+}
+
+type urlSetDocument struct {
+	XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+type sitemapIndexDocument struct {
+	XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+	Sitemaps []struct {
+		Loc string `xml:"loc"`
+	} `xml:"sitemap"`
+}
+
+var sitemapEntries = []store.SitemapEntry{
+	{Kind: store.SitemapEntryArticle, Key: "go-generics", UpdatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+	{Kind: store.SitemapEntryDigitalAuthor, Key: "0b6c1a52-8a8e-4b8a-a1f1-5d0f4f6f8f10",
+		UpdatedAt: time.Date(2026, 10, 2, 9, 30, 0, 0, time.FixedZone("ICT", 7*60*60))},
+}
+
+func (s *SitemapControllerTestSuite) TestSitemap() {
+	s.mockStore.On("CountSitemapEntries", mock.Anything).Return(2, nil)
+	s.mockStore.On("ListSitemapEntries", mock.Anything, store.ListSitemapEntriesParams{Offset: 0, Limit: 50000}).
+		Return(sitemapEntries, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sitemap.xml", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+	var doc urlSetDocument
+	s.Require().NoError(xml.Unmarshal(w.Body.Bytes(), &doc))
+	s.Require().Len(doc.URLs, 2)
+	s.Require().Equal("https://brevity.example.com/articles/go-generics", doc.URLs[0].Loc)
+	s.Require().Equal("2026-10-01T09:00:00Z", doc.URLs[0].LastMod)
+	s.Require().Equal("https://brevity.example.com/digital-authors/0b6c1a52-8a8e-4b8a-a1f1-5d0f4f6f8f10", doc.URLs[1].Loc)
+	s.Require().Equal("2026-10-02T02:30:00Z", doc.URLs[1].LastMod)
+}
+
+func (s *SitemapControllerTestSuite) TestSitemap_Index() {
+	s.mockStore.On("CountSitemapEntries", mock.Anything).Return(100001, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sitemap.xml", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	var doc sitemapIndexDocument
+	s.Require().NoError(xml.Unmarshal(w.Body.Bytes(), &doc))
+	s.Require().Len(doc.Sitemaps, 3)
+	s.Require().Equal("https://brevity.example.com/sitemaps/1.xml", doc.Sitemaps[0].Loc)
+	s.Require().Equal("https://brevity.example.com/sitemaps/3.xml", doc.Sitemaps[2].Loc)
+}
+
+func (s *SitemapControllerTestSuite) TestSitemapPage() {
+	s.mockStore.On("CountSitemapEntries", mock.Anything).Return(100001, nil)
+	s.mockStore.On("ListSitemapEntries", mock.Anything, store.ListSitemapEntriesParams{Offset: 100000, Limit: 50000}).
+		Return(sitemapEntries[:1], nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/sitemaps/3.xml", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	var doc urlSetDocument
+	s.Require().NoError(xml.Unmarshal(w.Body.Bytes(), &doc))
+	s.Require().Len(doc.URLs, 1)
+}
+
+func (s *SitemapControllerTestSuite) TestSitemapPage_NotFound() {
+	s.mockStore.On("CountSitemapEntries", mock.Anything).Return(100001, nil).Maybe()
+
+	for _, file := range []string{"4.xml", "0.xml", "1.txt", "first.xml"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/sitemaps/"+file, nil)
+		s.router.ServeHTTP(w, req)
+		s.Require().Equal(http.StatusNotFound, w.Code, file)
+		s.Require().Equal(string(controller.CodeSitemapNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+	}
+}
+
+func (s *SitemapControllerTestSuite) TestRobots() {
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/robots.txt", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	s.Require().Contains(w.Body.String(), "User-agent: *\n")
+	s.Require().Contains(w.Body.String(), "Sitemap: https://brevity.example.com/sitemap.xml\n")
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+const (
+	CodeTagNotFound ErrorCode = "tag_not_found"
+)
+
+// TagStore defines the store methods used by the tag controller.
+type TagStore interface {
+	ListTags(ctx context.Context) ([]store.TagSummary, error)
+	GetTagBySlug(ctx context.Context, slug string) (*store.Tag, error)
+	ListArticlesPreviews(ctx context.Context, params store.ListArticlesPreviewsParams) ([]store.ArticlePreview, error)
+}
+
+type TagController struct {
+	store TagStore
+	// pageTokenSecret is used to sign and verify page tokens.
+	pageTokenSecret []byte
+}
+
+func NewTagController(store TagStore, pageTokenSecret []byte) *TagController {
+	return &TagController{store: store, pageTokenSecret: pageTokenSecret}
+}
+
+// ListTags returns all tags in use, the most used tags first.
+func (c *TagController) ListTags(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "TagController.ListTags")
+	defer span.End()
+
+	tags, err := c.store.ListTags(ctx)
+	if err != nil {
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	response := ListTagsResponse{
+		Items: make([]TagSummary, len(tags)),
+	}
+	for i, tag := range tags {
+		response.Items[i] = TagSummary{
+			Tag:          newTag(tag.Tag),
+			ArticleCount: tag.ArticleCount,
+		}
+	}
+	ginCtx.JSON(http.StatusOK, response)
+}
+
+// ListArticles returns the previews of the articles with the given tag, most recently published first.
+func (c *TagController) ListArticles(ginCtx *gin.Context) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ginCtx.Request.Context(), "TagController.ListArticles")
+	defer span.End()
+
+	var req ListTagArticlesRequest
+	if err := ginCtx.ShouldBindUri(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+	if err := ginCtx.ShouldBindQuery(&req); err != nil {
+		writeBindingErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	// Look up the tag first so that an unknown tag is reported instead of returning an empty list.
+	tag, err := c.store.GetTagBySlug(ctx, req.Slug)
+	if err != nil {
+		if errors.Is(err, store.ErrTagNotFound) {
+			writeErrorResponse(ginCtx, writeErrorResponseParams{
+				Body: ErrorResponse{
+					Code:    CodeTagNotFound,
+					Message: err.Error(),
+				},
+				Span:       span,
+				Err:        err,
+				StatusCode: http.StatusNotFound,
+			})
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	page, err := listPreviewsPage(ctx, c.store.ListArticlesPreviews, c.pageTokenSecret, req.PreviewsPageRequest,
+		store.ListArticlesPreviewsParams{
+			TagSlug:  tag.Slug,
+			ViewerID: getOptionalContextUserUUID(ginCtx, span),
+		})
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidPageToken) {
+			writeInvalidPageTokenResponse(ginCtx, span, err)
+			return
+		}
+
+		writeUnknownErrorResponse(ginCtx, span, err)
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, ListTagArticlesResponse{
+		Tag:                  newTag(*tag),
+		ListPreviewsResponse: *page,
+	})
+}
+
+func newTag(tag store.Tag) Tag {
+	return Tag{
+		ID:   tag.ID,
+		Slug: tag.Slug,
+		Name: tag.Name,
+	}
+}
+
+func newTags(tags store.TagList) []Tag {
+	result := make([]Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = newTag(tag)
+	}
+	return result
+}
+
+type Tag struct {
+	ID   uuid.UUID `json:"id"`
+	Slug string    `json:"slug"`
+	Name string    `json:"name"`
+}
+
+type TagSummary struct {
+	Tag
+	// ArticleCount is the number of articles with the tag.
+	ArticleCount int `json:"articleCount"`
+}
+
+type ListTagsResponse struct {
+	Items []TagSummary `json:"items"`
+}
+
+type ListTagArticlesRequest struct {
+	Slug string `uri:"slug" form:"-"`
+	PreviewsPageRequest
+}
+
+type ListTagArticlesResponse struct {
+	Tag Tag `json:"tag"`
+	ListPreviewsResponse
+}
@@ -0,0 +1,98 @@
+package controller_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/tidwall/gjson"
+	"github.com/tuananhlai/brevity-go/internal/controller"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestTagController(t *testing.T) {
+	suite.Run(t, new(TagControllerTestSuite))
+}
+
+type TagControllerTestSuite struct {
+	suite.Suite
+	mockStore *controller.MockTagStore
+	router    *gin.Engine
+}
+
+func (s *TagControllerTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+}
+
+func (s *TagControllerTestSuite) BeforeTest(suiteName, testName string) {
+	s.mockStore = controller.NewMockTagStore(s.T())
+	s.router = gin.Default()
+	ctrl := controller.NewTagController(s.mockStore, testPageTokenSecret)
+	s.router.GET("/v1/tags", ctrl.ListTags)
+	s.router.GET("/v1/tags/:slug/articles", ctrl.ListArticles)
+}
+
+func (s *TagControllerTestSuite) TestListTags_Success() {
+	tags := []store.TagSummary{
+		{Tag: store.Tag{ID: uuid.New(), Slug: "go", Name: "Go"}, ArticleCount: 3},
+		{Tag: store.Tag{ID: uuid.New(), Slug: "rust", Name: "Rust"}, ArticleCount: 1},
+	}
+	s.mockStore.On("ListTags", mock.Anything).Return(tags, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/tags", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Len(gjson.Get(res, "items").Array(), 2)
+	s.Require().Equal(tags[0].ID.String(), gjson.Get(res, "items.0.id").String())
+	s.Require().Equal("go", gjson.Get(res, "items.0.slug").String())
+	s.Require().Equal("Go", gjson.Get(res, "items.0.name").String())
+	s.Require().Equal(int64(3), gjson.Get(res, "items.0.articleCount").Int())
+}
+
+func (s *TagControllerTestSuite) TestListArticles_Success() {
+	tag := &store.Tag{ID: uuid.New(), Slug: "go", Name: "Go"}
+	previews := []store.ArticlePreview{
+		{
+			ID:        uuid.New(),
+			Slug:      "test-article",
+			Title:     "Test Article",
+			Tags:      store.TagList{*tag},
+			CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	s.mockStore.On("GetTagBySlug", mock.Anything, "go").Return(tag, nil)
+	s.mockStore.On("ListArticlesPreviews", mock.Anything, store.ListArticlesPreviewsParams{
+		TagSlug: "go",
+		Limit:   51,
+	}).Return(previews, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/tags/go/articles", nil)
+	s.router.ServeHTTP(w, req)
+
+	res := w.Body.String()
+	s.Require().Equal(http.StatusOK, w.Code)
+	s.Require().Equal("Go", gjson.Get(res, "tag.name").String())
+	s.Require().Len(gjson.Get(res, "items").Array(), 1)
+	s.Require().Equal(previews[0].ID.String(), gjson.Get(res, "items.0.id").String())
+	s.Require().False(gjson.Get(res, "nextPageToken").Exists())
+}
+
+func (s *TagControllerTestSuite) TestListArticles_TagNotFound() {
+	s.mockStore.On("GetTagBySlug", mock.Anything, "unknown").Return(nil, store.ErrTagNotFound)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v1/tags/unknown/articles", nil)
+	s.router.ServeHTTP(w, req)
+
+	s.Require().Equal(http.StatusNotFound, w.Code)
+	s.Require().Equal(string(controller.CodeTagNotFound), gjson.Get(w.Body.String(), "errorCode").String())
+}
@@ -0,0 +1,366 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	epubMediaType = "application/epub+zip"
+	// epubContentDir is the directory of the package document and the content documents in the archive.
+	epubContentDir  = "OEBPS"
+	epubPackagePath = epubContentDir + "/content.opf"
+)
+
+// ErrEmptyBook is returned when exporting a book without articles, since an EPUB publication cannot be empty.
+var ErrEmptyBook = errors.New("book has no articles")
+
+// Book is a collection of articles to export as an EPUB book.
+type Book struct {
+	// ID is a permanent and unique IRI identifying the book, e.g. "urn:uuid:...".
+	ID          string
+	Title       string
+	Author      string
+	Description string
+	// Language is the BCP 47 language tag of the articles.
+	Language string
+	// Articles are the chapters of the book, in reading order.
+	Articles []Article
+}
+
+// Modified returns the last time an article of the book was updated, or the Unix epoch if the book is empty.
+func (b *Book) Modified() time.Time {
+	modified := time.Unix(0, 0).UTC()
+	for _, article := range b.Articles {
+		if article.Updated.After(modified) {
+			modified = article.Updated
+		}
+	}
+	return modified
+}
+
+// EPUB writes the book as an EPUB 3 publication, with one chapter per article and a table of contents.
+// ErrEmptyBook is returned if the book has no articles.
+func EPUB(w io.Writer, book Book) error {
+	if len(book.Articles) == 0 {
+		return ErrEmptyBook
+	}
+
+	zw := zip.NewWriter(w)
+
+	// The mimetype file must come first and be stored uncompressed without an extra field, so that the format
+	// can be recognized by its first bytes. CreateRaw does not add a data descriptor, unlike Create.
+	mimetype := []byte(epubMediaType)
+	fw, err := zw.CreateRaw(&zip.FileHeader{
+		Name:               "mimetype",
+		Method:             zip.Store,
+		CRC32:              crc32.ChecksumIEEE(mimetype),
+		CompressedSize64:   uint64(len(mimetype)),
+		UncompressedSize64: uint64(len(mimetype)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create mimetype: %w", err)
+	}
+	if _, err := fw.Write(mimetype); err != nil {
+		return fmt.Errorf("failed to write mimetype: %w", err)
+	}
+
+	files, err := epubFiles(book)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: book.Modified(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", file.name, err)
+		}
+		if _, err := fw.Write(file.body); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to close zip archive: %w", err)
+	}
+
+	return nil
+}
+
+type epubFile struct {
+	name string
+	body []byte
+}
+
+// epubFiles returns the files of the book other than the mimetype file.
+func epubFiles(book Book) ([]epubFile, error) {
+	language := book.Language
+	if language == "" {
+		language = "en"
+	}
+
+	container, err := marshalXML(epubContainer{
+		Version: "1.0",
+		NS:      "urn:oasis:names:tc:opendocument:xmlns:container",
+		Rootfiles: []epubRootfile{
+			{FullPath: epubPackagePath, MediaType: "application/oebps-package+xml"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := epubPackage{
+		NS:               "http://www.idpf.org/2007/opf",
+		Version:          "3.0",
+		UniqueIdentifier: "book-id",
+		Lang:             language,
+		Metadata: epubMetadata{
+			DCNS:        "http://purl.org/dc/elements/1.1/",
+			Identifier:  epubIdentifier{ID: "book-id", Value: book.ID},
+			Title:       book.Title,
+			Language:    language,
+			Creator:     book.Author,
+			Description: book.Description,
+			Publisher:   "Brevity",
+			Meta: []epubMeta{
+				{Property: "dcterms:modified", Value: book.Modified().UTC().Format("2006-01-02T15:04:05Z")},
+			},
+		},
+		Manifest: []epubItem{
+			{ID: "nav", Href: "nav.xhtml", MediaType: "application/xhtml+xml", Properties: "nav"},
+			{ID: "style", Href: "style.css", MediaType: "text/css"},
+		},
+		Spine: []epubItemref{{IDRef: "nav"}},
+	}
+
+	chapters := make([]epubChapter, len(book.Articles))
+	files := []epubFile{
+		{name: "META-INF/container.xml", body: container},
+		{name: epubContentDir + "/style.css", body: []byte(stylesheet)},
+	}
+	for i, article := range book.Articles {
+		chapter := epubChapter{
+			Article:  article,
+			ID:       fmt.Sprintf("chapter-%03d", i+1),
+			Language: language,
+		}
+		chapter.Href = chapter.ID + ".xhtml"
+		chapter.Content, err = toXHTML(article.HTML)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert article %s to XHTML: %w", article.Slug, err)
+		}
+		chapters[i] = chapter
+
+		var body bytes.Buffer
+		if err := chapterTemplate.Execute(&body, chapter); err != nil {
+			return nil, fmt.Errorf("failed to render chapter %s: %w", chapter.ID, err)
+		}
+		files = append(files, epubFile{name: epubContentDir + "/" + chapter.Href, body: body.Bytes()})
+		pkg.Manifest = append(pkg.Manifest, epubItem{
+			ID:        chapter.ID,
+			Href:      chapter.Href,
+			MediaType: "application/xhtml+xml",
+		})
+		pkg.Spine = append(pkg.Spine, epubItemref{IDRef: chapter.ID})
+	}
+
+	var nav bytes.Buffer
+	err = navTemplate.Execute(&nav, struct {
+		Title    string
+		Language string
+		Chapters []epubChapter
+	}{book.Title, language, chapters})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render table of contents: %w", err)
+	}
+
+	opf, err := marshalXML(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(files,
+		epubFile{name: epubContentDir + "/nav.xhtml", body: nav.Bytes()},
+		epubFile{name: epubPackagePath, body: opf},
+	), nil
+}
+
+type epubChapter struct {
+	Article
+	ID       string
+	Href     string
+	Language string
+	// Content is the content of the article as XHTML.
+	Content string
+}
+
+var xhtmlFuncs = template.FuncMap{
+	"xml": func(s string) (string, error) {
+		var buf strings.Builder
+		if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	},
+}
+
+var chapterTemplate = template.Must(template.New("chapter").Funcs(xhtmlFuncs).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" lang="{{xml .Language}}" xml:lang="{{xml .Language}}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{xml .Title}}</title>
+<link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+<section epub:type="chapter" id="{{.ID}}">
+<h1>{{xml .Title}}</h1>
+<p class="byline">{{if .AuthorName}}{{xml .AuthorName}} · {{end}}{{.Published.Format "January 2, 2006"}}</p>
+{{.Content}}
+</section>
+</body>
+</html>
+`))
+
+var navTemplate = template.Must(template.New("nav").Funcs(xhtmlFuncs).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" lang="{{xml .Language}}" xml:lang="{{xml .Language}}">
+<head>
+<meta charset="UTF-8"/>
+<title>{{xml .Title}}</title>
+<link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+<nav epub:type="toc" id="toc">
+<h1>Contents</h1>
+<ol>
+{{- range .Chapters}}
+<li><a href="{{.Href}}">{{xml .Title}}</a></li>
+{{- end}}
+</ol>
+</nav>
+</body>
+</html>
+`))
+
+// toXHTML converts an HTML fragment to XHTML, which EPUB content documents require. Images are replaced by their
+// alternative text, since EPUB readers may not load remote resources.
+func toXHTML(fragment string) (string, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "div",
+		DataAtom: atom.Div,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	for _, node := range nodes {
+		replaceImages(node)
+		// Render writes void elements as self-closing tags, and escapes text and attributes, as XML requires.
+		if err := html.Render(&buf, node); err != nil {
+			return "", err
+		}
+	}
+
+	return buf.String(), nil
+}
+
+func replaceImages(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if child.Type == html.ElementNode && child.DataAtom == atom.Img {
+			alt := ""
+			for _, attr := range child.Attr {
+				if attr.Key == "alt" {
+					alt = attr.Val
+				}
+			}
+			node.InsertBefore(&html.Node{Type: html.TextNode, Data: alt}, child)
+			node.RemoveChild(child)
+		} else {
+			replaceImages(child)
+		}
+		child = next
+	}
+}
+
+func marshalXML(doc any) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal XML document: %w", err)
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+type epubContainer struct {
+	XMLName   xml.Name       `xml:"container"`
+	Version   string         `xml:"version,attr"`
+	NS        string         `xml:"xmlns,attr"`
+	Rootfiles []epubRootfile `xml:"rootfiles>rootfile"`
+}
+
+type epubRootfile struct {
+	FullPath  string `xml:"full-path,attr"`
+	MediaType string `xml:"media-type,attr"`
+}
+
+type epubPackage struct {
+	XMLName          xml.Name      `xml:"package"`
+	NS               string        `xml:"xmlns,attr"`
+	Version          string        `xml:"version,attr"`
+	UniqueIdentifier string        `xml:"unique-identifier,attr"`
+	Lang             string        `xml:"xml:lang,attr"`
+	Metadata         epubMetadata  `xml:"metadata"`
+	Manifest         []epubItem    `xml:"manifest>item"`
+	Spine            []epubItemref `xml:"spine>itemref"`
+}
+
+type epubMetadata struct {
+	DCNS        string         `xml:"xmlns:dc,attr"`
+	Identifier  epubIdentifier `xml:"dc:identifier"`
+	Title       string         `xml:"dc:title"`
+	Language    string         `xml:"dc:language"`
+	Creator     string         `xml:"dc:creator,omitempty"`
+	Description string         `xml:"dc:description,omitempty"`
+	Publisher   string         `xml:"dc:publisher"`
+	Meta        []epubMeta     `xml:"meta"`
+}
+
+type epubIdentifier struct {
+	ID    string `xml:"id,attr"`
+	Value string `xml:",chardata"`
+}
+
+type epubMeta struct {
+	Property string `xml:"property,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type epubItem struct {
+	ID         string `xml:"id,attr"`
+	Href       string `xml:"href,attr"`
+	MediaType  string `xml:"media-type,attr"`
+	Properties string `xml:"properties,attr,omitempty"`
+}
+
+type epubItemref struct {
+	IDRef string `xml:"idref,attr"`
+}
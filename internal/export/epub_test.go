@@ -0,0 +1,215 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/export"
+)
+
+var testBook = export.Book{
+	ID:          "urn:uuid:5f0c8a4e-7d5c-4f38-9a3c-3f1b5b1c2d3e",
+	Title:       "Gopher Bot & friends",
+	Author:      "Gopher Bot",
+	Description: "The articles of Gopher Bot on Brevity",
+	Articles:    testArticles,
+}
+
+func TestEPUB(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, export.EPUB(&buf, testBook))
+
+	pkg, files := validateEPUB(t, buf.Bytes())
+	require.Equal(t, testBook.ID, pkg.Metadata.Identifiers[0].Value)
+	require.Equal(t, testBook.Title, pkg.Metadata.Title)
+	require.Equal(t, "en", pkg.Metadata.Language)
+	require.Equal(t, "Gopher Bot", pkg.Metadata.Creator)
+	require.Equal(t, "2026-10-03T09:00:00Z", pkg.modified())
+
+	// The table of contents comes first, then one chapter per article in order.
+	require.Len(t, pkg.Spine, len(testArticles)+1)
+	nav := string(files[pkg.href(pkg.Spine[0].IDRef)])
+	for i, itemref := range pkg.Spine[1:] {
+		chapter := string(files[pkg.href(itemref.IDRef)])
+		require.Contains(t, chapter, "<h1>"+xmlEscape(testArticles[i].Title)+"</h1>")
+		require.Contains(t, nav, `href="`+path.Base(pkg.href(itemref.IDRef))+`">`+xmlEscape(testArticles[i].Title)+"</a>")
+	}
+
+	// Remote images are replaced by their alternative text, and void elements are closed.
+	chapter := string(files[pkg.href(pkg.Spine[1].IDRef)])
+	require.NotContains(t, chapter, "<img")
+	require.Contains(t, chapter, "constraints.<br/>Gopher</p>")
+}
+
+func TestEPUB_Empty(t *testing.T) {
+	err := export.EPUB(io.Discard, export.Book{ID: testBook.ID, Title: testBook.Title})
+	require.ErrorIs(t, err, export.ErrEmptyBook)
+}
+
+// opfPackage holds the elements of an EPUB 3 package document which are checked by validateEPUB.
+type opfPackage struct {
+	XMLName          xml.Name `xml:"http://www.idpf.org/2007/opf package"`
+	Version          string   `xml:"version,attr"`
+	UniqueIdentifier string   `xml:"unique-identifier,attr"`
+	Metadata         struct {
+		Identifiers []struct {
+			ID    string `xml:"id,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"http://purl.org/dc/elements/1.1/ identifier"`
+		Title    string `xml:"http://purl.org/dc/elements/1.1/ title"`
+		Language string `xml:"http://purl.org/dc/elements/1.1/ language"`
+		Creator  string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+		Meta     []struct {
+			Property string `xml:"property,attr"`
+			Value    string `xml:",chardata"`
+		} `xml:"meta"`
+	} `xml:"metadata"`
+	Manifest []struct {
+		ID         string `xml:"id,attr"`
+		Href       string `xml:"href,attr"`
+		MediaType  string `xml:"media-type,attr"`
+		Properties string `xml:"properties,attr"`
+	} `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+	// dir is the directory of the package document in the container.
+	dir string
+}
+
+// href returns the path in the container of the manifest item with the given ID, or "" if there is none.
+func (p *opfPackage) href(id string) string {
+	for _, item := range p.Manifest {
+		if item.ID == id {
+			return path.Join(p.dir, item.Href)
+		}
+	}
+	return ""
+}
+
+func (p *opfPackage) modified() string {
+	for _, meta := range p.Metadata.Meta {
+		if meta.Property == "dcterms:modified" {
+			return meta.Value
+		}
+	}
+	return ""
+}
+
+// validateEPUB checks that the EPUB is structurally valid according to the EPUB 3 specification, and returns
+// its package document and files.
+func validateEPUB(t *testing.T, epub []byte) (*opfPackage, map[string][]byte) {
+	t.Helper()
+
+	// The mimetype file comes first, uncompressed and without an extra field, so that the media type is found
+	// at a fixed offset of the container.
+	require.Equal(t, "PK\x03\x04", string(epub[:4]))
+	require.Equal(t, "mimetypeapplication/epub+zip", string(epub[30:58]), "mimetype must be the first file")
+
+	zr, err := zip.NewReader(bytes.NewReader(epub), int64(len(epub)))
+	require.NoError(t, err)
+	require.Equal(t, "mimetype", zr.File[0].Name)
+	require.Equal(t, zip.Store, zr.File[0].Method)
+	require.Empty(t, zr.File[0].Extra)
+
+	files := make(map[string][]byte)
+	for _, file := range zr.File {
+		require.NotContains(t, files, file.Name, "duplicate file")
+		files[file.Name] = readZipFile(t, file)
+	}
+
+	var container struct {
+		XMLName   xml.Name `xml:"urn:oasis:names:tc:opendocument:xmlns:container container"`
+		Rootfiles []struct {
+			FullPath  string `xml:"full-path,attr"`
+			MediaType string `xml:"media-type,attr"`
+		} `xml:"rootfiles>rootfile"`
+	}
+	require.Contains(t, files, "META-INF/container.xml")
+	require.NoError(t, xml.Unmarshal(files["META-INF/container.xml"], &container))
+	require.NotEmpty(t, container.Rootfiles)
+	require.Equal(t, "application/oebps-package+xml", container.Rootfiles[0].MediaType)
+	packagePath := container.Rootfiles[0].FullPath
+	require.Contains(t, files, packagePath)
+
+	pkg := &opfPackage{dir: path.Dir(packagePath)}
+	require.NoError(t, xml.Unmarshal(files[packagePath], pkg))
+	require.Equal(t, "3.0", pkg.Version)
+
+	// The unique identifier references a dc:identifier, and title, language and modification time are required.
+	var identified bool
+	for _, identifier := range pkg.Metadata.Identifiers {
+		identified = identified || (identifier.ID == pkg.UniqueIdentifier && identifier.Value != "")
+	}
+	require.True(t, identified, "unique-identifier must reference a dc:identifier")
+	require.NotEmpty(t, pkg.Metadata.Title)
+	require.NotEmpty(t, pkg.Metadata.Language)
+	require.Regexp(t, regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`), pkg.modified())
+
+	// Every manifest item exists, every file of the publication is in the manifest, and exactly one item is the
+	// navigation document.
+	ids := make(map[string]bool)
+	manifested := map[string]bool{"mimetype": true, packagePath: true}
+	var navs int
+	for _, item := range pkg.Manifest {
+		require.NotContains(t, ids, item.ID, "duplicate manifest ID")
+		ids[item.ID] = true
+		href := path.Join(pkg.dir, item.Href)
+		require.Contains(t, files, href, "manifest item %s must exist", item.ID)
+		require.NotEmpty(t, item.MediaType)
+		manifested[href] = true
+		if item.Properties == "nav" {
+			navs++
+			require.Contains(t, string(files[href]), `<nav epub:type="toc"`, "the navigation document must have a toc")
+		}
+		if item.MediaType == "application/xhtml+xml" {
+			validateXHTML(t, href, files[href])
+		}
+	}
+	require.Equal(t, 1, navs, "there must be exactly one navigation document")
+	for name := range files {
+		require.True(t, manifested[name] || strings.HasPrefix(name, "META-INF/"), "%s must be in the manifest", name)
+	}
+
+	// The spine is not empty and only references manifest items.
+	require.NotEmpty(t, pkg.Spine)
+	for _, itemref := range pkg.Spine {
+		require.True(t, ids[itemref.IDRef], "spine item %s must be in the manifest", itemref.IDRef)
+	}
+
+	return pkg, files
+}
+
+// validateXHTML checks that a content document is well-formed XML in the XHTML namespace.
+func validateXHTML(t *testing.T, name string, document []byte) {
+	t.Helper()
+
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	var root xml.Name
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err, "%s must be well-formed XML", name)
+		if start, ok := token.(xml.StartElement); ok && root.Local == "" {
+			root = start.Name
+		}
+	}
+	require.Equal(t, xml.Name{Space: "http://www.w3.org/1999/xhtml", Local: "html"}, root, name)
+}
+
+func xmlEscape(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,205 @@
+// Package export renders articles as files which can be read offline: Markdown files with YAML front matter,
+// standalone HTML documents and EPUB 3 books.
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// Article is an article to export.
+type Article struct {
+	ID          string
+	Slug        string
+	Title       string
+	Description string
+	AuthorName  string
+	Tags        []string
+	// SeriesTitle and SeriesPosition describe the series the article is a part of. SeriesTitle is empty for
+	// standalone articles.
+	SeriesTitle    string
+	SeriesPosition int
+	// Markdown is the source of the article. Articles written in HTML are kept as HTML, which Markdown allows.
+	Markdown string
+	// HTML is the sanitized HTML content of the article.
+	HTML string
+	// URL is the address of the web page of the article.
+	URL       string
+	Published time.Time
+	Updated   time.Time
+}
+
+// NewArticle converts the details of an article which is served at url.
+func NewArticle(details *store.ArticleDetails, url string) Article {
+	article := Article{
+		ID:          details.ID.String(),
+		Slug:        details.Slug,
+		Title:       details.Title,
+		Description: details.Description,
+		AuthorName:  details.AuthorDisplayName.String,
+		Markdown:    details.Content,
+		HTML:        details.HTMLContent,
+		URL:         url,
+		Published:   details.CreatedAt.UTC(),
+		Updated:     details.UpdatedAt.UTC(),
+	}
+	if details.ContentFormat == store.ContentFormatHTML {
+		article.Markdown = details.HTMLContent
+	}
+	if details.PublishedAt.Valid {
+		article.Published = details.PublishedAt.Time.UTC()
+	}
+	if details.SeriesTitle.Valid {
+		article.SeriesTitle = details.SeriesTitle.String
+		article.SeriesPosition = int(details.Position.Int32)
+	}
+	for _, tag := range details.Tags {
+		article.Tags = append(article.Tags, tag.Name)
+	}
+
+	return article
+}
+
+// MarkdownFileName returns the name of the Markdown file of an article.
+func MarkdownFileName(article Article) string {
+	return article.Slug + ".md"
+}
+
+// frontMatter is the metadata at the beginning of an exported Markdown file.
+type frontMatter struct {
+	Title          string   `yaml:"title"`
+	Description    string   `yaml:"description,omitempty"`
+	Author         string   `yaml:"author,omitempty"`
+	Date           string   `yaml:"date"`
+	Updated        string   `yaml:"updated"`
+	Tags           []string `yaml:"tags,omitempty"`
+	Series         string   `yaml:"series,omitempty"`
+	SeriesPosition int      `yaml:"series_position,omitempty"`
+	Slug           string   `yaml:"slug"`
+	URL            string   `yaml:"url"`
+}
+
+// Markdown renders an article as a Markdown document, preceded by its metadata as YAML front matter.
+func Markdown(article Article) ([]byte, error) {
+	metadata, err := yaml.Marshal(frontMatter{
+		Title:          article.Title,
+		Description:    article.Description,
+		Author:         article.AuthorName,
+		Date:           article.Published.Format(time.RFC3339),
+		Updated:        article.Updated.Format(time.RFC3339),
+		Tags:           article.Tags,
+		Series:         article.SeriesTitle,
+		SeriesPosition: article.SeriesPosition,
+		Slug:           article.Slug,
+		URL:            article.URL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal front matter: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(metadata)
+	buf.WriteString("---\n\n")
+	buf.WriteString(article.Markdown)
+	if article.Markdown != "" && article.Markdown[len(article.Markdown)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
+
+// MarkdownBundle writes a zip archive of the articles as Markdown files, see Markdown.
+func MarkdownBundle(w io.Writer, articles []Article) error {
+	zw := zip.NewWriter(w)
+	for _, article := range articles {
+		body, err := Markdown(article)
+		if err != nil {
+			return err
+		}
+
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     MarkdownFileName(article),
+			Method:   zip.Deflate,
+			Modified: article.Updated,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", MarkdownFileName(article), err)
+		}
+		if _, err := fw.Write(body); err != nil {
+			return fmt.Errorf("failed to write %s: %w", MarkdownFileName(article), err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to close zip archive: %w", err)
+	}
+
+	return nil
+}
+
+var htmlTemplate = template.Must(template.New("article").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+{{- if .Description}}
+<meta name="description" content="{{.Description}}">
+{{- end}}
+{{- if .AuthorName}}
+<meta name="author" content="{{.AuthorName}}">
+{{- end}}
+<link rel="canonical" href="{{.URL}}">
+<style>` + stylesheet + `</style>
+</head>
+<body>
+<article>
+<header>
+<h1>{{.Title}}</h1>
+<p class="byline">{{if .AuthorName}}{{.AuthorName}} · {{end}}<time datetime="{{.Published.Format "2006-01-02T15:04:05Z07:00"}}">{{.Published.Format "January 2, 2006"}}</time></p>
+</header>
+{{.Content}}
+</article>
+</body>
+</html>
+`))
+
+// HTML renders an article as a standalone HTML document, which does not need any other file to be displayed.
+func HTML(article Article) ([]byte, error) {
+	var buf bytes.Buffer
+	err := htmlTemplate.Execute(&buf, struct {
+		Article
+		Content template.HTML
+	}{
+		Article: article,
+		// The content is sanitized when the article is rendered.
+		Content: template.HTML(article.HTML),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render HTML document: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// stylesheet is the style of the exported HTML documents and EPUB chapters.
+const stylesheet = `
+body { margin: 0 auto; max-width: 42em; padding: 1em; font-family: Georgia, serif; line-height: 1.6; }
+h1, h2, h3, h4, h5, h6 { font-family: sans-serif; line-height: 1.25; }
+.byline { color: #555; font-style: italic; }
+pre { overflow-x: auto; padding: 0.5em; background: #f5f5f5; }
+code { font-family: monospace; }
+blockquote { margin-left: 0; padding-left: 1em; border-left: 3px solid #ccc; color: #444; }
+img { max-width: 100%; }
+table { border-collapse: collapse; }
+th, td { padding: 0.25em 0.5em; border: 1px solid #ccc; }
+`
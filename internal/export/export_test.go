@@ -0,0 +1,122 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tuananhlai/brevity-go/internal/export"
+)
+
+var testArticles = []export.Article{
+	{
+		ID:             "0b6c1a52-8a8e-4b8a-a1f1-5d0f4f6f8f10",
+		Slug:           "go-generics",
+		Title:          "Go generics: <explained>",
+		Description:    "A short introduction & more",
+		AuthorName:     "Gopher Bot",
+		Tags:           []string{"Go", "Programming"},
+		SeriesTitle:    "Learning Go",
+		SeriesPosition: 2,
+		Markdown:       "Type parameters & constraints.\n\n![Gopher](https://example.com/gopher.png)",
+		HTML: `<p>Type parameters &amp; constraints.<br>` +
+			`<img src="https://example.com/gopher.png" alt="Gopher"></p><ul><li><input type="checkbox" checked disabled> Done</li></ul>`,
+		URL:       "https://brevity.example.com/articles/go-generics",
+		Published: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		Updated:   time.Date(2026, 10, 3, 9, 0, 0, 0, time.UTC),
+	},
+	{
+		ID:        "7a4b3c2d-1e0f-4a9b-8c7d-6e5f4a3b2c1d",
+		Slug:      "channels",
+		Title:     "Channels",
+		Markdown:  "Do not communicate by sharing memory.\n",
+		HTML:      "<p>Do not communicate by sharing memory.</p>",
+		URL:       "https://brevity.example.com/articles/channels",
+		Published: time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC),
+		Updated:   time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestMarkdown(t *testing.T) {
+	body, err := export.Markdown(testArticles[0])
+	require.NoError(t, err)
+
+	metadata, content := splitFrontMatter(t, body)
+	require.Equal(t, "Go generics: <explained>", metadata["title"])
+	require.Equal(t, "A short introduction & more", metadata["description"])
+	require.Equal(t, "Gopher Bot", metadata["author"])
+	require.Equal(t, "2026-10-01T09:00:00Z", metadata["date"])
+	require.Equal(t, "2026-10-03T09:00:00Z", metadata["updated"])
+	require.Equal(t, []any{"Go", "Programming"}, metadata["tags"])
+	require.Equal(t, "Learning Go", metadata["series"])
+	require.Equal(t, 2, metadata["series_position"])
+	require.Equal(t, "go-generics", metadata["slug"])
+	require.Equal(t, "https://brevity.example.com/articles/go-generics", metadata["url"])
+	require.Equal(t, testArticles[0].Markdown+"\n", content)
+
+	body, err = export.Markdown(testArticles[1])
+	require.NoError(t, err)
+	metadata, _ = splitFrontMatter(t, body)
+	require.NotContains(t, metadata, "tags")
+	require.NotContains(t, metadata, "series")
+}
+
+func TestMarkdownBundle(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, export.MarkdownBundle(&buf, testArticles))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, len(testArticles))
+	for i, file := range zr.File {
+		require.Equal(t, testArticles[i].Slug+".md", file.Name)
+
+		metadata, _ := splitFrontMatter(t, readZipFile(t, file))
+		require.Equal(t, testArticles[i].Title, metadata["title"])
+	}
+}
+
+func TestHTML(t *testing.T) {
+	body, err := export.HTML(testArticles[0])
+	require.NoError(t, err)
+
+	document := string(body)
+	require.True(t, strings.HasPrefix(document, "<!DOCTYPE html>"))
+	require.Contains(t, document, "<title>Go generics: &lt;explained&gt;</title>")
+	require.Contains(t, document, `<meta name="description" content="A short introduction &amp; more">`)
+	require.Contains(t, document, `<link rel="canonical" href="https://brevity.example.com/articles/go-generics">`)
+	require.Contains(t, document, testArticles[0].HTML)
+	require.Contains(t, document, "<style>")
+}
+
+// splitFrontMatter parses the YAML front matter of a Markdown document, and returns it with the content.
+func splitFrontMatter(t *testing.T, document []byte) (map[string]any, string) {
+	t.Helper()
+
+	rest, ok := strings.CutPrefix(string(document), "---\n")
+	require.True(t, ok, "the document must start with front matter")
+	frontMatter, content, ok := strings.Cut(rest, "\n---\n\n")
+	require.True(t, ok, "the front matter must be closed")
+
+	var metadata map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(frontMatter), &metadata))
+	return metadata, content
+}
+
+func readZipFile(t *testing.T, file *zip.File) []byte {
+	t.Helper()
+
+	r, err := file.Open()
+	require.NoError(t, err)
+	defer r.Close()
+
+	body, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return body
+}
@@ -0,0 +1,214 @@
+// Package feed renders lists of articles as RSS 2.0 and Atom 1.0 syndication feeds.
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// Feed is a list of articles which can be rendered in either feed format.
+type Feed struct {
+	// ID is a permanent and unique IRI identifying the feed, e.g. "urn:uuid:...". It is only used by Atom.
+	ID    string
+	Title string
+	// Link is the URL of the web page the feed is about.
+	Link string
+	// SelfLink is the URL the feed is served from.
+	SelfLink    string
+	Description string
+	// Updated is the last time the feed changed. It defaults to the latest update time of the items.
+	Updated time.Time
+	Items   []Item
+}
+
+// Item is an article in a feed.
+type Item struct {
+	// ID is a permanent and unique IRI identifying the article, e.g. "urn:uuid:...".
+	ID    string
+	Title string
+	// Link is the URL of the web page of the article.
+	Link        string
+	Description string
+	// ContentHTML is the full content of the article as HTML. It is escaped in the feed.
+	ContentHTML string
+	AuthorName  string
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+// LastUpdated returns the time the feed last changed: Updated if it is set, else the latest update time of the
+// items. The Unix epoch is returned for an empty feed, so that the time is stable.
+func (f *Feed) LastUpdated() time.Time {
+	if !f.Updated.IsZero() {
+		return f.Updated
+	}
+
+	updated := time.Unix(0, 0).UTC()
+	for _, item := range f.Items {
+		if item.Updated.After(updated) {
+			updated = item.Updated
+		}
+	}
+	return updated
+}
+
+// RSS renders the feed in the RSS 2.0 format.
+func RSS(f Feed) ([]byte, error) {
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Description,
+		LastBuildDate: f.LastUpdated().UTC().Format(time.RFC1123Z),
+		AtomLink: atomLink{
+			Href: f.SelfLink,
+			Rel:  "self",
+			Type: "application/rss+xml",
+		},
+	}
+	for _, item := range f.Items {
+		rss := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Description,
+			GUID:        rssGUID{Value: item.ID, IsPermaLink: false},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Categories:  item.Categories,
+			Content:     item.ContentHTML,
+			Creator:     item.AuthorName,
+		}
+		channel.Items = append(channel.Items, rss)
+	}
+
+	return marshal(rssDocument{
+		Version:      "2.0",
+		AtomNS:       atomNamespace,
+		ContentNS:    "http://purl.org/rss/1.0/modules/content/",
+		DublinCoreNS: "http://purl.org/dc/elements/1.1/",
+		Channel:      channel,
+	})
+}
+
+// Atom renders the feed in the Atom 1.0 format.
+func Atom(f Feed) ([]byte, error) {
+	doc := atomFeed{
+		NS:       atomNamespace,
+		ID:       f.ID,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  f.LastUpdated().UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.SelfLink, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Author:    atomPerson{Name: item.AuthorName},
+			Links:     []atomLink{{Href: item.Link, Rel: "alternate", Type: "text/html"}},
+			Summary:   atomText{Type: "text", Value: item.Description},
+			Content:   atomText{Type: "html", Value: item.ContentHTML},
+		}
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshal(doc)
+}
+
+func marshal(doc any) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal feed: %w", err)
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type rssDocument struct {
+	XMLName      xml.Name   `xml:"rss"`
+	Version      string     `xml:"version,attr"`
+	AtomNS       string     `xml:"xmlns:atom,attr"`
+	ContentNS    string     `xml:"xmlns:content,attr"`
+	DublinCoreNS string     `xml:"xmlns:dc,attr"`
+	Channel      rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+	// Content is the full content of the item, as defined by the RSS content module.
+	Content string `xml:"content:encoded,omitempty"`
+	// Creator is the author name, as defined by the Dublin Core module. The RSS author element requires
+	// an email address, which digital authors do not have.
+	Creator string `xml:"dc:creator,omitempty"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	NS       string      `xml:"xmlns,attr"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published"`
+	Author     atomPerson     `xml:"author"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+	Summary    atomText       `xml:"summary"`
+	Content    atomText       `xml:"content"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
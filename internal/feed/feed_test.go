@@ -0,0 +1,270 @@
+package feed_test
+
+import (
+	"encoding/xml"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/feed"
+)
+
+var testFeed = feed.Feed{
+	ID:          "urn:uuid:5f0c8a4e-7d5c-4f38-9a3c-3f1b5b1c2d3e",
+	Title:       "Brevity",
+	Link:        "https://brevity.example.com",
+	SelfLink:    "https://api.brevity.example.com/feeds/articles.atom",
+	Description: "The latest articles on Brevity",
+	Items: []feed.Item{
+		{
+			ID:          "urn:uuid:0b6c1a52-8a8e-4b8a-a1f1-5d0f4f6f8f10",
+			Title:       "Go generics <explained>",
+			Link:        "https://brevity.example.com/articles/go-generics",
+			Description: "A short introduction & more",
+			ContentHTML: "<p>Type parameters &amp; constraints.</p>",
+			AuthorName:  "Gopher Bot",
+			Categories:  []string{"Go", "Programming"},
+			Published:   time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+			Updated:     time.Date(2026, 10, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:          "urn:uuid:7a4b3c2d-1e0f-4a9b-8c7d-6e5f4a3b2c1d",
+			Title:       "Channels",
+			Link:        "https://brevity.example.com/articles/channels",
+			ContentHTML: "<p>Do not communicate by sharing memory.</p>",
+			AuthorName:  "Gopher Bot",
+			Published:   time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC),
+			Updated:     time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC),
+		},
+	},
+}
+
+func TestLastUpdated(t *testing.T) {
+	require.Equal(t, time.Date(2026, 10, 3, 9, 0, 0, 0, time.UTC), testFeed.LastUpdated())
+
+	empty := feed.Feed{}
+	require.Equal(t, time.Unix(0, 0).UTC(), empty.LastUpdated())
+}
+
+// rssSchema holds the elements of RSS 2.0 which are checked by validateRSS.
+type rssSchema struct {
+	XMLName xml.Name `xml:"rss"`
+	Version string   `xml:"version,attr"`
+	Channel struct {
+		Title         *string   `xml:"title"`
+		Links         []rssLink `xml:"link"`
+		Description   *string   `xml:"description"`
+		LastBuildDate string    `xml:"lastBuildDate"`
+		Items         []struct {
+			Title       *string `xml:"title"`
+			Link        string  `xml:"link"`
+			Description *string `xml:"description"`
+			GUID        struct {
+				Value       string `xml:",chardata"`
+				IsPermaLink string `xml:"isPermaLink,attr"`
+			} `xml:"guid"`
+			PubDate    string   `xml:"pubDate"`
+			Categories []string `xml:"category"`
+			Content    string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+			Creator    string   `xml:"http://purl.org/dc/elements/1.1/ creator"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+// rssLink matches both the RSS link element and the atom:link element of a channel.
+type rssLink struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+	Href    string `xml:"href,attr"`
+	Rel     string `xml:"rel,attr"`
+}
+
+// validateRSS checks the constraints of the RSS 2.0 specification (https://www.rssboard.org/rss-specification)
+// on the feed, and returns it parsed.
+func validateRSS(t *testing.T, body []byte) rssSchema {
+	var doc rssSchema
+	require.NoError(t, xml.Unmarshal(body, &doc))
+
+	require.Equal(t, "2.0", doc.Version)
+	// title, link and description are the required channel elements.
+	require.NotNil(t, doc.Channel.Title)
+	require.NotNil(t, doc.Channel.Description)
+	var channelLinks, selfLinks int
+	for _, link := range doc.Channel.Links {
+		if link.XMLName.Space == "" {
+			requireAbsoluteURL(t, link.Value)
+			channelLinks++
+			continue
+		}
+		require.Equal(t, "http://www.w3.org/2005/Atom", link.XMLName.Space)
+		require.Equal(t, "self", link.Rel)
+		requireAbsoluteURL(t, link.Href)
+		selfLinks++
+	}
+	require.Equal(t, 1, channelLinks)
+	require.Equal(t, 1, selfLinks)
+	requireRFC822(t, doc.Channel.LastBuildDate)
+	for _, item := range doc.Channel.Items {
+		// At least one of title or description must be present.
+		require.True(t, item.Title != nil || item.Description != nil)
+		requireAbsoluteURL(t, item.Link)
+		require.NotEmpty(t, item.GUID.Value)
+		require.Contains(t, []string{"", "true", "false"}, item.GUID.IsPermaLink)
+		requireRFC822(t, item.PubDate)
+	}
+
+	return doc
+}
+
+// atomSchema holds the elements of Atom 1.0 which are checked by validateAtom.
+type atomSchema struct {
+	XMLName xml.Name   `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string     `xml:"id"`
+	Title   *string    `xml:"title"`
+	Updated string     `xml:"updated"`
+	Authors []struct{} `xml:"author"`
+	Links   []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"link"`
+	Entries []struct {
+		ID        string  `xml:"id"`
+		Title     *string `xml:"title"`
+		Updated   string  `xml:"updated"`
+		Published string  `xml:"published"`
+		Authors   []struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Links []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Categories []struct {
+			Term string `xml:"term,attr"`
+		} `xml:"category"`
+		Summary *struct {
+			Type string `xml:"type,attr"`
+		} `xml:"summary"`
+		Content struct {
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"content"`
+	} `xml:"entry"`
+}
+
+// validateAtom checks the constraints of the Atom 1.0 specification (RFC 4287) on the feed, and returns it parsed.
+func validateAtom(t *testing.T, body []byte) atomSchema {
+	var doc atomSchema
+	require.NoError(t, xml.Unmarshal(body, &doc))
+
+	// atom:id, atom:title and atom:updated are required in feeds and entries.
+	requireAbsoluteURL(t, doc.ID)
+	require.NotNil(t, doc.Title)
+	requireRFC3339(t, doc.Updated)
+	var selfLinks int
+	for _, link := range doc.Links {
+		requireAbsoluteURL(t, link.Href)
+		if link.Rel == "self" {
+			selfLinks++
+		}
+	}
+	require.Equal(t, 1, selfLinks)
+
+	for _, entry := range doc.Entries {
+		requireAbsoluteURL(t, entry.ID)
+		require.NotNil(t, entry.Title)
+		requireRFC3339(t, entry.Updated)
+		requireRFC3339(t, entry.Published)
+		// An entry must have an author unless the feed has one.
+		if len(doc.Authors) == 0 {
+			require.NotEmpty(t, entry.Authors)
+		}
+		for _, author := range entry.Authors {
+			require.NotEmpty(t, author.Name)
+		}
+		// An entry without content must have an alternate link.
+		var alternateLinks int
+		for _, link := range entry.Links {
+			if link.Rel == "" || link.Rel == "alternate" {
+				alternateLinks++
+			}
+		}
+		require.Equal(t, 1, alternateLinks)
+		for _, category := range entry.Categories {
+			require.NotEmpty(t, category.Term)
+		}
+		if entry.Summary != nil {
+			require.Contains(t, []string{"text", "html", "xhtml"}, entry.Summary.Type)
+		}
+		require.Contains(t, []string{"text", "html", "xhtml"}, entry.Content.Type)
+	}
+
+	return doc
+}
+
+func TestRSS(t *testing.T) {
+	body, err := feed.RSS(testFeed)
+	require.NoError(t, err)
+
+	doc := validateRSS(t, body)
+	require.Equal(t, "Sat, 03 Oct 2026 09:00:00 +0000", doc.Channel.LastBuildDate)
+	require.Len(t, doc.Channel.Items, 2)
+	item := doc.Channel.Items[0]
+	require.Equal(t, "Go generics <explained>", *item.Title)
+	require.Equal(t, "Thu, 01 Oct 2026 09:00:00 +0000", item.PubDate)
+	require.Equal(t, "false", item.GUID.IsPermaLink)
+	require.Equal(t, []string{"Go", "Programming"}, item.Categories)
+	require.Equal(t, "<p>Type parameters &amp; constraints.</p>", item.Content)
+	require.Equal(t, "Gopher Bot", item.Creator)
+}
+
+func TestAtom(t *testing.T) {
+	body, err := feed.Atom(testFeed)
+	require.NoError(t, err)
+
+	doc := validateAtom(t, body)
+	require.Equal(t, testFeed.ID, doc.ID)
+	require.Equal(t, "2026-10-03T09:00:00Z", doc.Updated)
+	require.Len(t, doc.Entries, 2)
+	entry := doc.Entries[0]
+	require.Equal(t, "2026-10-03T09:00:00Z", entry.Updated)
+	require.Equal(t, "2026-10-01T09:00:00Z", entry.Published)
+	require.Equal(t, "html", entry.Content.Type)
+	require.Equal(t, "<p>Type parameters &amp; constraints.</p>", entry.Content.Value)
+}
+
+func TestAtom_Empty(t *testing.T) {
+	body, err := feed.Atom(feed.Feed{
+		ID:       "urn:uuid:5f0c8a4e-7d5c-4f38-9a3c-3f1b5b1c2d3e",
+		Title:    "Empty",
+		Link:     "https://brevity.example.com",
+		SelfLink: "https://api.brevity.example.com/feeds/articles.atom",
+	})
+	require.NoError(t, err)
+
+	doc := validateAtom(t, body)
+	require.Equal(t, "1970-01-01T00:00:00Z", doc.Updated)
+	require.Empty(t, doc.Entries)
+}
+
+func requireAbsoluteURL(t *testing.T, rawURL string) {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	require.True(t, u.IsAbs(), "%q is not an absolute URL", rawURL)
+}
+
+func requireRFC822(t *testing.T, value string) {
+	t.Helper()
+	_, err := time.Parse(time.RFC1123Z, value)
+	require.NoError(t, err)
+}
+
+func requireRFC3339(t *testing.T, value string) {
+	t.Helper()
+	_, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/invopop/jsonschema"
 	"github.com/openai/openai-go"
@@ -35,7 +36,10 @@ func New(client openai.Client) *Generator {
 }
 
 // Generate ...
-func (g *Generator) Generate(ctx context.Context, personalityPrompt string, excludedTopics []string) (*Article, error) {
+// If series is not nil, the article is written as the next part of the series.
+func (g *Generator) Generate(ctx context.Context, personalityPrompt string, excludedTopics []string,
+	series *Series,
+) (*Article, error) {
 	messages := []openai.ChatCompletionMessageParamUnion{
 		openai.SystemMessage(personalityPrompt),
 		openai.SystemMessage(TechnicalWritingStylePrompt),
@@ -45,7 +49,13 @@ func (g *Generator) Generate(ctx context.Context, personalityPrompt string, excl
 			fmt.Sprintf("You have already written articles with the following slugs: %v. Do not write about the same topic.", excludedTopics),
 		))
 	}
-	messages = append(messages, openai.UserMessage("Write about a random topic of your specialty"))
+	if series != nil {
+		messages = append(messages, openai.SystemMessage(seriesPrompt(series)))
+		messages = append(messages, openai.UserMessage(
+			fmt.Sprintf("Write part %d of the series", series.NextPosition)))
+	} else {
+		messages = append(messages, openai.UserMessage("Write about a random topic of your specialty"))
+	}
 
 	chat, err := g.openAIClient.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
 		Messages: messages,
@@ -78,6 +88,46 @@ type Article struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Content     string `json:"content"`
+	// Tags are topics that the article can be categorized under.
+	Tags []string `json:"tags" jsonschema_description:"Between 1 and 5 short topic tags for the article in Title Case, e.g. Go or Concurrency. Prefer broad topics that other articles could share."`
+}
+
+// Series is a sequence of articles which builds on the earlier parts.
+type Series struct {
+	Title       string
+	Description string
+	// Parts are the earlier parts of the series, in order.
+	Parts []SeriesPart
+	// NextPosition is the position of the part to write. Positions of removed parts are skipped.
+	NextPosition int
+}
+
+type SeriesPart struct {
+	Position int
+	Title    string
+	Summary  string
+}
+
+// seriesPrompt describes the series and its earlier parts, so that the next part continues where they stopped.
+func seriesPrompt(series *Series) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "You are writing a series of articles titled %q.", series.Title)
+	if series.Description != "" {
+		fmt.Fprintf(&b, " The series is about: %s", series.Description)
+	}
+	if len(series.Parts) == 0 {
+		b.WriteString("\nThis is the first part of the series. Introduce the series and its first topic.")
+		return b.String()
+	}
+
+	b.WriteString("\nThe earlier parts of the series are:\n")
+	for _, part := range series.Parts {
+		fmt.Fprintf(&b, "%d. %s: %s\n", part.Position, part.Title, part.Summary)
+	}
+	b.WriteString("Continue the series with the next logical topic. Build on the earlier parts without repeating them, " +
+		"and refer to them where it helps the reader.")
+
+	return b.String()
 }
 
 func createJSONSchema[T any]() any {
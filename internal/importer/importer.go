@@ -0,0 +1,298 @@
+// Package importer imports articles written by hand from Markdown files with YAML front matter, such as the
+// files exported by export.MarkdownBundle.
+package importer
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/tuananhlai/brevity-go/internal/content"
+	"github.com/tuananhlai/brevity-go/internal/slug"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+var (
+	ErrMissingFrontMatter = errors.New("missing front matter")
+	ErrMissingTitle       = errors.New("missing title")
+	ErrInvalidSlug        = errors.New("slug has no letters or digits")
+	// ErrSlugTaken is returned when the slug of a file is used by an article of another digital author, or was
+	// used by another article in the past.
+	ErrSlugTaken = errors.New("slug is used by another article")
+	// ErrArticleDeleted is returned when the slug of a file is used by a deleted article.
+	ErrArticleDeleted = errors.New("article with the slug was deleted")
+)
+
+type ArticleStore interface {
+	GetArticleSourceBySlug(ctx context.Context, slug string) (*store.ArticleSource, error)
+	ArticleSlugExists(ctx context.Context, slug string) (bool, error)
+	CreateArticle(ctx context.Context, article *store.Article) error
+	UpdateArticle(ctx context.Context, params store.UpdateArticleParams) error
+}
+
+// Action is what an import does with a file.
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+	ActionFail      Action = "fail"
+)
+
+// Result is the outcome of importing a file.
+type Result struct {
+	// File is the path of the imported file.
+	File string
+	// Slug is the slug of the article. It is empty if the file could not be parsed.
+	Slug   string
+	Action Action
+	// Err is the reason why the file could not be imported if Action is ActionFail.
+	Err error
+}
+
+// File is an article read from a Markdown file.
+type File struct {
+	Slug        string
+	Title       string
+	Description string
+	Tags        []string
+	// Date is the publish time of the article. It is zero if the file does not have one.
+	Date time.Time
+	// Content is the Markdown content of the article, without the front matter.
+	Content string
+}
+
+// frontMatter is the metadata at the beginning of an imported Markdown file. Other fields are ignored.
+type frontMatter struct {
+	Title       string    `yaml:"title"`
+	Slug        string    `yaml:"slug"`
+	Description string    `yaml:"description"`
+	Tags        []string  `yaml:"tags"`
+	Date        time.Time `yaml:"date"`
+}
+
+// Parse reads an article from a Markdown file with YAML front matter. The front matter must have a title.
+// The slug defaults to the name of the file without its extension, and is normalized like generated slugs.
+func Parse(name string, data []byte) (*File, error) {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	rest, ok := bytes.CutPrefix(data, []byte("---\n"))
+	if !ok {
+		return nil, ErrMissingFrontMatter
+	}
+	metadata, body, ok := bytes.Cut(rest, []byte("\n---\n"))
+	if !ok {
+		metadata, ok = bytes.CutSuffix(rest, []byte("\n---"))
+		if !ok {
+			return nil, ErrMissingFrontMatter
+		}
+	}
+
+	var fm frontMatter
+	if err := yaml.Unmarshal(metadata, &fm); err != nil {
+		return nil, fmt.Errorf("failed to parse front matter: %w", err)
+	}
+	if strings.TrimSpace(fm.Title) == "" {
+		return nil, ErrMissingTitle
+	}
+
+	base := strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+	articleSlug := slug.NormalizeASCII(cmp.Or(fm.Slug, base), slug.MaxLength)
+	if articleSlug == "" {
+		return nil, ErrInvalidSlug
+	}
+
+	return &File{
+		Slug:        articleSlug,
+		Title:       strings.TrimSpace(fm.Title),
+		Description: strings.TrimSpace(fm.Description),
+		Tags:        fm.Tags,
+		Date:        fm.Date,
+		Content:     strings.TrimSpace(string(body)) + "\n",
+	}, nil
+}
+
+// Importer imports Markdown files as articles of a digital author. Importing the same files again is a no-op,
+// so that an import can be repeated after editing some of the files.
+type Importer struct {
+	store    ArticleStore
+	renderer *content.Renderer
+	now      func() time.Time
+}
+
+func New(store ArticleStore) *Importer {
+	return &Importer{
+		store:    store,
+		renderer: content.NewRenderer(),
+		now:      time.Now,
+	}
+}
+
+// ImportDir imports the Markdown files (*.md and *.markdown) at the top of params.Dir, in the order of their
+// names, and returns the result of each file. A file which cannot be imported does not stop the import of the
+// other files. An error is only returned if the directory cannot be read.
+func (i *Importer) ImportDir(ctx context.Context, params ImportDirParams) ([]Result, error) {
+	entries, err := os.ReadDir(params.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	results := []Result{}
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".md" && ext != ".markdown") {
+			continue
+		}
+
+		path := filepath.Join(params.Dir, entry.Name())
+		result := Result{File: path}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			result.Action, result.Err = ActionFail, err
+			results = append(results, result)
+			continue
+		}
+		file, err := Parse(path, data)
+		if err != nil {
+			result.Action, result.Err = ActionFail, err
+			results = append(results, result)
+			continue
+		}
+
+		result.Slug = file.Slug
+		result.Action, result.Err = i.importFile(ctx, file, params.AuthorID, params.DryRun)
+		if result.Err != nil {
+			result.Action = ActionFail
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+type ImportDirParams struct {
+	Dir      string
+	AuthorID uuid.UUID
+	// DryRun reports what the import would do without changing any article.
+	DryRun bool
+}
+
+// importFile creates the article of the file, or updates it if the digital author already has an article with
+// the same slug. The publish time of an existing article is never changed.
+func (i *Importer) importFile(ctx context.Context, file *File, authorID uuid.UUID, dryRun bool) (Action, error) {
+	rendered, err := i.renderer.Render(file.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to render content: %w", err)
+	}
+
+	existing, err := i.store.GetArticleSourceBySlug(ctx, file.Slug)
+	if errors.Is(err, store.ErrArticleNotFound) {
+		// The slug may still belong to the slug history of another article.
+		taken, err := i.store.ArticleSlugExists(ctx, file.Slug)
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return "", ErrSlugTaken
+		}
+		if dryRun {
+			return ActionCreate, nil
+		}
+		return ActionCreate, i.create(ctx, file, authorID, rendered)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case existing.AuthorID != authorID:
+		return "", ErrSlugTaken
+	case existing.DeletedAt.Valid:
+		return "", ErrArticleDeleted
+	case isUnchanged(existing, file):
+		return ActionUnchanged, nil
+	case dryRun:
+		return ActionUpdate, nil
+	}
+
+	tags := file.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return ActionUpdate, i.store.UpdateArticle(ctx, store.UpdateArticleParams{
+		ID:               existing.ID,
+		Title:            file.Title,
+		Description:      file.Description,
+		Content:          file.Content,
+		ContentFormat:    store.ContentFormatMarkdown,
+		HTMLContent:      rendered.HTML,
+		PlaintextContent: rendered.Plaintext,
+		Tags:             tags,
+	})
+}
+
+// create creates the article of a file. It is published at the date of the file, or immediately if the file does
+// not have a date. Articles dated in the future are scheduled.
+func (i *Importer) create(ctx context.Context, file *File, authorID uuid.UUID, rendered *content.Rendered) error {
+	article := &store.Article{
+		Slug:             file.Slug,
+		Title:            file.Title,
+		Description:      file.Description,
+		Content:          file.Content,
+		ContentFormat:    store.ContentFormatMarkdown,
+		HTMLContent:      rendered.HTML,
+		PlaintextContent: rendered.Plaintext,
+		AuthorID:         authorID,
+		Tags:             file.Tags,
+		Status:           store.ArticleStatusPublished,
+	}
+	if !file.Date.IsZero() {
+		article.PublishedAt.Time, article.PublishedAt.Valid = file.Date, true
+		if file.Date.After(i.now()) {
+			article.Status = store.ArticleStatusScheduled
+		}
+	}
+
+	return i.store.CreateArticle(ctx, article)
+}
+
+func isUnchanged(existing *store.ArticleSource, file *File) bool {
+	currentTags := make([]string, len(existing.Tags))
+	for j, tag := range existing.Tags {
+		currentTags[j] = tag.Slug
+	}
+	slices.Sort(currentTags)
+
+	return existing.Title == file.Title &&
+		existing.Description == file.Description &&
+		existing.Content == file.Content &&
+		existing.ContentFormat == store.ContentFormatMarkdown &&
+		slices.Equal(currentTags, tagSlugs(file.Tags))
+}
+
+// tagSlugs returns the sorted slugs of the tags which the store keeps from tagNames.
+func tagSlugs(tagNames []string) []string {
+	slugs := []string{}
+	for _, name := range tagNames {
+		if len(slugs) == store.MaxTagsPerArticle {
+			break
+		}
+		tagSlug := slug.Normalize(strings.TrimSpace(name))
+		if tagSlug != "" && !slices.Contains(slugs, tagSlug) {
+			slugs = append(slugs, tagSlug)
+		}
+	}
+	slices.Sort(slugs)
+
+	return slugs
+}
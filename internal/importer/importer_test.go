@@ -0,0 +1,256 @@
+package importer_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/export"
+	"github.com/tuananhlai/brevity-go/internal/importer"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestParse(t *testing.T) {
+	file, err := importer.Parse("posts/hello.md", []byte(`---
+title: "  Hello, World  "
+description: A first post.
+tags: [Go, Testing]
+date: 2025-03-01
+---
+
+# Hello
+
+Welcome.
+`))
+	require.NoError(t, err)
+	require.Equal(t, &importer.File{
+		Slug:        "hello",
+		Title:       "Hello, World",
+		Description: "A first post.",
+		Tags:        []string{"Go", "Testing"},
+		Date:        time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		Content:     "# Hello\n\nWelcome.\n",
+	}, file)
+}
+
+func TestParse_SlugIsNormalized(t *testing.T) {
+	file, err := importer.Parse("Café Culture.md", []byte("---\r\ntitle: Café\r\n---\r\nText"))
+	require.NoError(t, err)
+	require.Equal(t, "cafe-culture", file.Slug)
+	require.Equal(t, "Text\n", file.Content)
+
+	file, err = importer.Parse("post.md", []byte("---\ntitle: Café\nslug: My Post!\n---"))
+	require.NoError(t, err)
+	require.Equal(t, "my-post", file.Slug)
+	require.Equal(t, "\n", file.Content)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "no front matter", data: "# Hello", wantErr: importer.ErrMissingFrontMatter},
+		{name: "unterminated front matter", data: "---\ntitle: Hello\n# Hello", wantErr: importer.ErrMissingFrontMatter},
+		{name: "no title", data: "---\ndescription: Hello\n---\n# Hello", wantErr: importer.ErrMissingTitle},
+		{name: "no slug", data: "---\ntitle: Hello\nslug: '!!'\n---\n# Hello", wantErr: importer.ErrInvalidSlug},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := importer.Parse("post.md", []byte(tt.data))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := importer.Parse("post.md", []byte("---\ntitle: [\n---\n"))
+	require.ErrorContains(t, err, "failed to parse front matter")
+}
+
+func TestParse_ExportedMarkdown(t *testing.T) {
+	data, err := export.Markdown(export.Article{
+		Slug:        "go-generics",
+		Title:       "Go Generics",
+		Description: "Type parameters.",
+		AuthorName:  "Gopher Bot",
+		Tags:        []string{"Go"},
+		Markdown:    "# Go Generics\n",
+		Published:   time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		Updated:     time.Date(2026, 10, 2, 9, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	file, err := importer.Parse("other-name.md", data)
+	require.NoError(t, err)
+	require.Equal(t, "go-generics", file.Slug)
+	require.Equal(t, "Go Generics", file.Title)
+	require.Equal(t, "Type parameters.", file.Description)
+	require.Equal(t, []string{"Go"}, file.Tags)
+	require.True(t, file.Date.Equal(time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)))
+	require.Equal(t, "# Go Generics\n", file.Content)
+}
+
+func TestImporter(t *testing.T) {
+	suite.Run(t, new(ImporterTestSuite))
+}
+
+type ImporterTestSuite struct {
+	suite.Suite
+	mockStore *importer.MockArticleStore
+	importer  *importer.Importer
+	dir       string
+	authorID  uuid.UUID
+}
+
+func (s *ImporterTestSuite) SetupTest() {
+	s.mockStore = importer.NewMockArticleStore(s.T())
+	s.importer = importer.New(s.mockStore)
+	s.dir = s.T().TempDir()
+	s.authorID = uuid.New()
+}
+
+func (s *ImporterTestSuite) writeFile(name, data string) {
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, name), []byte(data), 0o644))
+}
+
+// existing returns the stored article which the file "---\ntitle: Hello\ntags: [Go]\n---\nHi" would create.
+func (s *ImporterTestSuite) existing() *store.ArticleSource {
+	return &store.ArticleSource{
+		ID:            uuid.New(),
+		Content:       "Hi\n",
+		ContentFormat: store.ContentFormatMarkdown,
+		AuthorID:      s.authorID,
+		Title:         "Hello",
+		Tags:          store.TagList{{ID: uuid.New(), Slug: "go", Name: "go"}},
+	}
+}
+
+func (s *ImporterTestSuite) TestImportDir_Create() {
+	s.writeFile("hello.md", "---\ntitle: Hello\ndescription: First.\ntags: [Go]\ndate: 2025-03-01\n---\n# Hi\n")
+	s.writeFile("future.markdown", "---\ntitle: Future\ndate: 2999-01-01\n---\nLater")
+	s.writeFile("notes.txt", "ignored")
+	s.Require().NoError(os.Mkdir(filepath.Join(s.dir, "drafts.md"), 0o755))
+
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, mock.Anything).Return(nil, store.ErrArticleNotFound)
+	s.mockStore.On("ArticleSlugExists", mock.Anything, mock.Anything).Return(false, nil)
+	s.mockStore.On("CreateArticle", mock.Anything, mock.MatchedBy(func(article *store.Article) bool {
+		return article.Slug == "hello"
+	})).Run(func(args mock.Arguments) {
+		article := args.Get(1).(*store.Article)
+		s.Require().Equal("Hello", article.Title)
+		s.Require().Equal("First.", article.Description)
+		s.Require().Equal("# Hi\n", article.Content)
+		s.Require().Equal(store.ContentFormatMarkdown, article.ContentFormat)
+		s.Require().Contains(article.HTMLContent, "<h1")
+		s.Require().Equal("Hi", article.PlaintextContent)
+		s.Require().Equal(s.authorID, article.AuthorID)
+		s.Require().Equal([]string{"Go"}, article.Tags)
+		s.Require().Equal(store.ArticleStatusPublished, article.Status)
+		s.Require().Equal(sql.NullTime{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true}, article.PublishedAt)
+	}).Return(nil).Once()
+	s.mockStore.On("CreateArticle", mock.Anything, mock.MatchedBy(func(article *store.Article) bool {
+		return article.Slug == "future" && article.Status == store.ArticleStatusScheduled
+	})).Return(nil).Once()
+
+	results, err := s.importer.ImportDir(context.Background(), importer.ImportDirParams{
+		Dir:      s.dir,
+		AuthorID: s.authorID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]importer.Result{
+		{File: filepath.Join(s.dir, "future.markdown"), Slug: "future", Action: importer.ActionCreate},
+		{File: filepath.Join(s.dir, "hello.md"), Slug: "hello", Action: importer.ActionCreate},
+	}, results)
+}
+
+func (s *ImporterTestSuite) TestImportDir_UpdateAndUnchanged() {
+	s.writeFile("hello.md", "---\ntitle: Hello\ntags: [Go]\n---\nHi")
+	s.writeFile("edited.md", "---\ntitle: Hello again\n---\nHi")
+
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "hello").Return(s.existing(), nil)
+	edited := s.existing()
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "edited").Return(edited, nil)
+	s.mockStore.On("UpdateArticle", mock.Anything, mock.MatchedBy(func(params store.UpdateArticleParams) bool {
+		return params.ID == edited.ID && params.Title == "Hello again" && params.Content == "Hi\n" &&
+			params.HTMLContent == "<p>Hi</p>\n" && params.Tags != nil && len(params.Tags) == 0
+	})).Return(nil).Once()
+
+	results, err := s.importer.ImportDir(context.Background(), importer.ImportDirParams{
+		Dir:      s.dir,
+		AuthorID: s.authorID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]importer.Result{
+		{File: filepath.Join(s.dir, "edited.md"), Slug: "edited", Action: importer.ActionUpdate},
+		{File: filepath.Join(s.dir, "hello.md"), Slug: "hello", Action: importer.ActionUnchanged},
+	}, results)
+}
+
+func (s *ImporterTestSuite) TestImportDir_DryRun() {
+	s.writeFile("new.md", "---\ntitle: New\n---\nHi")
+	s.writeFile("edited.md", "---\ntitle: Hello again\n---\nHi")
+
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "new").Return(nil, store.ErrArticleNotFound)
+	s.mockStore.On("ArticleSlugExists", mock.Anything, "new").Return(false, nil)
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "edited").Return(s.existing(), nil)
+
+	results, err := s.importer.ImportDir(context.Background(), importer.ImportDirParams{
+		Dir:      s.dir,
+		AuthorID: s.authorID,
+		DryRun:   true,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]importer.Result{
+		{File: filepath.Join(s.dir, "edited.md"), Slug: "edited", Action: importer.ActionUpdate},
+		{File: filepath.Join(s.dir, "new.md"), Slug: "new", Action: importer.ActionCreate},
+	}, results)
+	s.mockStore.AssertNotCalled(s.T(), "CreateArticle", mock.Anything, mock.Anything)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateArticle", mock.Anything, mock.Anything)
+}
+
+func (s *ImporterTestSuite) TestImportDir_Failures() {
+	s.writeFile("a-invalid.md", "# No front matter")
+	s.writeFile("b-other-author.md", "---\ntitle: Hello\n---\nHi")
+	s.writeFile("c-deleted.md", "---\ntitle: Hello\n---\nHi")
+	s.writeFile("d-renamed.md", "---\ntitle: Hello\n---\nHi")
+
+	otherAuthor := s.existing()
+	otherAuthor.AuthorID = uuid.New()
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "b-other-author").Return(otherAuthor, nil)
+	deleted := s.existing()
+	deleted.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "c-deleted").Return(deleted, nil)
+	s.mockStore.On("GetArticleSourceBySlug", mock.Anything, "d-renamed").Return(nil, store.ErrArticleNotFound)
+	s.mockStore.On("ArticleSlugExists", mock.Anything, "d-renamed").Return(true, nil)
+
+	results, err := s.importer.ImportDir(context.Background(), importer.ImportDirParams{
+		Dir:      s.dir,
+		AuthorID: s.authorID,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]importer.Result{
+		{File: filepath.Join(s.dir, "a-invalid.md"), Action: importer.ActionFail, Err: importer.ErrMissingFrontMatter},
+		{File: filepath.Join(s.dir, "b-other-author.md"), Slug: "b-other-author", Action: importer.ActionFail,
+			Err: importer.ErrSlugTaken},
+		{File: filepath.Join(s.dir, "c-deleted.md"), Slug: "c-deleted", Action: importer.ActionFail,
+			Err: importer.ErrArticleDeleted},
+		{File: filepath.Join(s.dir, "d-renamed.md"), Slug: "d-renamed", Action: importer.ActionFail,
+			Err: importer.ErrSlugTaken},
+	}, results)
+}
+
+func (s *ImporterTestSuite) TestImportDir_DirectoryNotFound() {
+	_, err := s.importer.ImportDir(context.Background(), importer.ImportDirParams{
+		Dir:      filepath.Join(s.dir, "missing"),
+		AuthorID: s.authorID,
+	})
+	s.Require().ErrorIs(err, os.ErrNotExist)
+}
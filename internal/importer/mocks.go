@@ -0,0 +1,287 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package importer
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// NewMockArticleStore creates a new instance of MockArticleStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleStore {
+	mock := &MockArticleStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArticleStore is an autogenerated mock type for the ArticleStore type
+type MockArticleStore struct {
+	mock.Mock
+}
+
+type MockArticleStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleStore) EXPECT() *MockArticleStore_Expecter {
+	return &MockArticleStore_Expecter{mock: &_m.Mock}
+}
+
+// ArticleSlugExists provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) ArticleSlugExists(ctx context.Context, slug string) (bool, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArticleSlugExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_ArticleSlugExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArticleSlugExists'
+type MockArticleStore_ArticleSlugExists_Call struct {
+	*mock.Call
+}
+
+// ArticleSlugExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockArticleStore_Expecter) ArticleSlugExists(ctx interface{}, slug interface{}) *MockArticleStore_ArticleSlugExists_Call {
+	return &MockArticleStore_ArticleSlugExists_Call{Call: _e.mock.On("ArticleSlugExists", ctx, slug)}
+}
+
+func (_c *MockArticleStore_ArticleSlugExists_Call) Run(run func(ctx context.Context, slug string)) *MockArticleStore_ArticleSlugExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_ArticleSlugExists_Call) Return(b bool, err error) *MockArticleStore_ArticleSlugExists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockArticleStore_ArticleSlugExists_Call) RunAndReturn(run func(ctx context.Context, slug string) (bool, error)) *MockArticleStore_ArticleSlugExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateArticle provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) CreateArticle(ctx context.Context, article *store.Article) error {
+	ret := _mock.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *store.Article) error); ok {
+		r0 = returnFunc(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockArticleStore_CreateArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateArticle'
+type MockArticleStore_CreateArticle_Call struct {
+	*mock.Call
+}
+
+// CreateArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *store.Article
+func (_e *MockArticleStore_Expecter) CreateArticle(ctx interface{}, article interface{}) *MockArticleStore_CreateArticle_Call {
+	return &MockArticleStore_CreateArticle_Call{Call: _e.mock.On("CreateArticle", ctx, article)}
+}
+
+func (_c *MockArticleStore_CreateArticle_Call) Run(run func(ctx context.Context, article *store.Article)) *MockArticleStore_CreateArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *store.Article
+		if args[1] != nil {
+			arg1 = args[1].(*store.Article)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_CreateArticle_Call) Return(err error) *MockArticleStore_CreateArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockArticleStore_CreateArticle_Call) RunAndReturn(run func(ctx context.Context, article *store.Article) error) *MockArticleStore_CreateArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleSourceBySlug provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) GetArticleSourceBySlug(ctx context.Context, slug string) (*store.ArticleSource, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleSourceBySlug")
+	}
+
+	var r0 *store.ArticleSource
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*store.ArticleSource, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *store.ArticleSource); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.ArticleSource)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_GetArticleSourceBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleSourceBySlug'
+type MockArticleStore_GetArticleSourceBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleSourceBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockArticleStore_Expecter) GetArticleSourceBySlug(ctx interface{}, slug interface{}) *MockArticleStore_GetArticleSourceBySlug_Call {
+	return &MockArticleStore_GetArticleSourceBySlug_Call{Call: _e.mock.On("GetArticleSourceBySlug", ctx, slug)}
+}
+
+func (_c *MockArticleStore_GetArticleSourceBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockArticleStore_GetArticleSourceBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleSourceBySlug_Call) Return(articleSource *store.ArticleSource, err error) *MockArticleStore_GetArticleSourceBySlug_Call {
+	_c.Call.Return(articleSource, err)
+	return _c
+}
+
+func (_c *MockArticleStore_GetArticleSourceBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*store.ArticleSource, error)) *MockArticleStore_GetArticleSourceBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateArticle provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) UpdateArticle(ctx context.Context, params store.UpdateArticleParams) error {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateArticle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.UpdateArticleParams) error); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockArticleStore_UpdateArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticle'
+type MockArticleStore_UpdateArticle_Call struct {
+	*mock.Call
+}
+
+// UpdateArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.UpdateArticleParams
+func (_e *MockArticleStore_Expecter) UpdateArticle(ctx interface{}, params interface{}) *MockArticleStore_UpdateArticle_Call {
+	return &MockArticleStore_UpdateArticle_Call{Call: _e.mock.On("UpdateArticle", ctx, params)}
+}
+
+func (_c *MockArticleStore_UpdateArticle_Call) Run(run func(ctx context.Context, params store.UpdateArticleParams)) *MockArticleStore_UpdateArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.UpdateArticleParams
+		if args[1] != nil {
+			arg1 = args[1].(store.UpdateArticleParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_UpdateArticle_Call) Return(err error) *MockArticleStore_UpdateArticle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockArticleStore_UpdateArticle_Call) RunAndReturn(run func(ctx context.Context, params store.UpdateArticleParams) error) *MockArticleStore_UpdateArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,256 @@
+// Package ogimage renders the social preview images of articles, which are shown by social networks and chat
+// apps when a link to an article is shared.
+package ogimage
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// Width and Height are the size of the images, as recommended for Open Graph and Twitter cards.
+	Width  = 1200
+	Height = 630
+
+	margin        = 80
+	accentWidth   = 16
+	titleSize     = 64
+	titleLeading  = 80
+	maxTitleLines = 4
+	brandSize     = 36
+	footerSize    = 32
+	ellipsis      = "…"
+)
+
+var (
+	backgroundColor = color.RGBA{R: 0xfa, G: 0xfa, B: 0xf7, A: 0xff}
+	accentColor     = color.RGBA{R: 0x1a, G: 0x7f, B: 0x64, A: 0xff}
+	titleColor      = color.RGBA{R: 0x11, G: 0x18, B: 0x27, A: 0xff}
+	footerColor     = color.RGBA{R: 0x4b, G: 0x55, B: 0x63, A: 0xff}
+)
+
+var (
+	regularFont = mustParseFont(goregular.TTF)
+	boldFont    = mustParseFont(gobold.TTF)
+)
+
+func mustParseFont(ttf []byte) *opentype.Font {
+	f, err := opentype.Parse(ttf)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Card is the content of the preview image of an article.
+type Card struct {
+	Title string
+	// Author is the display name of the author of the article. It is left out of the image if empty.
+	Author         string
+	ReadingMinutes int
+}
+
+// Renderer renders cards as PNG images. The most recently rendered images are kept in memory, so that the image
+// of a card is only rendered again once it has been evicted. It is safe for concurrent use.
+type Renderer struct {
+	mu       sync.Mutex
+	capacity int
+	// recent lists the cached images, most recently used first.
+	recent *list.List
+	images map[Card]*list.Element
+}
+
+type cachedImage struct {
+	card Card
+	data []byte
+}
+
+// NewRenderer creates a renderer which keeps up to cacheSize images in memory. Images are not cached if
+// cacheSize is zero.
+func NewRenderer(cacheSize int) *Renderer {
+	return &Renderer{
+		capacity: cacheSize,
+		recent:   list.New(),
+		images:   map[Card]*list.Element{},
+	}
+}
+
+// Render returns the PNG image of a card. The returned slice is shared with other callers and must not be
+// modified.
+func (r *Renderer) Render(card Card) ([]byte, error) {
+	if cached, ok := r.get(card); ok {
+		return cached, nil
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, drawCard(card)); err != nil {
+		return nil, fmt.Errorf("failed to encode PNG image: %w", err)
+	}
+
+	r.put(card, buf.Bytes())
+	return buf.Bytes(), nil
+}
+
+func (r *Renderer) get(card Card) ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.images[card]
+	if !ok {
+		return nil, false
+	}
+	r.recent.MoveToFront(element)
+	return element.Value.(*cachedImage).data, true
+}
+
+func (r *Renderer) put(card Card, data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.capacity <= 0 {
+		return
+	}
+	// The image may have been rendered concurrently by another caller.
+	if element, ok := r.images[card]; ok {
+		r.recent.MoveToFront(element)
+		return
+	}
+
+	r.images[card] = r.recent.PushFront(&cachedImage{card: card, data: data})
+	if r.recent.Len() > r.capacity {
+		oldest := r.recent.Back()
+		r.recent.Remove(oldest)
+		delete(r.images, oldest.Value.(*cachedImage).card)
+	}
+}
+
+// drawCard draws a card: the name of the website at the top, the title of the article in the middle, and its
+// author and reading time at the bottom.
+func drawCard(card Card) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	fill(img, img.Bounds(), backgroundColor)
+	fill(img, image.Rect(0, 0, accentWidth, Height), accentColor)
+
+	brandFace := newFace(boldFont, brandSize)
+	defer brandFace.Close()
+	drawText(img, brandFace, accentColor, margin, margin+brandSize, "Brevity")
+
+	titleFace := newFace(boldFont, titleSize)
+	defer titleFace.Close()
+	lines := wrap(titleFace, strings.Join(strings.Fields(card.Title), " "), Width-2*margin, maxTitleLines)
+	// The title is centered vertically between the name of the website and the footer.
+	y := (Height-len(lines)*titleLeading)/2 + titleSize
+	for _, line := range lines {
+		drawText(img, titleFace, titleColor, margin, y, line)
+		y += titleLeading
+	}
+
+	footer := readingTime(card.ReadingMinutes)
+	if author := strings.TrimSpace(card.Author); author != "" {
+		footer = author + " · " + footer
+	}
+	footerFace := newFace(regularFont, footerSize)
+	defer footerFace.Close()
+	footerLines := wrap(footerFace, footer, Width-2*margin, 1)
+	drawText(img, footerFace, footerColor, margin, Height-margin, footerLines[0])
+
+	return img
+}
+
+func readingTime(minutes int) string {
+	return strconv.Itoa(max(minutes, 1)) + " min read"
+}
+
+func newFace(f *opentype.Font, size float64) font.Face {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		// NewFace only fails for invalid options.
+		panic(err)
+	}
+	return face
+}
+
+func fill(img *image.RGBA, rect image.Rectangle, c color.Color) {
+	draw.Draw(img, rect, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// drawText draws a line of text with its baseline at y.
+func drawText(img *image.RGBA, face font.Face, c color.Color, x, y int, text string) {
+	drawer := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	drawer.DrawString(text)
+}
+
+// wrap splits text into at most maxLines lines which fit in width pixels. Words which are wider than a line are
+// split, and the last line ends with an ellipsis if the text does not fit. It returns at least one line.
+func wrap(face font.Face, text string, width int, maxLines int) []string {
+	maxWidth := fixed.I(width)
+	fits := func(s string) bool { return font.MeasureString(face, s) <= maxWidth }
+
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if fits(candidate) {
+			line = candidate
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+		// Split the words which do not fit on a line of their own.
+		for !fits(word) {
+			cut := len(word)
+			for cut > 0 && !fits(word[:cut]) {
+				_, size := utf8.DecodeLastRuneInString(word[:cut])
+				cut -= size
+			}
+			if cut == 0 {
+				// Not even a single character fits.
+				_, cut = utf8.DecodeRuneInString(word)
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+
+	if len(lines) <= maxLines {
+		return lines
+	}
+
+	lines = lines[:maxLines]
+	last := lines[maxLines-1]
+	for last != "" && !fits(last+ellipsis) {
+		_, size := utf8.DecodeLastRuneInString(last)
+		last = last[:len(last)-size]
+	}
+	lines[maxLines-1] = strings.TrimRight(last, " ") + ellipsis
+	return lines
+}
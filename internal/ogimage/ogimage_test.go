@@ -0,0 +1,94 @@
+package ogimage_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/ogimage"
+)
+
+func decode(t *testing.T, data []byte) image.Image {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	return img
+}
+
+func TestRender(t *testing.T) {
+	r := ogimage.NewRenderer(10)
+
+	data, err := r.Render(ogimage.Card{Title: "Go Generics", Author: "Gopher Bot", ReadingMinutes: 6})
+	require.NoError(t, err)
+
+	img := decode(t, data)
+	require.Equal(t, image.Rect(0, 0, ogimage.Width, ogimage.Height), img.Bounds())
+}
+
+func TestRender_LongText(t *testing.T) {
+	r := ogimage.NewRenderer(10)
+
+	cards := []ogimage.Card{
+		{Title: strings.Repeat("A very long title ", 40), Author: strings.Repeat("Name ", 60), ReadingMinutes: 42},
+		{Title: strings.Repeat("x", 500)},
+		{Title: "Tiếng Việt, Ελληνικά, Русский"},
+		{},
+	}
+	for _, card := range cards {
+		data, err := r.Render(card)
+		require.NoError(t, err)
+		decode(t, data)
+	}
+}
+
+func TestRender_DifferentCards(t *testing.T) {
+	r := ogimage.NewRenderer(10)
+
+	first, err := r.Render(ogimage.Card{Title: "First", ReadingMinutes: 1})
+	require.NoError(t, err)
+	second, err := r.Render(ogimage.Card{Title: "Second", ReadingMinutes: 1})
+	require.NoError(t, err)
+
+	require.NotEqual(t, first, second)
+}
+
+func TestRender_Cache(t *testing.T) {
+	r := ogimage.NewRenderer(2)
+	first := ogimage.Card{Title: "First"}
+	second := ogimage.Card{Title: "Second"}
+	third := ogimage.Card{Title: "Third"}
+
+	cached, err := r.Render(first)
+	require.NoError(t, err)
+	again, err := r.Render(first)
+	require.NoError(t, err)
+	require.Same(t, &cached[0], &again[0])
+
+	_, err = r.Render(second)
+	require.NoError(t, err)
+	_, err = r.Render(third)
+	require.NoError(t, err)
+
+	// The first card was the least recently used, so it has been evicted.
+	rendered, err := r.Render(first)
+	require.NoError(t, err)
+	require.NotSame(t, &cached[0], &rendered[0])
+	require.Equal(t, cached, rendered)
+}
+
+func TestRender_NoCache(t *testing.T) {
+	r := ogimage.NewRenderer(0)
+	card := ogimage.Card{Title: "Title"}
+
+	first, err := r.Render(card)
+	require.NoError(t, err)
+	second, err := r.Render(card)
+	require.NoError(t, err)
+
+	require.NotSame(t, &first[0], &second[0])
+	require.Equal(t, first, second)
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package publisher
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// NewMockArticleStore creates a new instance of MockArticleStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockArticleStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockArticleStore {
+	mock := &MockArticleStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockArticleStore is an autogenerated mock type for the ArticleStore type
+type MockArticleStore struct {
+	mock.Mock
+}
+
+type MockArticleStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockArticleStore) EXPECT() *MockArticleStore_Expecter {
+	return &MockArticleStore_Expecter{mock: &_m.Mock}
+}
+
+// PublishNextDueArticle provides a mock function for the type MockArticleStore
+func (_mock *MockArticleStore) PublishNextDueArticle(ctx context.Context) (*store.PublishedArticle, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishNextDueArticle")
+	}
+
+	var r0 *store.PublishedArticle
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*store.PublishedArticle, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *store.PublishedArticle); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.PublishedArticle)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockArticleStore_PublishNextDueArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishNextDueArticle'
+type MockArticleStore_PublishNextDueArticle_Call struct {
+	*mock.Call
+}
+
+// PublishNextDueArticle is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockArticleStore_Expecter) PublishNextDueArticle(ctx interface{}) *MockArticleStore_PublishNextDueArticle_Call {
+	return &MockArticleStore_PublishNextDueArticle_Call{Call: _e.mock.On("PublishNextDueArticle", ctx)}
+}
+
+func (_c *MockArticleStore_PublishNextDueArticle_Call) Run(run func(ctx context.Context)) *MockArticleStore_PublishNextDueArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockArticleStore_PublishNextDueArticle_Call) Return(publishedArticle *store.PublishedArticle, err error) *MockArticleStore_PublishNextDueArticle_Call {
+	_c.Call.Return(publishedArticle, err)
+	return _c
+}
+
+func (_c *MockArticleStore_PublishNextDueArticle_Call) RunAndReturn(run func(ctx context.Context) (*store.PublishedArticle, error)) *MockArticleStore_PublishNextDueArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
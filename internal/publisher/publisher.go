@@ -0,0 +1,114 @@
+// Package publisher publishes scheduled articles once their publish time has come.
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const otelScopeName = "github.com/tuananhlai/brevity-go/internal/publisher"
+
+type ArticleStore interface {
+	PublishNextDueArticle(ctx context.Context) (*store.PublishedArticle, error)
+}
+
+// Publisher polls the store for scheduled articles which are due and publishes them.
+// Several publishers can run at the same time, e.g. one in each replica of a service.
+type Publisher struct {
+	store    ArticleStore
+	interval time.Duration
+	logger   *slog.Logger
+	// publishedCounter counts the published articles.
+	publishedCounter metric.Int64Counter
+}
+
+// New creates a publisher which checks for due articles every interval.
+func New(store ArticleStore, interval time.Duration, logger *slog.Logger) (*Publisher, error) {
+	publishedCounter, err := otel.Meter(otelScopeName).Int64Counter("brevity.publisher.articles_published",
+		metric.WithDescription("The number of scheduled articles which have been published."),
+		metric.WithUnit("{article}"))
+	if err != nil {
+		return nil, fmt.Errorf("error creating published articles counter: %w", err)
+	}
+
+	return &Publisher{
+		store:            store,
+		interval:         interval,
+		logger:           logger,
+		publishedCounter: publishedCounter,
+	}, nil
+}
+
+// Run publishes due articles every interval until ctx is canceled.
+func (p *Publisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := p.PublishDue(ctx)
+		if err != nil {
+			p.logger.ErrorContext(ctx, "failed to publish due articles", "error", err)
+		} else if published > 0 {
+			p.logger.InfoContext(ctx, "published due articles", "count", published)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDue publishes all scheduled articles which are due, one at a time, and returns how many were published.
+func (p *Publisher) PublishDue(ctx context.Context) (int, error) {
+	var published int
+	for ctx.Err() == nil {
+		ok, err := p.publishNext(ctx)
+		if err != nil {
+			return published, err
+		}
+		if !ok {
+			break
+		}
+		published++
+	}
+
+	return published, nil
+}
+
+// publishNext publishes the next due article. It reports whether there was an article to publish.
+func (p *Publisher) publishNext(ctx context.Context) (bool, error) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ctx, "Publisher.PublishArticle")
+	defer span.End()
+
+	article, err := p.store.PublishNextDueArticle(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+	if article == nil {
+		span.SetAttributes(attribute.Bool("published", false))
+		return false, nil
+	}
+
+	span.SetAttributes(
+		attribute.Bool("published", true),
+		attribute.String("article.id", article.ID.String()),
+		attribute.String("article.slug", article.Slug),
+		attribute.String("article.published_at", article.PublishedAt.Format(time.RFC3339)),
+	)
+	p.publishedCounter.Add(ctx, 1)
+
+	return true, nil
+}
@@ -0,0 +1,97 @@
+package publisher_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/tuananhlai/brevity-go/internal/publisher"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestPublisher(t *testing.T) {
+	suite.Run(t, new(PublisherTestSuite))
+}
+
+type PublisherTestSuite struct {
+	suite.Suite
+	publisher    *publisher.Publisher
+	mockStore    *publisher.MockArticleStore
+	metricReader *sdkmetric.ManualReader
+}
+
+func (s *PublisherTestSuite) SetupTest() {
+	s.metricReader = sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(s.metricReader)))
+
+	s.mockStore = publisher.NewMockArticleStore(s.T())
+	var err error
+	s.publisher, err = publisher.New(s.mockStore, time.Minute, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.Require().NoError(err)
+}
+
+func (s *PublisherTestSuite) TestPublishDue_PublishesUntilNoneDue() {
+	ctx := context.Background()
+	for _, slug := range []string{"first", "second"} {
+		s.mockStore.On("PublishNextDueArticle", mock.Anything).Return(&store.PublishedArticle{
+			ID:          uuid.New(),
+			Slug:        slug,
+			PublishedAt: time.Now(),
+		}, nil).Once()
+	}
+	s.mockStore.On("PublishNextDueArticle", mock.Anything).Return(nil, nil).Once()
+
+	published, err := s.publisher.PublishDue(ctx)
+
+	s.Require().NoError(err)
+	s.Require().Equal(2, published)
+	s.Require().Equal(int64(2), s.publishedCount())
+}
+
+func (s *PublisherTestSuite) TestPublishDue_Error() {
+	ctx := context.Background()
+	s.mockStore.On("PublishNextDueArticle", mock.Anything).Return(&store.PublishedArticle{
+		ID:   uuid.New(),
+		Slug: "first",
+	}, nil).Once()
+	s.mockStore.On("PublishNextDueArticle", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+	published, err := s.publisher.PublishDue(ctx)
+
+	s.Require().Error(err)
+	s.Require().Equal(1, published)
+	s.Require().Equal(int64(1), s.publishedCount())
+}
+
+// publishedCount returns the value of the published articles counter.
+func (s *PublisherTestSuite) publishedCount() int64 {
+	var rm metricdata.ResourceMetrics
+	s.Require().NoError(s.metricReader.Collect(context.Background(), &rm))
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "brevity.publisher.articles_published" {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			s.Require().True(ok)
+			var total int64
+			for _, dp := range sum.DataPoints {
+				total += dp.Value
+			}
+			return total
+		}
+	}
+
+	return 0
+}
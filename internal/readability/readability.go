@@ -0,0 +1,90 @@
+// Package readability estimates how long and how hard an English text is to read.
+package readability
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// WordsPerMinute is the average silent reading speed of adults reading non-fiction.
+const WordsPerMinute = 238
+
+// Stats describes the length and difficulty of a text.
+type Stats struct {
+	WordCount int
+	// ReadingMinutes is the estimated time to read the text, rounded up to whole minutes.
+	// It is 0 only for texts without any words.
+	ReadingMinutes int
+	// FleschKincaidGrade is the Flesch-Kincaid grade level of the text: roughly the number of years of
+	// education needed to understand it. Lower is easier to read.
+	FleschKincaidGrade float64
+}
+
+// Analyze computes the stats of a plaintext document.
+//
+// example:
+//
+//	Analyze("The cat sat on the mat.") // Stats{WordCount: 6, ReadingMinutes: 1, FleschKincaidGrade: -1.45}
+func Analyze(text string) Stats {
+	var words, syllables int
+	for _, field := range strings.Fields(text) {
+		word := strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if word == "" {
+			continue
+		}
+		words++
+		syllables += countSyllables(word)
+	}
+	if words == 0 {
+		return Stats{}
+	}
+
+	sentences := max(countSentences(text), 1)
+	grade := 0.39*float64(words)/float64(sentences) + 11.8*float64(syllables)/float64(words) - 15.59
+
+	return Stats{
+		WordCount:          words,
+		ReadingMinutes:     (words + WordsPerMinute - 1) / WordsPerMinute,
+		FleschKincaidGrade: math.Round(grade*100) / 100,
+	}
+}
+
+// countSentences counts the runs of sentence-ending punctuation in text.
+func countSentences(text string) int {
+	var sentences int
+	inTerminator := false
+	for _, r := range text {
+		isTerminator := r == '.' || r == '!' || r == '?'
+		if isTerminator && !inTerminator {
+			sentences++
+		}
+		inTerminator = isTerminator
+	}
+	return sentences
+}
+
+// countSyllables estimates the number of syllables in an English word by counting its groups of vowels.
+// Words without letters, such as numbers, count as one syllable.
+func countSyllables(word string) int {
+	word = strings.ToLower(word)
+
+	var syllables int
+	inVowelGroup := false
+	for _, r := range word {
+		isVowel := strings.ContainsRune("aeiouy", r)
+		if isVowel && !inVowelGroup {
+			syllables++
+		}
+		inVowelGroup = isVowel
+	}
+
+	// A trailing "e" is usually silent, as in "make", but not in "table".
+	if syllables > 1 && strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") {
+		syllables--
+	}
+
+	return max(syllables, 1)
+}
@@ -0,0 +1,50 @@
+package readability_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/readability"
+)
+
+func TestAnalyze(t *testing.T) {
+	stats := readability.Analyze("The cat sat on the mat.")
+
+	require.Equal(t, readability.Stats{
+		WordCount:          6,
+		ReadingMinutes:     1,
+		FleschKincaidGrade: -1.45,
+	}, stats)
+}
+
+func TestAnalyze_HarderTextHasHigherGrade(t *testing.T) {
+	easy := readability.Analyze("I like dogs. Dogs like me. We play all day.")
+	hard := readability.Analyze("Comprehensive organizational restructuring necessitates considerable " +
+		"administrative coordination between interdependent departments.")
+
+	require.Greater(t, hard.FleschKincaidGrade, easy.FleschKincaidGrade)
+}
+
+func TestAnalyze_ReadingMinutes(t *testing.T) {
+	testCases := []struct {
+		words    int
+		expected int
+	}{
+		{words: 1, expected: 1},
+		{words: readability.WordsPerMinute, expected: 1},
+		{words: readability.WordsPerMinute + 1, expected: 2},
+		{words: 10 * readability.WordsPerMinute, expected: 10},
+	}
+
+	for _, tc := range testCases {
+		stats := readability.Analyze(strings.Repeat("word ", tc.words))
+		require.Equal(t, tc.words, stats.WordCount)
+		require.Equal(t, tc.expected, stats.ReadingMinutes, "words %d", tc.words)
+	}
+}
+
+func TestAnalyze_Empty(t *testing.T) {
+	require.Equal(t, readability.Stats{}, readability.Analyze(" -- \n * "))
+}
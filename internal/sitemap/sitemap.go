@@ -0,0 +1,110 @@
+// Package sitemap renders the sitemaps and the robots.txt file which tell search engines what to index.
+// See https://www.sitemaps.org/protocol.html.
+package sitemap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxURLs is the maximum number of URLs in a sitemap. Larger sitemaps must be split, and listed in
+// a sitemap index.
+const MaxURLs = 50000
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URL is a page in a sitemap.
+type URL struct {
+	// Loc is the absolute URL of the page.
+	Loc string
+	// LastMod is the last time the content of the page changed. It is left out if zero.
+	LastMod time.Time
+}
+
+// Sitemap is a sitemap in a sitemap index.
+type Sitemap struct {
+	// Loc is the absolute URL of the sitemap.
+	Loc string
+	// LastMod is the last time any page in the sitemap changed. It is left out if zero.
+	LastMod time.Time
+}
+
+// URLSet renders a sitemap of the given pages. There must be at most MaxURLs pages.
+func URLSet(urls []URL) ([]byte, error) {
+	if len(urls) > MaxURLs {
+		return nil, fmt.Errorf("sitemap has %d URLs, more than the maximum of %d", len(urls), MaxURLs)
+	}
+
+	doc := urlSet{NS: namespace, URLs: make([]urlElement, len(urls))}
+	for i, url := range urls {
+		doc.URLs[i] = urlElement{Loc: url.Loc, LastMod: formatLastMod(url.LastMod)}
+	}
+
+	return marshal(doc)
+}
+
+// Index renders a sitemap index of the given sitemaps. There must be at most MaxURLs sitemaps.
+func Index(sitemaps []Sitemap) ([]byte, error) {
+	if len(sitemaps) > MaxURLs {
+		return nil, fmt.Errorf("sitemap index has %d sitemaps, more than the maximum of %d", len(sitemaps), MaxURLs)
+	}
+
+	doc := sitemapIndex{NS: namespace, Sitemaps: make([]sitemapElement, len(sitemaps))}
+	for i, sitemap := range sitemaps {
+		doc.Sitemaps[i] = sitemapElement{Loc: sitemap.Loc, LastMod: formatLastMod(sitemap.LastMod)}
+	}
+
+	return marshal(doc)
+}
+
+// Robots renders a robots.txt file which allows all crawlers to index the whole website, and points them to
+// the sitemap at the given URL.
+func Robots(sitemapURL string) []byte {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	b.WriteString("Allow: /\n")
+	b.WriteString("\n")
+	b.WriteString("Sitemap: " + sitemapURL + "\n")
+	return []byte(b.String())
+}
+
+// formatLastMod formats the time in the W3C Datetime format, or returns an empty string if it is zero.
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func marshal(doc any) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sitemap: %w", err)
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	NS      string       `xml:"xmlns,attr"`
+	URLs    []urlElement `xml:"url"`
+}
+
+type urlElement struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name         `xml:"sitemapindex"`
+	NS       string           `xml:"xmlns,attr"`
+	Sitemaps []sitemapElement `xml:"sitemap"`
+}
+
+type sitemapElement struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
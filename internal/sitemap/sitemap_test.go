@@ -0,0 +1,61 @@
+package sitemap_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/sitemap"
+)
+
+func TestURLSet(t *testing.T) {
+	body, err := sitemap.URLSet([]sitemap.URL{
+		{Loc: "https://brevity.example.com/articles/a&b", LastMod: time.Date(2026, 10, 1, 16, 0, 0, 0, time.FixedZone("ICT", 7*60*60))},
+		{Loc: "https://brevity.example.com/digital-authors/1"},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+		URLs    []struct {
+			Loc     string  `xml:"loc"`
+			LastMod *string `xml:"lastmod"`
+		} `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	require.Len(t, doc.URLs, 2)
+	require.Equal(t, "https://brevity.example.com/articles/a&b", doc.URLs[0].Loc)
+	require.Equal(t, "2026-10-01T09:00:00Z", *doc.URLs[0].LastMod)
+	// lastmod is optional, and left out if unknown.
+	require.Nil(t, doc.URLs[1].LastMod)
+}
+
+func TestURLSet_TooManyURLs(t *testing.T) {
+	_, err := sitemap.URLSet(make([]sitemap.URL, sitemap.MaxURLs+1))
+	require.Error(t, err)
+}
+
+func TestIndex(t *testing.T) {
+	body, err := sitemap.Index([]sitemap.Sitemap{
+		{Loc: "https://brevity.example.com/sitemaps/1.xml"},
+		{Loc: "https://brevity.example.com/sitemaps/2.xml"},
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+		Sitemaps []struct {
+			Loc string `xml:"loc"`
+		} `xml:"sitemap"`
+	}
+	require.NoError(t, xml.Unmarshal(body, &doc))
+	require.Len(t, doc.Sitemaps, 2)
+	require.Equal(t, "https://brevity.example.com/sitemaps/2.xml", doc.Sitemaps[1].Loc)
+}
+
+func TestRobots(t *testing.T) {
+	require.Equal(t, "User-agent: *\nAllow: /\n\nSitemap: https://brevity.example.com/sitemap.xml\n",
+		string(sitemap.Robots("https://brevity.example.com/sitemap.xml")))
+}
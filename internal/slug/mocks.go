@@ -0,0 +1,104 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package slug
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockStore creates a new instance of MockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStore {
+	mock := &MockStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStore is an autogenerated mock type for the Store type
+type MockStore struct {
+	mock.Mock
+}
+
+type MockStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStore) EXPECT() *MockStore_Expecter {
+	return &MockStore_Expecter{mock: &_m.Mock}
+}
+
+// ArticleSlugExists provides a mock function for the type MockStore
+func (_mock *MockStore) ArticleSlugExists(ctx context.Context, slug string) (bool, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArticleSlugExists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ArticleSlugExists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArticleSlugExists'
+type MockStore_ArticleSlugExists_Call struct {
+	*mock.Call
+}
+
+// ArticleSlugExists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockStore_Expecter) ArticleSlugExists(ctx interface{}, slug interface{}) *MockStore_ArticleSlugExists_Call {
+	return &MockStore_ArticleSlugExists_Call{Call: _e.mock.On("ArticleSlugExists", ctx, slug)}
+}
+
+func (_c *MockStore_ArticleSlugExists_Call) Run(run func(ctx context.Context, slug string)) *MockStore_ArticleSlugExists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ArticleSlugExists_Call) Return(b bool, err error) *MockStore_ArticleSlugExists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStore_ArticleSlugExists_Call) RunAndReturn(run func(ctx context.Context, slug string) (bool, error)) *MockStore_ArticleSlugExists_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,82 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+)
+
+const (
+	// MaxLength is the maximum length of the slugs created by Service, including the collision suffix.
+	MaxLength = 100
+	// fallbackSlug is used for texts without any letters or digits which can be written in ASCII.
+	fallbackSlug = "article"
+
+	suffixAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	suffixLength   = 4
+	// maxAttempts is the number of suffixes tried before giving up. With 36^4 possible suffixes,
+	// running out of attempts is practically impossible.
+	maxAttempts = 10
+)
+
+// ErrNoAvailableSlug is returned when no unused slug could be found for a text.
+var ErrNoAvailableSlug = errors.New("no available slug")
+
+// Store checks which slugs are in use.
+type Store interface {
+	// ArticleSlugExists reports whether the slug is used by an article, either currently or in the past.
+	ArticleSlugExists(ctx context.Context, slug string) (bool, error)
+}
+
+// Service creates unique slugs for articles.
+type Service struct {
+	store Store
+}
+
+func NewService(store Store) *Service {
+	return &Service{store: store}
+}
+
+// Generate converts text into a slug with NormalizeASCII. If the slug is already in use, a short random suffix
+// is appended to it, e.g. "go-concurrency-x7k2".
+// A slug which is free when Generate returns can still be taken by a concurrent writer before it is used,
+// so callers must handle unique constraint violations.
+func (s *Service) Generate(ctx context.Context, text string) (string, error) {
+	base := NormalizeASCII(text, MaxLength)
+	if base == "" {
+		base = fallbackSlug
+	}
+
+	exists, err := s.store.ArticleSlugExists(ctx, base)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return base, nil
+	}
+
+	// Leave room for the suffix and its hyphen.
+	base = truncate(base, MaxLength-suffixLength-1)
+	for range maxAttempts {
+		candidate := base + "-" + randomSuffix()
+
+		exists, err := s.store.ArticleSlugExists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w for %q after %d attempts", ErrNoAvailableSlug, base, maxAttempts)
+}
+
+func randomSuffix() string {
+	suffix := make([]byte, suffixLength)
+	for i := range suffix {
+		suffix[i] = suffixAlphabet[rand.IntN(len(suffixAlphabet))]
+	}
+	return string(suffix)
+}
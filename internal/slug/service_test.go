@@ -0,0 +1,85 @@
+package slug_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/slug"
+)
+
+func TestService(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+	service   *slug.Service
+	mockStore *slug.MockStore
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockStore = slug.NewMockStore(s.T())
+	s.service = slug.NewService(s.mockStore)
+}
+
+func (s *ServiceTestSuite) TestGenerate_Available() {
+	s.mockStore.On("ArticleSlugExists", mock.Anything, "cafe-culture").Return(false, nil).Once()
+
+	result, err := s.service.Generate(context.Background(), "Café Culture")
+
+	s.Require().NoError(err)
+	s.Require().Equal("cafe-culture", result)
+}
+
+func (s *ServiceTestSuite) TestGenerate_Collision() {
+	s.mockStore.On("ArticleSlugExists", mock.Anything, "cafe-culture").Return(true, nil).Once()
+	s.mockStore.On("ArticleSlugExists", mock.Anything, mock.Anything).Return(true, nil).Once()
+	s.mockStore.On("ArticleSlugExists", mock.Anything, mock.Anything).Return(false, nil).Once()
+
+	result, err := s.service.Generate(context.Background(), "Café Culture")
+
+	s.Require().NoError(err)
+	s.Require().Regexp(`^cafe-culture-[a-z0-9]{4}$`, result)
+}
+
+func (s *ServiceTestSuite) TestGenerate_LongTextWithCollision() {
+	text := strings.Repeat("word ", 50)
+	s.mockStore.On("ArticleSlugExists", mock.Anything, mock.Anything).Return(true, nil).Once()
+	s.mockStore.On("ArticleSlugExists", mock.Anything, mock.Anything).Return(false, nil).Once()
+
+	result, err := s.service.Generate(context.Background(), text)
+
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(len(result), slug.MaxLength)
+	s.Require().Regexp(`^(word-)+[a-z0-9]{4}$`, result)
+}
+
+func (s *ServiceTestSuite) TestGenerate_Fallback() {
+	s.mockStore.On("ArticleSlugExists", mock.Anything, "article").Return(false, nil).Once()
+
+	result, err := s.service.Generate(context.Background(), "日本語")
+
+	s.Require().NoError(err)
+	s.Require().Equal("article", result)
+}
+
+func (s *ServiceTestSuite) TestGenerate_NoAvailableSlug() {
+	s.mockStore.On("ArticleSlugExists", mock.Anything, mock.Anything).Return(true, nil)
+
+	_, err := s.service.Generate(context.Background(), "Taken")
+
+	s.Require().ErrorIs(err, slug.ErrNoAvailableSlug)
+}
+
+func (s *ServiceTestSuite) TestGenerate_StoreError() {
+	s.mockStore.On("ArticleSlugExists", mock.Anything, "taken").Return(false, errors.New("connection refused")).Once()
+
+	_, err := s.service.Generate(context.Background(), "Taken")
+
+	s.Require().Error(err)
+}
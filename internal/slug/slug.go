@@ -0,0 +1,77 @@
+// Package slug creates URL-friendly identifiers from human-readable text.
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize converts s into a slug: a lowercase string of letters and digits separated by single hyphens.
+//
+// example:
+//
+//	Normalize("  Go: Concurrency Patterns! ") // "go-concurrency-patterns"
+func Normalize(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	return b.String()
+}
+
+// foldedLetters are the ASCII replacements of letters which are not decomposed into a base letter and
+// diacritics by Unicode normalization.
+var foldedLetters = map[rune]string{
+	'đ': "d", 'ð': "d", 'ł': "l", 'ø': "o", 'ß': "ss", 'æ': "ae", 'œ': "oe", 'þ': "th", 'ı': "i",
+}
+
+// NormalizeASCII is like Normalize, but the slug only contains ASCII characters: letters with diacritics are
+// replaced by their base letter and other characters are treated as separators. The slug is cut at a hyphen
+// so that it is at most maxLength bytes long.
+//
+// example:
+//
+//	NormalizeASCII("Tiếng Việt: Đọc & Viết", 100) // "tieng-viet-doc-viet"
+func NormalizeASCII(s string, maxLength int) string {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(strings.ToLower(s)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop the diacritics left by the decomposition.
+		case r < unicode.MaxASCII:
+			b.WriteRune(r)
+		case foldedLetters[r] != "":
+			b.WriteString(foldedLetters[r])
+		default:
+			b.WriteByte(' ')
+		}
+	}
+
+	return truncate(Normalize(b.String()), maxLength)
+}
+
+// truncate cuts slug at a hyphen so that it is at most maxLength bytes long. A single word which is too long
+// is cut in the middle.
+func truncate(slug string, maxLength int) string {
+	if len(slug) <= maxLength {
+		return slug
+	}
+
+	if i := strings.LastIndexByte(slug[:maxLength+1], '-'); i > 0 {
+		return slug[:i]
+	}
+	return slug[:maxLength]
+}
@@ -0,0 +1,49 @@
+package slug_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/slug"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "Go", expected: "go"},
+		{input: "  Go: Concurrency Patterns! ", expected: "go-concurrency-patterns"},
+		{input: "already-a-slug", expected: "already-a-slug"},
+		{input: "multiple   spaces---and__underscores", expected: "multiple-spaces-and-underscores"},
+		{input: "C++ & Rust", expected: "c-rust"},
+		{input: "!!!", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, slug.Normalize(tc.input), "input %q", tc.input)
+	}
+}
+
+func TestNormalizeASCII(t *testing.T) {
+	testCases := []struct {
+		input     string
+		maxLength int
+		expected  string
+	}{
+		{input: "  Go: Concurrency Patterns! ", maxLength: 100, expected: "go-concurrency-patterns"},
+		{input: "Tiếng Việt: Đọc & Viết", maxLength: 100, expected: "tieng-viet-doc-viet"},
+		{input: "Crème Brûlée für Ærø", maxLength: 100, expected: "creme-brulee-fur-aero"},
+		{input: "Straße", maxLength: 100, expected: "strasse"},
+		{input: "Go 日本語 guide", maxLength: 100, expected: "go-guide"},
+		{input: "日本語", maxLength: 100, expected: ""},
+		{input: "one two three", maxLength: 9, expected: "one-two"},
+		{input: "one two three", maxLength: 7, expected: "one-two"},
+		{input: "supercalifragilistic", maxLength: 5, expected: "super"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, slug.NormalizeASCII(tc.input, tc.maxLength), "input %q", tc.input)
+	}
+}
@@ -5,32 +5,217 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/tuananhlai/brevity-go/internal/readability"
 )
 
-// CreateArticle creates a new article
+// articleTagsColumn selects the tags of article "a" as a JSON array, which can be scanned into a TagList.
+const articleTagsColumn = `COALESCE((
+	SELECT json_agg(json_build_object('id', t.id, 'slug', t.slug, 'name', t.name) ORDER BY t.name)
+	FROM article_tags at INNER JOIN tags t ON t.id = at.tag_id
+	WHERE at.article_id = a.id), '[]') AS tags`
+
+// articlePreviewColumns are the columns scanned into an ArticlePreview. Queries using them must select
+// from articles "a" joined with digital_authors "da".
+var articlePreviewColumns = []string{
+	"a.id", "a.slug", "a.title", "a.description", "a.author_id", "a.status", "a.published_at",
+	"a.word_count", "a.reading_minutes", "a.readability_grade", "a.created_at", "a.updated_at",
+	"da.display_name AS author_display_name", articleTagsColumn, articleClapCountColumn,
+}
+
+// articleViewerColumns selects the state of article "a" for the given user: the number of claps they gave
+// to the article and whether they bookmarked it. The columns are zero if viewerID is uuid.Nil.
+func articleViewerColumns(viewerID uuid.UUID) sq.Sqlizer {
+	return sq.Expr(`COALESCE((
+		SELECT vc.count FROM article_claps vc WHERE vc.article_id = a.id AND vc.user_id = ?), 0) AS viewer_clap_count,
+		EXISTS (SELECT 1 FROM bookmarks vb WHERE vb.article_id = a.id AND vb.user_id = ?) AS viewer_bookmarked`,
+		viewerID, viewerID)
+}
+
+// articleIsPublic is the condition for article "a" to be visible to the public.
+var articleIsPublic = articleIsPublicAs("a")
+
+// articleIsPublicAs is the condition for the article with the given table alias to be visible to the public.
+// Articles which are deleted or taken down by a moderator are hidden, whatever their status.
+func articleIsPublicAs(alias string) string {
+	return alias + ".status = 'published' AND " + alias + ".deleted_at IS NULL AND " + alias + ".taken_down_at IS NULL"
+}
+
+// articleSeriesColumns are the columns scanned into an ArticleSeriesInfo. Queries using them must select
+// from articles "a" joined by joinArticleSeries.
+var articleSeriesColumns = []string{
+	"a.series_id", "s.title AS series_title", "a.series_position",
+	"prev.slug AS previous_slug", "prev.title AS previous_title", "next.slug AS next_slug", "next.title AS next_title",
+}
+
+// joinArticleSeries joins article "a" with its series "s" and the published parts right before ("prev")
+// and after ("next") it, so that articleSeriesColumns can be selected.
+func joinArticleSeries(builder sq.SelectBuilder) sq.SelectBuilder {
+	return builder.
+		LeftJoin("series s ON s.id = a.series_id").
+		LeftJoin(`LATERAL (
+			SELECT p.slug, p.title FROM articles p
+			WHERE p.series_id = a.series_id AND p.series_position < a.series_position AND ` + articleIsPublicAs("p") + `
+			ORDER BY p.series_position DESC LIMIT 1) prev ON true`).
+		LeftJoin(`LATERAL (
+			SELECT n.slug, n.title FROM articles n
+			WHERE n.series_id = a.series_id AND n.series_position > a.series_position AND ` + articleIsPublicAs("n") + `
+			ORDER BY n.series_position LIMIT 1) next ON true`)
+}
+
+// CreateArticle creates a new article along with its tags and its first revision. If article.ContentFormat is
+// empty, the content is assumed to be Markdown. If article.Status is empty, the article is created as a draft.
+// Published articles without article.PublishedAt are published immediately. Articles which should be published
+// in the future must be created as ArticleStatusScheduled. ErrArticleSlugTaken is returned if article.Slug is
+// used by another article, see slug.Service to generate unique slugs. If article.SeriesID is set, the article
+// is appended to the series.
+// The reading stats of the article are computed from article.PlaintextContent.
+// The ID, status, reading stats and timestamps of the created article are set on article.
 func (p *Store) CreateArticle(ctx context.Context, article *Article) error {
+	contentFormat := article.ContentFormat
+	if contentFormat == "" {
+		contentFormat = ContentFormatMarkdown
+	}
+	status := article.Status
+	if status == "" {
+		status = ArticleStatusDraft
+	}
+	var publishedAt any = article.PublishedAt
+	if status == ArticleStatusPublished && !article.PublishedAt.Valid {
+		publishedAt = sq.Expr("CURRENT_TIMESTAMP")
+	}
+	stats := readability.Analyze(article.PlaintextContent)
+	var seriesPosition any
+	if article.SeriesID.Valid {
+		seriesPosition = sq.Expr("("+nextSeriesPositionQuery+")", article.SeriesID)
+	}
+
 	query, args, err := p.qb.
 		Insert("articles").
-		Columns("slug", "title", "description", "plaintext_content", "content", "content_format", "author_id").
+		Columns("slug", "title", "description", "plaintext_content", "content", "content_format",
+			"html_content", "author_id", "status", "published_at", "word_count", "reading_minutes", "readability_grade",
+			"series_id", "series_position").
 		Values(article.Slug, article.Title, article.Description, article.PlaintextContent,
-			article.Content, "text/markdown", article.AuthorID).
+			article.Content, contentFormat, article.HTMLContent, article.AuthorID, status, publishedAt,
+			stats.WordCount, stats.ReadingMinutes, stats.FleschKincaidGrade, article.SeriesID, seriesPosition).
+		Suffix("RETURNING id, content_format, status, published_at, word_count, reading_minutes, readability_grade, " +
+			"series_id, series_position, created_at, updated_at").
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build query: %w", err)
 	}
 
-	_, err = p.db.ExecContext(ctx, query, args...)
-	return err
+	return p.withTx(ctx, func(tx *sqlx.Tx) error {
+		if err := tx.GetContext(ctx, article, query, args...); err != nil {
+			if isArticleSlugViolation(err) {
+				return ErrArticleSlugTaken
+			}
+			return err
+		}
+
+		if err := setArticleTags(ctx, tx, article.ID, article.Tags); err != nil {
+			return err
+		}
+
+		return createArticleRevision(ctx, tx, article.ID)
+	})
 }
 
-// GetArticleBySlug retrieves a single article by its slug
-func (p *Store) GetArticleBySlug(ctx context.Context, slug string) (*ArticleDetails, error) {
+// UpdateArticle replaces the content of an article, and records the new content as a revision of the article.
+// The reading stats of the article are computed again from params.PlaintextContent.
+func (p *Store) UpdateArticle(ctx context.Context, params UpdateArticleParams) error {
+	stats := readability.Analyze(params.PlaintextContent)
 	query, args, err := p.qb.
-		Select("a.id", "a.slug", "a.title", "a.content", "a.author_id",
-			"a.created_at", "a.updated_at", "da.display_name AS author_display_name").
+		Update("articles").
+		Set("title", params.Title).
+		Set("description", params.Description).
+		Set("content", params.Content).
+		Set("content_format", params.ContentFormat).
+		Set("html_content", params.HTMLContent).
+		Set("plaintext_content", params.PlaintextContent).
+		Set("word_count", stats.WordCount).
+		Set("reading_minutes", stats.ReadingMinutes).
+		Set("readability_grade", stats.FleschKincaidGrade).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": params.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	return p.withTx(ctx, func(tx *sqlx.Tx) error {
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return ErrArticleNotFound
+		}
+
+		if params.Tags != nil {
+			if err := replaceArticleTags(ctx, tx, params.ID, params.Tags); err != nil {
+				return err
+			}
+		}
+
+		return createArticleRevision(ctx, tx, params.ID)
+	})
+}
+
+type UpdateArticleParams struct {
+	ID               uuid.UUID
+	Title            string
+	Description      string
+	Content          string
+	ContentFormat    ContentFormat
+	HTMLContent      string
+	PlaintextContent string
+	// Tags are the names of the tags of the article. If not nil, they replace the current tags of the article.
+	Tags []string
+}
+
+// GetArticleBySlug retrieves a single published article by its slug. viewerID is the ID of the user who reads
+// the article, or uuid.Nil for anonymous readers.
+func (p *Store) GetArticleBySlug(ctx context.Context, slug string, viewerID uuid.UUID) (*ArticleDetails, error) {
+	return p.getArticleDetails(ctx, viewerID, sq.And{
+		sq.Eq{"a.slug": slug},
+		sq.Expr(articleIsPublic),
+	})
+}
+
+// GetOwnedArticleBySlug retrieves a single article in any status by its slug. The article must be written by
+// a digital author owned by the given user, and must not be deleted.
+func (p *Store) GetOwnedArticleBySlug(ctx context.Context, ownerID uuid.UUID, slug string) (*ArticleDetails, error) {
+	return p.getArticleDetails(ctx, ownerID, sq.Eq{
+		"a.slug":       slug,
+		"da.owner_id":  ownerID,
+		"a.deleted_at": nil,
+	})
+}
+
+func (p *Store) getArticleDetails(ctx context.Context, viewerID uuid.UUID, where sq.Sqlizer) (*ArticleDetails, error) {
+	builder := p.qb.
+		Select("a.id", "a.slug", "a.title", "a.description", "a.content", "a.content_format", "a.html_content",
+			"a.author_id", "a.status", "a.published_at", "a.word_count", "a.reading_minutes", "a.readability_grade",
+			"a.created_at", "a.updated_at", "da.display_name AS author_display_name",
+			articleTagsColumn, articleClapCountColumn).
+		Column(articleViewerColumns(viewerID)).
+		Columns(articleSeriesColumns...).
 		From("articles a").
-		InnerJoin("digital_authors da ON a.author_id = da.id").
-		Where("a.slug = ?", slug).
+		InnerJoin("digital_authors da ON a.author_id = da.id")
+
+	query, args, err := joinArticleSeries(builder).
+		Where(where).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %w", err)
@@ -48,25 +233,351 @@ func (p *Store) GetArticleBySlug(ctx context.Context, slug string) (*ArticleDeta
 	return &article, nil
 }
 
-// ListArticlesPreviews lists articles with basic information
-func (p *Store) ListArticlesPreviews(ctx context.Context) ([]ArticlePreview, error) {
-	articles := []ArticlePreview{}
+// ListArticleSources returns the source content of all articles.
+func (p *Store) ListArticleSources(ctx context.Context) ([]ArticleSource, error) {
+	sources := []ArticleSource{}
 
-	query, _, err := p.qb.
-		Select("a.id", "a.slug", "a.title", "a.description", "a.author_id",
-			"a.created_at", "a.updated_at", "da.display_name AS author_display_name").
+	query, args, err := p.qb.
+		Select("id", "content", "content_format").
+		From("articles").
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &sources, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return sources, nil
+}
+
+// GetArticleSourceBySlug returns the source content of the article with the given slug, in any status, along
+// with its author, title, description and tags. Deleted articles are returned too, since their slug cannot be
+// reused.
+func (p *Store) GetArticleSourceBySlug(ctx context.Context, slug string) (*ArticleSource, error) {
+	query, args, err := p.qb.
+		Select("a.id", "a.content", "a.content_format", "a.author_id", "a.title", "a.description", articleTagsColumn,
+			"a.deleted_at").
 		From("articles a").
-		InnerJoin("digital_authors da ON a.author_id = da.id").
-		OrderBy("a.created_at DESC").
+		Where(sq.Eq{"a.slug": slug}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	var source ArticleSource
+	err = p.db.GetContext(ctx, &source, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrArticleNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &source, nil
+}
+
+// ListArticlesHTMLContent returns the HTML content of the public articles with the given IDs, keyed by ID.
+// Articles which are not public are left out.
+func (p *Store) ListArticlesHTMLContent(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	contents := map[uuid.UUID]string{}
+	if len(ids) == 0 {
+		return contents, nil
+	}
+
+	query, args, err := p.qb.
+		Select("a.id", "a.html_content").
+		From("articles a").
+		Where(sq.Eq{"a.id": ids}).
+		Where(articleIsPublic).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	var rows []struct {
+		ID          uuid.UUID `db:"id"`
+		HTMLContent string    `db:"html_content"`
+	}
+	err = p.db.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	for _, row := range rows {
+		contents[row.ID] = row.HTMLContent
+	}
+
+	return contents, nil
+}
+
+// GetArticlesLastUpdatedAt returns the latest update time of the articles, of the given digital author if
+// authorID is not uuid.Nil. Hidden articles are included, since an article leaving a list of articles, e.g. when
+// it is deleted, archived or taken down, changes the list. The zero time is returned if there is no article.
+func (p *Store) GetArticlesLastUpdatedAt(ctx context.Context, authorID uuid.UUID) (time.Time, error) {
+	builder := p.qb.
+		Select("MAX(a.updated_at)").
+		From("articles a")
+	if authorID != uuid.Nil {
+		builder = builder.Where(sq.Eq{"a.author_id": authorID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var updatedAt sql.NullTime
+	err = p.db.GetContext(ctx, &updatedAt, query, args...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return updatedAt.Time, nil
+}
+
+// UpdateArticleRendering stores the representations derived from the source content of an article,
+// along with the reading stats computed from params.PlaintextContent. It reports whether the article was changed.
+func (p *Store) UpdateArticleRendering(ctx context.Context, params UpdateArticleRenderingParams) (bool, error) {
+	stats := readability.Analyze(params.PlaintextContent)
+	query, args, err := p.qb.
+		Update("articles").
+		Set("html_content", params.HTMLContent).
+		Set("plaintext_content", params.PlaintextContent).
+		Set("word_count", stats.WordCount).
+		Set("reading_minutes", stats.ReadingMinutes).
+		Set("readability_grade", stats.FleschKincaidGrade).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": params.ID}).
+		Where(`(html_content IS DISTINCT FROM ? OR plaintext_content IS DISTINCT FROM ?
+			OR word_count IS DISTINCT FROM ? OR reading_minutes IS DISTINCT FROM ?
+			OR readability_grade IS DISTINCT FROM ?)`,
+			params.HTMLContent, params.PlaintextContent,
+			stats.WordCount, stats.ReadingMinutes, stats.FleschKincaidGrade).
 		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	result, err := p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+type UpdateArticleRenderingParams struct {
+	ID               uuid.UUID
+	HTMLContent      string
+	PlaintextContent string
+}
+
+// articleListedAtExpr is the time by which article "a" is ordered in listings, see ArticlePreview.ListedAt.
+// Published articles are ordered by their publish time, so that articles which were approved or scheduled long
+// after their creation, or imported with an earlier date, appear at the time they became public.
+const articleListedAtExpr = "COALESCE(a.published_at, a.created_at)"
+
+// ListArticlesPreviews lists articles with basic information, most recently published first unless
+// params.OrderBy is set. Only published articles are listed, unless params.OwnerID is set.
+// Results are paginated with a keyset on the sort key, (publish time, id) by default: pass the last item of
+// the previous page as params.After to fetch the next page.
+func (p *Store) ListArticlesPreviews(ctx context.Context, params ListArticlesPreviewsParams) ([]ArticlePreview, error) {
+	articles := []ArticlePreview{}
+
+	builder := p.qb.
+		Select(articlePreviewColumns...).
+		Column(articleViewerColumns(params.ViewerID)).
+		From("articles a").
+		InnerJoin("digital_authors da ON a.author_id = da.id")
+
+	switch params.OrderBy {
+	case ArticleOrderMostClapped:
+		builder = builder.OrderBy("clap_count DESC", articleListedAtExpr+" DESC", "a.id DESC")
+		if params.After != nil {
+			builder = builder.Where("("+articleClapCountExpr+", "+articleListedAtExpr+", a.id) < (?, ?, ?)",
+				params.After.ClapCount, params.After.PublishedAt, params.After.ID)
+		}
+	case ArticleOrderTrending:
+		builder = builder.
+			Column(articleTrendingScoreExpr+" AS trending_score").
+			OrderBy("trending_score DESC", articleListedAtExpr+" DESC", "a.id DESC")
+		if params.After != nil {
+			builder = builder.Where("("+articleTrendingScoreExpr+", "+articleListedAtExpr+", a.id) < (?, ?, ?)",
+				params.After.TrendingScore, params.After.PublishedAt, params.After.ID)
+		}
+	default:
+		builder = builder.OrderBy(articleListedAtExpr+" DESC", "a.id DESC")
+		if params.After != nil {
+			builder = builder.Where("("+articleListedAtExpr+", a.id) < (?, ?)", params.After.PublishedAt, params.After.ID)
+		}
+	}
+
+	if params.OwnerID != uuid.Nil {
+		builder = builder.Where(sq.Eq{"da.owner_id": params.OwnerID, "a.deleted_at": nil})
+		if params.Status != "" {
+			builder = builder.Where(sq.Eq{"a.status": params.Status})
+		}
+	} else {
+		builder = builder.Where(articleIsPublic)
+	}
+	if params.TagSlug != "" {
+		builder = builder.Where(`EXISTS (
+			SELECT 1 FROM article_tags at INNER JOIN tags t ON t.id = at.tag_id
+			WHERE at.article_id = a.id AND t.slug = ?)`, params.TagSlug)
+	}
+	if params.MaxReadingMinutes > 0 {
+		builder = builder.Where(sq.LtOrEq{"a.reading_minutes": params.MaxReadingMinutes})
+	}
+	if params.FollowerID != uuid.Nil {
+		builder = builder.Where("a.author_id IN (SELECT f.author_id FROM follows f WHERE f.user_id = ?)", params.FollowerID)
+	}
+	if params.AuthorID != uuid.Nil {
+		builder = builder.Where(sq.Eq{"a.author_id": params.AuthorID})
+	}
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build SQL query: %w", err)
 	}
 
-	err = p.db.SelectContext(ctx, &articles, query)
+	err = p.db.SelectContext(ctx, &articles, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
 	}
 
 	return articles, nil
 }
+
+type ListArticlesPreviewsParams struct {
+	// OwnerID is an optional filter. If set, the articles of the digital authors owned by the user are returned
+	// in any status, instead of the published articles. Deleted articles are never returned.
+	OwnerID uuid.UUID
+	// Status is an optional filter on the status of the articles. It only applies if OwnerID is set.
+	Status ArticleStatus
+	// TagSlug is an optional filter. If set, only articles with the given tag are returned.
+	TagSlug string
+	// MaxReadingMinutes is an optional filter. If set, only articles which can be read within the given number
+	// of minutes are returned.
+	MaxReadingMinutes int
+	// FollowerID is an optional filter. If set, only articles of the digital authors followed by the user
+	// are returned.
+	FollowerID uuid.UUID
+	// AuthorID is an optional filter. If set, only articles of the given digital author are returned.
+	AuthorID uuid.UUID
+	// ViewerID is the ID of the user who lists the articles, or uuid.Nil for anonymous users. It is used to
+	// return the state of the articles for the user, such as their claps and bookmarks.
+	ViewerID uuid.UUID
+	// OrderBy is the order of the articles. It defaults to ArticleOrderNewest.
+	OrderBy ArticleOrder
+	// Limit is the maximum number of previews to return. No limit is applied if it is zero.
+	Limit int
+	// After is an optional cursor. If set, only articles that come after it in the listing order are returned.
+	After *ArticlePreviewCursor
+}
+
+// ArticleOrder is an order in which article previews can be listed.
+type ArticleOrder string
+
+const (
+	// ArticleOrderNewest lists the most recently published articles first.
+	ArticleOrderNewest ArticleOrder = "newest"
+	// ArticleOrderMostClapped lists the articles with the most claps first, then the newest first.
+	ArticleOrderMostClapped ArticleOrder = "most_clapped"
+	// ArticleOrderTrending lists the articles with the highest trending score first, then the newest first.
+	// Articles are only ranked once their score has been computed by RefreshTrendingScores.
+	ArticleOrderTrending ArticleOrder = "trending"
+)
+
+// ArticlePreviewCursor identifies a position in the article previews listing.
+type ArticlePreviewCursor struct {
+	// ClapCount is only used when ordering by ArticleOrderMostClapped.
+	ClapCount int
+	// TrendingScore is only used when ordering by ArticleOrderTrending.
+	TrendingScore float64
+	// PublishedAt is the ArticlePreview.ListedAt time of the article.
+	PublishedAt time.Time
+	ID          uuid.UUID
+}
+
+const (
+	// SearchHighlightStart and SearchHighlightStop enclose the matched terms in ArticleSearchResult.Highlight.
+	// They are characters from the Unicode private use area, so they never collide with article content.
+	SearchHighlightStart = "\uE000"
+	SearchHighlightStop  = "\uE001"
+
+	searchHeadlineOptions = "StartSel=" + SearchHighlightStart + ", StopSel=" + SearchHighlightStop +
+		`, MaxFragments=2, MinWords=10, MaxWords=30, FragmentDelimiter=" ... "`
+)
+
+// SearchArticles performs a full-text search over the title, description and plaintext content of articles.
+// Results are ordered by relevance and paginated with a keyset on (rank, id).
+func (p *Store) SearchArticles(ctx context.Context, params SearchArticlesParams) ([]ArticleSearchResult, error) {
+	results := []ArticleSearchResult{}
+
+	matches := p.qb.
+		Select(articlePreviewColumns...).
+		Column(articleViewerColumns(params.ViewerID)).
+		Column("ts_rank(a.search_vector, q.query) AS rank").
+		Column("ts_headline('english', COALESCE(NULLIF(a.plaintext_content, ''), a.description, ''), q.query, ?) AS highlight",
+			searchHeadlineOptions).
+		From("articles a").
+		CrossJoin("websearch_to_tsquery('english', ?) AS q(query)", params.Query).
+		InnerJoin("digital_authors da ON a.author_id = da.id").
+		Where("a.search_vector @@ q.query").
+		Where(articleIsPublic)
+
+	builder := p.qb.
+		Select("*").
+		FromSelect(matches, "r").
+		OrderBy("r.rank DESC", "r.id DESC")
+
+	if params.After != nil {
+		builder = builder.Where("(r.rank, r.id) < (?, ?)", params.After.Rank, params.After.ID)
+	}
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return results, nil
+}
+
+type SearchArticlesParams struct {
+	// Query is a search query in the format accepted by PostgreSQL's websearch_to_tsquery,
+	// e.g. `"go concurrency" -python`.
+	Query string
+	// ViewerID is the ID of the user who searches the articles, or uuid.Nil for anonymous users. It is used to
+	// return the state of the articles for the user, such as their claps and bookmarks.
+	ViewerID uuid.UUID
+	// Limit is the maximum number of results to return. No limit is applied if it is zero.
+	Limit int
+	// After is an optional cursor. If set, only results ranked after it are returned.
+	After *ArticleSearchCursor
+}
+
+// ArticleSearchCursor identifies a position in the search results.
+type ArticleSearchCursor struct {
+	Rank float64
+	ID   uuid.UUID
+}
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// MaxClapsPerUser is the maximum number of times a user can clap an article.
+const MaxClapsPerUser = 50
+
+// articleClapCountExpr is the total number of claps of article "a".
+const articleClapCountExpr = "COALESCE((SELECT SUM(ac.count) FROM article_claps ac WHERE ac.article_id = a.id), 0)"
+
+// articleClapCountColumn selects the total number of claps of article "a".
+const articleClapCountColumn = articleClapCountExpr + " AS clap_count"
+
+// ClapArticle adds claps of a user to a published article. The claps of each user are capped at MaxClapsPerUser,
+// extra claps are ignored. Concurrent claps of the same user are added atomically. The added claps are recorded
+// with their time, so that they decay from it in the trending scores.
+func (p *Store) ClapArticle(ctx context.Context, params ClapArticleParams) (*ArticleClaps, error) {
+	query, args, err := p.qb.
+		Select("upsert.count AS viewer_clap_count").
+		Column(`upsert.count + COALESCE((
+			SELECT SUM(ac.count) FROM article_claps ac
+			WHERE ac.article_id = upsert.article_id AND ac.user_id <> upsert.user_id), 0) AS clap_count`).
+		Prefix(`WITH upsert AS (
+			INSERT INTO article_claps (article_id, user_id, count)
+			SELECT a.id, ?::uuid, ?::integer FROM articles a WHERE a.slug = ? AND `+articleIsPublic+`
+			ON CONFLICT (article_id, user_id) DO UPDATE
+			SET count = LEAST(article_claps.count + EXCLUDED.count, ?), updated_at = CURRENT_TIMESTAMP
+			RETURNING article_id, user_id, count
+		)`, params.UserID, min(params.Count, MaxClapsPerUser), params.Slug, MaxClapsPerUser).
+		Column("upsert.article_id").
+		From("upsert").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var claps ArticleClaps
+	err = p.withTx(ctx, func(tx *sqlx.Tx) error {
+		var row struct {
+			ArticleClaps
+			ArticleID uuid.UUID `db:"article_id"`
+		}
+		if err := tx.GetContext(ctx, &row, query, args...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrArticleNotFound
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		claps = row.ArticleClaps
+
+		// The clap row stays locked until the end of the transaction, so the events of the user are up to date
+		// in this statement, and their counts sum up to the count before the claps were added.
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO article_clap_events (article_id, user_id, count)
+			SELECT $1::uuid, $2::uuid, added FROM (
+				SELECT $3::integer - COALESCE(SUM(e.count), 0) AS added FROM article_clap_events e
+				WHERE e.article_id = $1 AND e.user_id = $2
+			) events
+			WHERE added > 0`, row.ArticleID, params.UserID, claps.ViewerClapCount)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &claps, nil
+}
+
+type ClapArticleParams struct {
+	Slug   string
+	UserID uuid.UUID
+	// Count is the number of claps to add. It must be positive.
+	Count int
+}
@@ -0,0 +1,68 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleClapStore(t *testing.T) {
+	suite.Run(t, new(ArticleClapStoreTestSuite))
+}
+
+type ArticleClapStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ArticleClapStoreTestSuite) TestClapArticle() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	article := &store.Article{Slug: "popular", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, article))
+	other := &store.Article{Slug: "newer", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, other))
+
+	_, err := s.store.ClapArticle(ctx, store.ClapArticleParams{Slug: "unknown", UserID: user.ID, Count: 1})
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+
+	claps, err := s.store.ClapArticle(ctx, store.ClapArticleParams{Slug: article.Slug, UserID: user.ID, Count: 30})
+	s.Require().NoError(err)
+	s.Require().Equal(&store.ArticleClaps{ClapCount: 30, ViewerClapCount: 30}, claps)
+
+	// Claps above the cap are ignored.
+	claps, err = s.store.ClapArticle(ctx, store.ClapArticleParams{Slug: article.Slug, UserID: user.ID, Count: 30})
+	s.Require().NoError(err)
+	s.Require().Equal(&store.ArticleClaps{ClapCount: 50, ViewerClapCount: 50}, claps)
+
+	details, err := s.store.GetArticleBySlug(ctx, article.Slug, user.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(50, details.ClapCount)
+	s.Require().Equal(50, details.ViewerClapCount)
+	details, err = s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().Equal(0, details.ViewerClapCount)
+
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		OrderBy: store.ArticleOrderMostClapped,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 2)
+	s.Require().Equal(article.ID, previews[0].ID)
+	s.Require().Equal(other.ID, previews[1].ID)
+
+	previews, err = s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		OrderBy: store.ArticleOrderMostClapped,
+		After: &store.ArticlePreviewCursor{
+			ClapCount:   previews[0].ClapCount,
+			PublishedAt: previews[0].ListedAt(),
+			ID:          previews[0].ID,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal(other.ID, previews[0].ID)
+}
@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ArticleEventType is the kind of an event sent by the reader of an article.
+type ArticleEventType string
+
+const (
+	// ArticleEventView is sent when a visitor opens an article.
+	ArticleEventView ArticleEventType = "view"
+	// ArticleEventReadCompletion is sent when a visitor reaches the end of an article.
+	ArticleEventReadCompletion ArticleEventType = "read_completion"
+)
+
+// RecordArticleEvent records an event of a visitor on a published article, and adds it to the daily rollups.
+// It reports whether the event was recorded: only the first event of each visitor and type in a deduplication
+// window is recorded. ErrArticleNotFound is returned if no published article has the given slug.
+func (p *Store) RecordArticleEvent(ctx context.Context, params RecordArticleEventParams) (bool, error) {
+	var recorded bool
+	err := p.withTx(ctx, func(tx *sqlx.Tx) error {
+		var articleID uuid.UUID
+		err := tx.GetContext(ctx, &articleID,
+			`SELECT a.id FROM articles a WHERE a.slug = $1 AND `+articleIsPublic, params.Slug)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrArticleNotFound
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO article_events (article_id, visitor_hash, type, read_seconds, window_start, occurred_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (article_id, visitor_hash, type, window_start) DO NOTHING`,
+			articleID, params.VisitorHash, params.Type, params.ReadSeconds, params.WindowStart, params.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return nil
+		}
+		recorded = true
+
+		day := params.OccurredAt.UTC().Format(time.DateOnly)
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO article_daily_readers (article_id, day, visitor_hash) VALUES ($1, $2, $3)
+			ON CONFLICT (article_id, day, visitor_hash) DO NOTHING`,
+			articleID, day, params.VisitorHash)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		var views, completions, readSeconds int
+		switch params.Type {
+		case ArticleEventView:
+			views = 1
+		case ArticleEventReadCompletion:
+			completions = 1
+			readSeconds = params.ReadSeconds
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO article_daily_stats (article_id, day, views, completions, read_seconds)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (article_id, day) DO UPDATE SET
+				views = article_daily_stats.views + EXCLUDED.views,
+				completions = article_daily_stats.completions + EXCLUDED.completions,
+				read_seconds = article_daily_stats.read_seconds + EXCLUDED.read_seconds`,
+			articleID, day, views, completions, readSeconds)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return recorded, nil
+}
+
+type RecordArticleEventParams struct {
+	Slug string
+	// VisitorHash identifies the visitor who sent the event, without revealing who they are.
+	VisitorHash string
+	Type        ArticleEventType
+	// ReadSeconds is the time the visitor took to read the article. It only applies to ArticleEventReadCompletion.
+	ReadSeconds int
+	OccurredAt  time.Time
+	// WindowStart is the start of the deduplication window which OccurredAt is in.
+	WindowStart time.Time
+}
+
+// GetDigitalAuthorAnalytics returns the metrics of the articles of a digital author owned by the given user,
+// for each day in a date range and in total. ErrDigitalAuthorNotFound is returned if the user does not own
+// the digital author.
+func (p *Store) GetDigitalAuthorAnalytics(ctx context.Context, params GetDigitalAuthorAnalyticsParams) (*AuthorAnalytics, error) {
+	from := params.From.Format(time.DateOnly)
+	to := params.To.Format(time.DateOnly)
+
+	var ownedID uuid.UUID
+	err := p.db.GetContext(ctx, &ownedID,
+		`SELECT id FROM digital_authors WHERE id = $1 AND owner_id = $2`, params.AuthorID, params.OwnerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDigitalAuthorNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	analytics := &AuthorAnalytics{Days: []DailyAnalytics{}}
+	err = p.db.SelectContext(ctx, &analytics.Days, `
+		WITH stats AS (
+			SELECT s.day, SUM(s.views) AS views, SUM(s.completions) AS completions, SUM(s.read_seconds) AS read_seconds
+			FROM article_daily_stats s INNER JOIN articles a ON a.id = s.article_id
+			WHERE a.author_id = $1 AND s.day BETWEEN $2::date AND $3::date
+			GROUP BY s.day
+		), readers AS (
+			SELECT r.day, COUNT(DISTINCT r.visitor_hash) AS unique_readers
+			FROM article_daily_readers r INNER JOIN articles a ON a.id = r.article_id
+			WHERE a.author_id = $1 AND r.day BETWEEN $2::date AND $3::date
+			GROUP BY r.day
+		)
+		SELECT d.day::date AS day,
+			COALESCE(stats.views, 0) AS views,
+			COALESCE(readers.unique_readers, 0) AS unique_readers,
+			COALESCE(stats.completions, 0) AS completions,
+			COALESCE(stats.read_seconds, 0) AS read_seconds
+		FROM generate_series($2::date, $3::date, INTERVAL '1 day') AS d (day)
+		LEFT JOIN stats ON stats.day = d.day::date
+		LEFT JOIN readers ON readers.day = d.day::date
+		ORDER BY d.day`,
+		params.AuthorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	// Readers are counted once over the whole range, even if they came back on several days.
+	err = p.db.GetContext(ctx, &analytics.UniqueReaders, `
+		SELECT COUNT(DISTINCT r.visitor_hash)
+		FROM article_daily_readers r INNER JOIN articles a ON a.id = r.article_id
+		WHERE a.author_id = $1 AND r.day BETWEEN $2::date AND $3::date`,
+		params.AuthorID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	for _, day := range analytics.Days {
+		analytics.Views += day.Views
+		analytics.Completions += day.Completions
+		analytics.ReadSeconds += day.ReadSeconds
+	}
+
+	return analytics, nil
+}
+
+type GetDigitalAuthorAnalyticsParams struct {
+	AuthorID uuid.UUID
+	// OwnerID is the ID of the user who requests the analytics, who must own the digital author.
+	OwnerID uuid.UUID
+	// From and To are the first and last days of the range, inclusive. Only their dates are used.
+	From time.Time
+	To   time.Time
+}
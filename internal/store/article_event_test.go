@@ -0,0 +1,78 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleEventStore(t *testing.T) {
+	suite.Run(t, new(ArticleEventStoreTestSuite))
+}
+
+type ArticleEventStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ArticleEventStoreTestSuite) TestArticleEvents() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+
+	day := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	record := func(visitor string, eventType store.ArticleEventType, readSeconds int, at time.Time) bool {
+		recorded, err := s.store.RecordArticleEvent(ctx, store.RecordArticleEventParams{
+			Slug:        article.Slug,
+			VisitorHash: visitor,
+			Type:        eventType,
+			ReadSeconds: readSeconds,
+			OccurredAt:  at,
+			WindowStart: at.Truncate(30 * time.Minute),
+		})
+		s.Require().NoError(err)
+		return recorded
+	}
+
+	s.Require().True(record("alice", store.ArticleEventView, 0, day))
+	// Repeated events in the same window are not recorded.
+	s.Require().False(record("alice", store.ArticleEventView, 0, day.Add(10*time.Minute)))
+	s.Require().True(record("alice", store.ArticleEventReadCompletion, 200, day.Add(10*time.Minute)))
+	s.Require().True(record("alice", store.ArticleEventView, 0, day.Add(time.Hour)))
+	s.Require().True(record("bob", store.ArticleEventView, 0, day))
+	s.Require().True(record("alice", store.ArticleEventView, 0, day.AddDate(0, 0, 1)))
+	s.Require().True(record("bob", store.ArticleEventReadCompletion, 100, day.AddDate(0, 0, 1)))
+
+	_, err := s.store.RecordArticleEvent(ctx, store.RecordArticleEventParams{Slug: "unknown", Type: store.ArticleEventView})
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+
+	_, err = s.store.GetDigitalAuthorAnalytics(ctx, store.GetDigitalAuthorAnalyticsParams{
+		AuthorID: user.ID,
+		OwnerID:  uuid.New(),
+		From:     day,
+		To:       day,
+	})
+	s.Require().ErrorIs(err, store.ErrDigitalAuthorNotFound)
+
+	analytics, err := s.store.GetDigitalAuthorAnalytics(ctx, store.GetDigitalAuthorAnalyticsParams{
+		AuthorID: user.ID,
+		OwnerID:  user.ID,
+		From:     day,
+		To:       day.AddDate(0, 0, 2),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(4, analytics.Views)
+	s.Require().Equal(2, analytics.UniqueReaders)
+	s.Require().Equal(2, analytics.Completions)
+	s.Require().Equal(int64(300), analytics.ReadSeconds)
+	s.Require().Len(analytics.Days, 3)
+	s.Require().Equal(3, analytics.Days[0].Views)
+	s.Require().Equal(2, analytics.Days[0].UniqueReaders)
+	s.Require().Equal(1, analytics.Days[1].Views)
+	s.Require().Equal(2, analytics.Days[1].UniqueReaders)
+	s.Require().Zero(analytics.Days[2].Views)
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// DeleteOwnedArticle soft-deletes an article on behalf of the owner of its digital author. The article is kept
+// so that its history survives, but it is hidden from everyone, including the owner. ErrArticleNotFound is
+// returned if the user owns no article with the slug, or if it is already deleted.
+func (p *Store) DeleteOwnedArticle(ctx context.Context, ownerID uuid.UUID, slug string) error {
+	query, args, err := p.qb.
+		Update("articles").
+		Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"slug": slug, "deleted_at": nil}).
+		Where("author_id IN (SELECT id FROM digital_authors WHERE owner_id = ?)", ownerID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	return p.execArticleUpdate(ctx, query, args)
+}
+
+// TakeDownArticle hides an article from the public on behalf of a moderator, whatever its status, until it is
+// restored with RestoreArticle. Taking down an article again only replaces the reason. ErrArticleNotFound is
+// returned if there is no article with the slug, or if it is deleted. The caller must check that the current
+// user is a moderator.
+func (p *Store) TakeDownArticle(ctx context.Context, params TakeDownArticleParams) error {
+	query, args, err := p.qb.
+		Update("articles").
+		Set("taken_down_at", sq.Expr("COALESCE(taken_down_at, CURRENT_TIMESTAMP)")).
+		Set("taken_down_by", params.ModeratorID).
+		Set("takedown_reason", params.Reason).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"slug": params.Slug, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	return p.execArticleUpdate(ctx, query, args)
+}
+
+type TakeDownArticleParams struct {
+	Slug string
+	// ModeratorID is the ID of the moderator who takes the article down.
+	ModeratorID uuid.UUID
+	// Reason explains why the article is taken down.
+	Reason string
+}
+
+// RestoreArticle reverts the takedown of an article, so that it is visible again if it is published. Restoring
+// an article which is not taken down has no effect. ErrArticleNotFound is returned if there is no article with
+// the slug, or if it is deleted. The caller must check that the current user is a moderator.
+func (p *Store) RestoreArticle(ctx context.Context, slug string) error {
+	query, args, err := p.qb.
+		Update("articles").
+		Set("taken_down_at", nil).
+		Set("taken_down_by", nil).
+		Set("takedown_reason", nil).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"slug": slug, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	return p.execArticleUpdate(ctx, query, args)
+}
+
+// execArticleUpdate runs an update of a single article, and returns ErrArticleNotFound if no article was updated.
+func (p *Store) execArticleUpdate(ctx context.Context, query string, args []any) error {
+	result, err := p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrArticleNotFound
+	}
+
+	return nil
+}
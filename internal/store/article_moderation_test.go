@@ -0,0 +1,56 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleModerationStore(t *testing.T) {
+	suite.Run(t, new(ArticleModerationStoreTestSuite))
+}
+
+type ArticleModerationStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ArticleModerationStoreTestSuite) TestArticleModeration() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	moderator := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+
+	isListed := func() bool {
+		previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{})
+		s.Require().NoError(err)
+		return len(previews) == 1
+	}
+	s.Require().True(isListed())
+
+	s.Require().NoError(s.store.TakeDownArticle(ctx, store.TakeDownArticleParams{
+		Slug:        article.Slug,
+		ModeratorID: moderator.ID,
+		Reason:      "Plagiarism",
+	}))
+	s.Require().False(isListed())
+	_, err := s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+	// The owner still sees the article which is taken down.
+	_, err = s.store.GetOwnedArticleBySlug(ctx, user.ID, article.Slug)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.store.RestoreArticle(ctx, article.Slug))
+	s.Require().True(isListed())
+
+	s.Require().ErrorIs(s.store.DeleteOwnedArticle(ctx, moderator.ID, article.Slug), store.ErrArticleNotFound)
+	s.Require().NoError(s.store.DeleteOwnedArticle(ctx, user.ID, article.Slug))
+	s.Require().False(isListed())
+	_, err = s.store.GetOwnedArticleBySlug(ctx, user.ID, article.Slug)
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+	s.Require().ErrorIs(s.store.DeleteOwnedArticle(ctx, user.ID, article.Slug), store.ErrArticleNotFound)
+	s.Require().ErrorIs(s.store.RestoreArticle(ctx, article.Slug), store.ErrArticleNotFound)
+}
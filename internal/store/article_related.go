@@ -0,0 +1,182 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// MaxRelatedArticles is the number of related articles computed and cached for each article, which is the
+// maximum number ListRelatedArticles can return.
+const MaxRelatedArticles = 20
+
+// The weights of each kind of similarity in the relatedness score of two articles. The text similarity is
+// between 0 and 1, so a shared tag weighs about as much as half of the content being alike.
+const (
+	relatedTagWeight    = 1.0
+	relatedAuthorWeight = 0.5
+	relatedTextWeight   = 2.0
+)
+
+// relatedTextSampleLength is the number of characters at the beginning of the plaintext content of the articles
+// which are compared for text similarity. It bounds the cost of comparing long articles. It must match the
+// trigram index on the articles, see relatedTextSample.
+const relatedTextSampleLength = 4000
+
+// relatedTextSample is the beginning of the plaintext content of the article with the given table alias which is
+// compared for text similarity. The expression is the one of the idx_articles_plaintext_sample_trgm index, so
+// that similar articles are found with the index instead of comparing every article.
+func relatedTextSample(alias string) string {
+	return fmt.Sprintf("left(%s.plaintext_content, %d)", alias, relatedTextSampleLength)
+}
+
+// ListRelatedArticles returns the published articles most similar to the published article with the given slug,
+// most similar first. Articles are similar if they share tags, have the same author, or have similar contents,
+// according to the trigram similarity of pg_trgm. The articles are computed once and cached, until the article
+// is updated or the cache is older than params.MaxAge. It returns ErrArticleNotFound if there is no published
+// article with the slug.
+func (p *Store) ListRelatedArticles(ctx context.Context, params ListRelatedArticlesParams) ([]ArticlePreview, error) {
+	var target struct {
+		ID         uuid.UUID    `db:"id"`
+		UpdatedAt  time.Time    `db:"updated_at"`
+		ComputedAt sql.NullTime `db:"computed_at"`
+	}
+	err := p.db.GetContext(ctx, &target, `
+		SELECT a.id, a.updated_at, c.computed_at
+		FROM articles a
+		LEFT JOIN related_article_caches c ON c.article_id = a.id
+		WHERE a.slug = $1 AND `+articleIsPublic, params.Slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrArticleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	if !target.ComputedAt.Valid || target.ComputedAt.Time.Before(target.UpdatedAt) ||
+		target.ComputedAt.Time.Before(params.Now.Add(-params.MaxAge)) {
+		if err := p.refreshRelatedArticles(ctx, target.ID, target.ComputedAt, params.Now); err != nil {
+			return nil, err
+		}
+	}
+
+	// Related articles which are no longer public since they were cached are skipped.
+	builder := p.qb.
+		Select(articlePreviewColumns...).
+		Column(articleViewerColumns(params.ViewerID)).
+		From("related_article_caches c").
+		JoinClause("CROSS JOIN LATERAL unnest(c.related_ids) WITH ORDINALITY AS r(id, position)").
+		InnerJoin("articles a ON a.id = r.id").
+		InnerJoin("digital_authors da ON a.author_id = da.id").
+		Where(sq.Eq{"c.article_id": target.ID}).
+		Where(articleIsPublic).
+		OrderBy("r.position")
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	articles := []ArticlePreview{}
+	err = p.db.SelectContext(ctx, &articles, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return articles, nil
+}
+
+type ListRelatedArticlesParams struct {
+	Slug string
+	// ViewerID is the ID of the user who lists the articles, or uuid.Nil for anonymous users.
+	ViewerID uuid.UUID
+	// Limit is the maximum number of articles to return. At most MaxRelatedArticles are returned.
+	Limit int
+	// Now is the current time.
+	Now time.Time
+	// MaxAge is how long the cached related articles are used before they are computed again.
+	MaxAge time.Duration
+}
+
+// refreshRelatedArticles computes the related articles of an article as of now, and caches them. Published
+// articles which are not similar at all come last, newest first, so that there are always related articles
+// to suggest. Only the articles which share a tag or the author with the article, or whose text is similar
+// enough to use the trigram index, are scored.
+//
+// Concurrent refreshes of the same article are serialized. computedAt is the time the cached articles were
+// computed when they were found to be stale; if they were refreshed since then by another request, they are
+// not computed again.
+func (p *Store) refreshRelatedArticles(ctx context.Context, articleID uuid.UUID, computedAt sql.NullTime,
+	now time.Time) error {
+	return p.withTx(ctx, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			"SELECT pg_advisory_xact_lock(hashtextextended('related_article_caches:' || $1::text, 0))", articleID)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		var current sql.NullTime
+		err = tx.GetContext(ctx, &current,
+			"SELECT computed_at FROM related_article_caches WHERE article_id = $1", articleID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		if current.Valid != computedAt.Valid || !current.Time.Equal(computedAt.Time) {
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			WITH target AS (
+				SELECT t.id, t.author_id, `+relatedTextSample("t")+` AS text_sample
+				FROM articles t
+				WHERE t.id = $1
+			), text_matches AS (
+				SELECT a.id, similarity(`+relatedTextSample("a")+`, t.text_sample) AS similarity
+				FROM articles a, target t
+				WHERE `+relatedTextSample("a")+` % t.text_sample AND `+articleIsPublic+`
+			), candidates AS (
+				SELECT at.article_id AS id FROM article_tags at
+				INNER JOIN article_tags tt ON tt.tag_id = at.tag_id AND tt.article_id = $1
+				UNION
+				SELECT a.id FROM articles a, target t WHERE a.author_id = t.author_id
+				UNION
+				SELECT id FROM text_matches
+				UNION
+				(SELECT a.id FROM articles a WHERE `+articleIsPublic+` ORDER BY a.published_at DESC, a.id
+					LIMIT $6::integer + 1)
+			)
+			INSERT INTO related_article_caches (article_id, related_ids, computed_at)
+			SELECT t.id, ARRAY(
+				SELECT a.id FROM candidates c
+				INNER JOIN articles a ON a.id = c.id
+				LEFT JOIN text_matches m ON m.id = a.id
+				WHERE a.id <> t.id AND `+articleIsPublic+`
+				ORDER BY
+					$3::double precision * (
+						SELECT COUNT(*) FROM article_tags at
+						INNER JOIN article_tags tt ON tt.tag_id = at.tag_id AND tt.article_id = t.id
+						WHERE at.article_id = a.id)
+					+ CASE WHEN a.author_id = t.author_id THEN $4::double precision ELSE 0 END
+					+ $5::double precision * COALESCE(m.similarity, 0) DESC,
+					a.published_at DESC, a.id
+				LIMIT $6::integer), $2::timestamptz
+			FROM target t
+			ON CONFLICT (article_id) DO UPDATE
+			SET related_ids = EXCLUDED.related_ids, computed_at = EXCLUDED.computed_at`,
+			articleID, now, relatedTagWeight, relatedAuthorWeight, relatedTextWeight, MaxRelatedArticles)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+}
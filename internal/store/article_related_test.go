@@ -0,0 +1,96 @@
+package store_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleRelatedStore(t *testing.T) {
+	suite.Run(t, new(ArticleRelatedStoreTestSuite))
+}
+
+type ArticleRelatedStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ArticleRelatedStoreTestSuite) TestListRelatedArticles() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	other := s.mustCreateUser()
+	newArticle := func(slug string, authorID uuid.UUID, status store.ArticleStatus, plaintext string, tags ...string) {
+		article := &store.Article{Slug: slug, Content: plaintext, PlaintextContent: plaintext, AuthorID: authorID,
+			Status: status, Tags: tags}
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+	newArticle("target", user.ID, store.ArticleStatusPublished, "Goroutines and channels in Go", "Go", "Concurrency")
+	newArticle("same-tags", other.ID, store.ArticleStatusPublished, "Mutexes and wait groups", "Go", "Concurrency")
+	newArticle("same-author", user.ID, store.ArticleStatusPublished, "Baking sourdough bread")
+	newArticle("unrelated", other.ID, store.ArticleStatusPublished, "Baking sourdough bread")
+	newArticle("draft", other.ID, store.ArticleStatusDraft, "Goroutines and channels in Go", "Go", "Concurrency")
+
+	now := time.Now()
+	params := store.ListRelatedArticlesParams{Slug: "target", Limit: 10, Now: now, MaxAge: time.Hour}
+	related, err := s.store.ListRelatedArticles(ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"same-tags", "same-author", "unrelated"}, relatedSlugs(related))
+
+	// The related articles are cached, so new articles are only suggested once the cache expires.
+	newArticle("similar-text", other.ID, store.ArticleStatusPublished, "Goroutines and channels")
+	params.Limit = 2
+	related, err = s.store.ListRelatedArticles(ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"same-tags", "same-author"}, relatedSlugs(related))
+
+	params.Now = now.Add(2 * time.Hour)
+	related, err = s.store.ListRelatedArticles(ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"same-tags", "similar-text"}, relatedSlugs(related))
+
+	_, err = s.store.ListRelatedArticles(ctx, store.ListRelatedArticlesParams{Slug: "draft", Now: now})
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+}
+
+func (s *ArticleRelatedStoreTestSuite) TestListRelatedArticles_ConcurrentRefresh() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	for _, slug := range []string{"target", "first", "second", "third"} {
+		article := &store.Article{Slug: slug, Content: slug, PlaintextContent: slug, AuthorID: user.ID,
+			Status: store.ArticleStatusPublished}
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+
+	// Requests which find the cache stale at the same time compute the related articles once, one at a time.
+	params := store.ListRelatedArticlesParams{Slug: "target", Limit: 10, Now: time.Now(), MaxAge: time.Hour}
+	results := make([][]string, 5)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			related, err := s.store.ListRelatedArticles(ctx, params)
+			s.NoError(err)
+			results[i] = relatedSlugs(related)
+		}()
+	}
+	wg.Wait()
+
+	for _, slugs := range results {
+		s.Require().Len(slugs, 3)
+		s.Require().Equal(results[0], slugs)
+	}
+}
+
+func relatedSlugs(articles []store.ArticlePreview) []string {
+	slugs := make([]string, len(articles))
+	for i, article := range articles {
+		slugs[i] = article.Slug
+	}
+	return slugs
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// createArticleRevision snapshots the current title, description and content of an article as its next revision.
+// It must run in the transaction which wrote the article, so that concurrent writes are numbered in order.
+func createArticleRevision(ctx context.Context, tx *sqlx.Tx, articleID uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO article_revisions (article_id, revision_number, title, description, content, content_format)
+		SELECT a.id,
+			COALESCE((SELECT MAX(r.revision_number) FROM article_revisions r WHERE r.article_id = a.id), 0) + 1,
+			a.title, COALESCE(a.description, ''), a.content, a.content_format
+		FROM articles a
+		WHERE a.id = $1`,
+		articleID)
+	if err != nil {
+		return fmt.Errorf("failed to create article revision: %w", err)
+	}
+
+	return nil
+}
+
+// ListArticleRevisions lists the revisions of the published article with the given slug, newest first.
+func (p *Store) ListArticleRevisions(ctx context.Context, slug string) ([]ArticleRevisionSummary, error) {
+	revisions := []ArticleRevisionSummary{}
+
+	query, args, err := p.qb.
+		Select("r.revision_number", "r.title", "r.description", "r.content_format", "r.created_at").
+		From("article_revisions r").
+		InnerJoin("articles a ON a.id = r.article_id").
+		Where("a.slug = ?", slug).
+		Where(articleIsPublic).
+		OrderBy("r.revision_number DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &revisions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	// Every article has at least the revision created along with it.
+	if len(revisions) == 0 {
+		return nil, ErrArticleNotFound
+	}
+
+	return revisions, nil
+}
+
+// GetArticleRevision retrieves a revision of the published article with the given slug by its number.
+func (p *Store) GetArticleRevision(ctx context.Context, slug string, number int) (*ArticleRevision, error) {
+	query, args, err := p.qb.
+		Select("r.revision_number", "r.title", "r.description", "r.content", "r.content_format", "r.created_at").
+		From("article_revisions r").
+		InnerJoin("articles a ON a.id = r.article_id").
+		Where("a.slug = ?", slug).
+		Where("r.revision_number = ?", number).
+		Where(articleIsPublic).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var revision ArticleRevision
+	err = p.db.GetContext(ctx, &revision, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrArticleRevisionNotFound
+		}
+		return nil, err
+	}
+
+	return &revision, nil
+}
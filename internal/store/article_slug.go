@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the PostgreSQL error code of unique constraint violations.
+const uniqueViolation = "23505"
+
+// isArticleSlugViolation reports whether err is caused by writing an article with a slug which is already used.
+func isArticleSlugViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "articles_slug_key"
+}
+
+// ArticleSlugExists reports whether the slug is used by an article, either currently or in the past.
+// Slugs used in the past are kept so that their URLs can be redirected, hence they cannot be reused.
+func (p *Store) ArticleSlugExists(ctx context.Context, slug string) (bool, error) {
+	var exists bool
+	err := p.db.GetContext(ctx, &exists, `
+		SELECT EXISTS (SELECT 1 FROM articles WHERE slug = $1)
+			OR EXISTS (SELECT 1 FROM slug_history WHERE slug = $1)`,
+		slug)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return exists, nil
+}
+
+// UpdateArticleSlug changes the slug of an article. The previous slug is kept in the slug history, so that
+// GetArticleSlugRedirect can find the article by it. An article can take back one of its previous slugs,
+// but not the current or previous slug of another article.
+func (p *Store) UpdateArticleSlug(ctx context.Context, params UpdateArticleSlugParams) error {
+	return p.withTx(ctx, func(tx *sqlx.Tx) error {
+		var currentSlug string
+		err := tx.GetContext(ctx, &currentSlug, `SELECT slug FROM articles WHERE id = $1 FOR UPDATE`, params.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrArticleNotFound
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		if currentSlug == params.Slug {
+			return nil
+		}
+
+		// Free the new slug if the article used it before. If it still exists in the history afterwards,
+		// it belongs to another article.
+		var takenByAnotherArticle bool
+		err = tx.GetContext(ctx, &takenByAnotherArticle, `
+			WITH reclaimed AS (
+				DELETE FROM slug_history WHERE slug = $1 AND article_id = $2
+			)
+			SELECT EXISTS (SELECT 1 FROM slug_history WHERE slug = $1 AND article_id <> $2)`,
+			params.Slug, params.ID)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		if takenByAnotherArticle {
+			return ErrArticleSlugTaken
+		}
+
+		query, args, err := p.qb.
+			Update("articles").
+			Set("slug", params.Slug).
+			Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+			Where(sq.Eq{"id": params.ID}).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			if isArticleSlugViolation(err) {
+				return ErrArticleSlugTaken
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO slug_history (slug, article_id) VALUES ($1, $2)`,
+			currentSlug, params.ID)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+}
+
+type UpdateArticleSlugParams struct {
+	ID   uuid.UUID
+	Slug string
+}
+
+// GetArticleSlugRedirect returns the current slug of the published article which used to have the given slug.
+func (p *Store) GetArticleSlugRedirect(ctx context.Context, slug string) (string, error) {
+	query, args, err := p.qb.
+		Select("a.slug").
+		From("slug_history h").
+		InnerJoin("articles a ON a.id = h.article_id").
+		Where(sq.Eq{"h.slug": slug}).
+		Where(articleIsPublic).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var currentSlug string
+	err = p.db.GetContext(ctx, &currentSlug, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrArticleNotFound
+		}
+		return "", fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return currentSlug, nil
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// UpdateArticleStatus moves an article to another status on behalf of the owner of its digital author.
+// Published articles are published immediately, and scheduled articles are published at params.PublishAt.
+// Archived articles which were public keep their publish time, which they take back if they are published again.
+// ErrInvalidStatusTransition is returned if the article cannot move from its current status to params.Status, or
+// its current status is not one of params.From.
+func (p *Store) UpdateArticleStatus(ctx context.Context, params UpdateArticleStatusParams) (*ArticleState, error) {
+	selectQuery, selectArgs, err := p.qb.
+		Select("a.id", "a.status").
+		From("articles a").
+		InnerJoin("digital_authors da ON a.author_id = da.id").
+		Where(sq.Eq{"a.slug": params.Slug, "da.owner_id": params.OwnerID, "a.deleted_at": nil}).
+		Suffix("FOR UPDATE OF a").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var state ArticleState
+	err = p.withTx(ctx, func(tx *sqlx.Tx) error {
+		var current struct {
+			ID     uuid.UUID     `db:"id"`
+			Status ArticleStatus `db:"status"`
+		}
+		if err := tx.GetContext(ctx, &current, selectQuery, selectArgs...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrArticleNotFound
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		if !canChangeArticleStatus(current.Status, params.Status) ||
+			(len(params.From) > 0 && !slices.Contains(params.From, current.Status)) {
+			return fmt.Errorf("%w: from %s to %s", ErrInvalidStatusTransition, current.Status, params.Status)
+		}
+
+		updateQuery, updateArgs, err := p.qb.
+			Update("articles").
+			Set("status", params.Status).
+			Set("published_at", newArticlePublishedAt(current.Status, params)).
+			Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+			Where(sq.Eq{"id": current.ID}).
+			Suffix("RETURNING status, published_at").
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %w", err)
+		}
+
+		if err := tx.GetContext(ctx, &state, updateQuery, updateArgs...); err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// PublishNextDueArticle publishes the scheduled article which has been due for the longest time, and returns it.
+// nil is returned if no scheduled article is due. Articles locked by concurrent transactions are skipped, so that
+// several publishers can run at the same time without publishing an article twice.
+func (p *Store) PublishNextDueArticle(ctx context.Context) (*PublishedArticle, error) {
+	var article PublishedArticle
+	err := p.db.GetContext(ctx, &article, `
+		WITH due AS (
+			SELECT id FROM articles
+			WHERE status = 'scheduled' AND published_at <= CURRENT_TIMESTAMP AND deleted_at IS NULL
+			ORDER BY published_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE articles a
+		SET status = 'published', updated_at = CURRENT_TIMESTAMP
+		FROM due
+		WHERE a.id = due.id
+		RETURNING a.id, a.slug, a.published_at`)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &article, nil
+}
+
+// canChangeArticleStatus reports whether an article can move from one status to another. Articles which are not
+// public yet can be published, scheduled or archived. Published articles can only be archived, and archived
+// articles can be published again.
+func canChangeArticleStatus(from, to ArticleStatus) bool {
+	switch from {
+	case ArticleStatusDraft, ArticleStatusPendingReview, ArticleStatusScheduled:
+		return to == ArticleStatusPublished || to == ArticleStatusScheduled || to == ArticleStatusArchived
+	case ArticleStatusPublished:
+		return to == ArticleStatusArchived
+	case ArticleStatusArchived:
+		return to == ArticleStatusPublished
+	default:
+		return false
+	}
+}
+
+// newArticlePublishedAt returns the value of the published_at column of an article which moves from one status
+// to params.Status.
+func newArticlePublishedAt(from ArticleStatus, params UpdateArticleStatusParams) any {
+	switch params.Status {
+	case ArticleStatusPublished:
+		if from == ArticleStatusArchived {
+			// Articles which were public before they were archived are restored at their place in the listings.
+			return sq.Expr("COALESCE(published_at, CURRENT_TIMESTAMP)")
+		}
+		return sq.Expr("CURRENT_TIMESTAMP")
+	case ArticleStatusScheduled:
+		return params.PublishAt
+	case ArticleStatusArchived:
+		if from == ArticleStatusPublished {
+			return sq.Expr("published_at")
+		}
+	}
+	return sql.NullTime{}
+}
+
+type UpdateArticleStatusParams struct {
+	Slug string
+	// OwnerID is the ID of the user who owns the digital author of the article.
+	OwnerID uuid.UUID
+	// Status is the new status: ArticleStatusPublished, ArticleStatusScheduled or ArticleStatusArchived.
+	Status ArticleStatus
+	// PublishAt is the time the article becomes public. It only applies to ArticleStatusScheduled.
+	PublishAt time.Time
+	// From optionally restricts the statuses the article can move from, among those allowed for params.Status.
+	From []ArticleStatus
+}
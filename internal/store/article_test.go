@@ -2,6 +2,8 @@ package store_test
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,7 +11,6 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/tuananhlai/brevity-go/internal/store"
-	"github.com/tuananhlai/brevity-go/internal/testutil"
 )
 
 func TestArticleStore(t *testing.T) {
@@ -17,40 +18,139 @@ func TestArticleStore(t *testing.T) {
 }
 
 type ArticleStoreTestSuite struct {
-	suite.Suite
-	dbTestUtil *testutil.DatabaseTestUtil
-	store      *store.Store
+	storeTestSuite
 }
 
-func (s *ArticleStoreTestSuite) SetupSuite() {
-	var err error
-	s.dbTestUtil, err = testutil.NewDatabaseTestUtil()
+func (s *ArticleStoreTestSuite) TestCreateArticle_Success() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+
+	article := &store.Article{
+		Title:    "Test Article",
+		Content:  "This is a test article",
+		AuthorID: author.ID,
+	}
+	err := s.store.CreateArticle(ctx, article)
 	s.Require().NoError(err)
+}
 
-	s.store = store.New(s.dbTestUtil.DB())
+func (s *ArticleStoreTestSuite) TestCreateArticle_SlugTaken() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	article := s.mustCreateArticle(author.ID)
+
+	err := s.store.CreateArticle(ctx, &store.Article{
+		Slug:     article.Slug,
+		Title:    "Another Article",
+		Content:  "content",
+		AuthorID: author.ID,
+	})
+	s.Require().ErrorIs(err, store.ErrArticleSlugTaken)
 }
 
-func (s *ArticleStoreTestSuite) BeforeTest(suiteName, testName string) {
-	err := s.dbTestUtil.Reset()
+func (s *ArticleStoreTestSuite) TestUpdateArticleSlug_RedirectsOldSlug() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	article := s.mustCreateArticle(author.ID)
+	oldSlug := article.Slug
+
+	err := s.store.UpdateArticleSlug(ctx, store.UpdateArticleSlugParams{ID: article.ID, Slug: "renamed"})
 	s.Require().NoError(err)
-}
 
-func (s *ArticleStoreTestSuite) TearDownSuite() {
-	err := s.dbTestUtil.Teardown()
+	_, err = s.store.GetArticleBySlug(ctx, oldSlug, uuid.Nil)
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+	currentSlug, err := s.store.GetArticleSlugRedirect(ctx, oldSlug)
+	s.Require().NoError(err)
+	s.Require().Equal("renamed", currentSlug)
+
+	// Old slugs cannot be reused by other articles.
+	exists, err := s.store.ArticleSlugExists(ctx, oldSlug)
+	s.Require().NoError(err)
+	s.Require().True(exists)
+	other := &store.Article{Slug: "other", Title: "Other", Content: "content", AuthorID: author.ID}
+	s.Require().NoError(s.store.CreateArticle(ctx, other))
+	err = s.store.UpdateArticleSlug(ctx, store.UpdateArticleSlugParams{ID: other.ID, Slug: oldSlug})
+	s.Require().ErrorIs(err, store.ErrArticleSlugTaken)
+	err = s.store.UpdateArticleSlug(ctx, store.UpdateArticleSlugParams{ID: other.ID, Slug: "renamed"})
+	s.Require().ErrorIs(err, store.ErrArticleSlugTaken)
+
+	// The article can take back its old slug.
+	err = s.store.UpdateArticleSlug(ctx, store.UpdateArticleSlugParams{ID: article.ID, Slug: oldSlug})
+	s.Require().NoError(err)
+	_, err = s.store.GetArticleBySlug(ctx, oldSlug, uuid.Nil)
+	s.Require().NoError(err)
+	currentSlug, err = s.store.GetArticleSlugRedirect(ctx, "renamed")
 	s.Require().NoError(err)
+	s.Require().Equal(oldSlug, currentSlug)
 }
 
-func (s *ArticleStoreTestSuite) TestCreateArticle_Success() {
+func (s *ArticleStoreTestSuite) TestUpdateArticleRendering_Success() {
 	ctx := context.Background()
 	author := s.mustCreateUser()
+	article := s.mustCreateArticle(author.ID)
+	s.Require().Equal(store.ContentFormatMarkdown, article.ContentFormat)
 
-	article := &store.Article{
-		Title:    "Test Article",
-		Content:  "This is a test article",
-		AuthorID: author.ID,
+	params := store.UpdateArticleRenderingParams{
+		ID:               article.ID,
+		HTMLContent:      "<p>This is a test article</p>",
+		PlaintextContent: "This is a test article",
 	}
-	err := s.store.CreateArticle(ctx, article)
+	changed, err := s.store.UpdateArticleRendering(ctx, params)
+	s.Require().NoError(err)
+	s.Require().True(changed)
+
+	// Rendering the same content again is a no-op.
+	changed, err = s.store.UpdateArticleRendering(ctx, params)
+	s.Require().NoError(err)
+	s.Require().False(changed)
+
+	details, err := s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().Equal(params.HTMLContent, details.HTMLContent)
+}
+
+func (s *ArticleStoreTestSuite) TestUpdateArticle_CreatesRevision() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	article := s.mustCreateArticle(author.ID)
+
+	err := s.store.UpdateArticle(ctx, store.UpdateArticleParams{
+		ID:            article.ID,
+		Title:         "Updated Title",
+		Description:   article.Description,
+		Content:       "This is an updated test article",
+		ContentFormat: store.ContentFormatMarkdown,
+	})
+	s.Require().NoError(err)
+
+	revisions, err := s.store.ListArticleRevisions(ctx, article.Slug)
 	s.Require().NoError(err)
+	s.Require().Len(revisions, 2)
+	s.Require().Equal(2, revisions[0].Number)
+	s.Require().Equal("Updated Title", revisions[0].Title)
+	s.Require().Equal(1, revisions[1].Number)
+	s.Require().Equal(article.Title, revisions[1].Title)
+
+	revision, err := s.store.GetArticleRevision(ctx, article.Slug, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(article.Content, revision.Content)
+
+	_, err = s.store.GetArticleRevision(ctx, article.Slug, 3)
+	s.Require().ErrorIs(err, store.ErrArticleRevisionNotFound)
+}
+
+func (s *ArticleStoreTestSuite) TestUpdateArticle_NotFound() {
+	err := s.store.UpdateArticle(context.Background(), store.UpdateArticleParams{
+		ID:      uuid.New(),
+		Title:   "Title",
+		Content: "Content",
+	})
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+}
+
+func (s *ArticleStoreTestSuite) TestListArticleRevisions_ArticleNotFound() {
+	_, err := s.store.ListArticleRevisions(context.Background(), "unknown")
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
 }
 
 func (s *ArticleStoreTestSuite) TestListArticlesPreviews_Success() {
@@ -58,7 +158,7 @@ func (s *ArticleStoreTestSuite) TestListArticlesPreviews_Success() {
 	author := s.mustCreateUser()
 	newArticle := s.mustCreateArticle(author.ID)
 
-	previews, err := s.store.ListArticlesPreviews(ctx)
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{})
 
 	s.Require().NoError(err)
 	s.Require().Len(previews, 1)
@@ -67,12 +167,217 @@ func (s *ArticleStoreTestSuite) TestListArticlesPreviews_Success() {
 	s.Require().Equal(newArticle.AuthorID, previews[0].AuthorID)
 }
 
+func (s *ArticleStoreTestSuite) TestListArticlesPreviews_Pagination() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	for _, slug := range []string{"first", "second", "third"} {
+		err := s.store.CreateArticle(ctx, &store.Article{
+			Slug:     slug,
+			Title:    slug,
+			Content:  "content",
+			AuthorID: author.ID,
+			Status:   store.ArticleStatusPublished,
+		})
+		s.Require().NoError(err)
+	}
+
+	firstPage, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(firstPage, 2)
+
+	last := firstPage[len(firstPage)-1]
+	secondPage, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		Limit: 2,
+		After: &store.ArticlePreviewCursor{PublishedAt: last.ListedAt(), ID: last.ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(secondPage, 1)
+
+	seen := map[string]bool{}
+	for _, preview := range append(firstPage, secondPage...) {
+		s.Require().False(seen[preview.Slug], "duplicate article %s", preview.Slug)
+		seen[preview.Slug] = true
+	}
+	s.Require().Len(seen, 3)
+}
+
+func (s *ArticleStoreTestSuite) TestListArticlesPreviews_OrderedByPublishTime() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	// Generated first, but only approved after the backdated article was imported.
+	approved := &store.Article{Slug: "approved", Title: "Approved", Content: "content", AuthorID: author.ID,
+		Status: store.ArticleStatusPendingReview}
+	s.Require().NoError(s.store.CreateArticle(ctx, approved))
+	backdated := &store.Article{Slug: "backdated", Title: "Backdated", Content: "content", AuthorID: author.ID,
+		Status: store.ArticleStatusPublished, PublishedAt: sql.NullTime{Time: time.Now().Add(-24 * time.Hour), Valid: true}}
+	s.Require().NoError(s.store.CreateArticle(ctx, backdated))
+	_, err := s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    approved.Slug,
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusPublished,
+	})
+	s.Require().NoError(err)
+
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal(approved.ID, previews[0].ID)
+
+	previews, err = s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		After: &store.ArticlePreviewCursor{PublishedAt: previews[0].ListedAt(), ID: previews[0].ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal(backdated.ID, previews[0].ID)
+}
+
+func (s *ArticleStoreTestSuite) TestGetArticlesLastUpdatedAt_HiddenArticles() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	article := &store.Article{Slug: "short-lived", Content: "content", AuthorID: author.ID,
+		Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, article))
+
+	// Deleting the article makes it leave the lists, which changes them.
+	s.Require().NoError(s.store.DeleteOwnedArticle(ctx, author.ID, article.Slug))
+	lastUpdated, err := s.store.GetArticlesLastUpdatedAt(ctx, author.ID)
+	s.Require().NoError(err)
+	s.Require().True(lastUpdated.After(article.UpdatedAt))
+	allLastUpdated, err := s.store.GetArticlesLastUpdatedAt(ctx, uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().False(allLastUpdated.Before(lastUpdated))
+
+	lastUpdated, err = s.store.GetArticlesLastUpdatedAt(ctx, uuid.New())
+	s.Require().NoError(err)
+	s.Require().True(lastUpdated.IsZero())
+}
+
+func (s *ArticleStoreTestSuite) TestListArticlesPreviews_TagFilter() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	articles := []*store.Article{
+		{Slug: "go-channels", Title: "Go channels", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusPublished, Tags: []string{"Go", "Concurrency"}},
+		{Slug: "rust-ownership", Title: "Rust ownership", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusPublished, Tags: []string{"Rust"}},
+	}
+	for _, article := range articles {
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{TagSlug: "go"})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal("go-channels", previews[0].Slug)
+	s.Require().Len(previews[0].Tags, 2)
+	s.Require().Equal("Concurrency", previews[0].Tags[0].Name)
+	s.Require().Equal("go", previews[0].Tags[1].Slug)
+}
+
+func (s *ArticleStoreTestSuite) TestListArticlesPreviews_MaxReadingMinutes() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	articles := []*store.Article{
+		{Slug: "short", Title: "Short", Content: "content", PlaintextContent: "A short article.",
+			AuthorID: author.ID, Status: store.ArticleStatusPublished},
+		{Slug: "long", Title: "Long", Content: "content", PlaintextContent: strings.Repeat("Many words. ", 1000),
+			AuthorID: author.ID, Status: store.ArticleStatusPublished},
+	}
+	for _, article := range articles {
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+	s.Require().Equal(3, articles[0].WordCount)
+	s.Require().Equal(1, articles[0].ReadingMinutes)
+	s.Require().Equal(2000, articles[1].WordCount)
+	s.Require().Equal(9, articles[1].ReadingMinutes)
+
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{MaxReadingMinutes: 5})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal("short", previews[0].Slug)
+	s.Require().Equal(3, previews[0].WordCount)
+	s.Require().Equal(articles[0].ReadabilityGrade, previews[0].ReadabilityGrade)
+}
+
+func (s *ArticleStoreTestSuite) TestListTags_Success() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	articles := []*store.Article{
+		// Tags with the same slug are merged.
+		{Slug: "first", Title: "first", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusPublished, Tags: []string{"Go", "go", "SQL"}},
+		{Slug: "second", Title: "second", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusPublished, Tags: []string{"GO"}},
+	}
+	for _, article := range articles {
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+
+	tags, err := s.store.ListTags(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(tags, 2)
+	s.Require().Equal("go", tags[0].Slug)
+	s.Require().Equal("Go", tags[0].Name)
+	s.Require().Equal(2, tags[0].ArticleCount)
+	s.Require().Equal("sql", tags[1].Slug)
+	s.Require().Equal(1, tags[1].ArticleCount)
+
+	tag, err := s.store.GetTagBySlug(ctx, "sql")
+	s.Require().NoError(err)
+	s.Require().Equal(tags[1].ID, tag.ID)
+
+	_, err = s.store.GetTagBySlug(ctx, "unknown")
+	s.Require().ErrorIs(err, store.ErrTagNotFound)
+}
+
+func (s *ArticleStoreTestSuite) TestSearchArticles_Success() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	articles := []*store.Article{
+		{
+			Slug:             "go-channels",
+			Title:            "Understanding Go channels",
+			PlaintextContent: "Channels let goroutines communicate.",
+			Content:          "Channels let goroutines communicate.",
+			AuthorID:         author.ID,
+			Status:           store.ArticleStatusPublished,
+		},
+		{
+			Slug:             "rust-ownership",
+			Title:            "Rust ownership",
+			PlaintextContent: "Ownership is how Rust manages memory.",
+			Content:          "Ownership is how Rust manages memory.",
+			AuthorID:         author.ID,
+			Status:           store.ArticleStatusPublished,
+		},
+	}
+	for _, article := range articles {
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+
+	results, err := s.store.SearchArticles(ctx, store.SearchArticlesParams{Query: "goroutines channel"})
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().Equal("go-channels", results[0].Slug)
+	s.Require().Greater(results[0].Rank, 0.0)
+	s.Require().Contains(results[0].Highlight, store.SearchHighlightStart+"goroutines"+store.SearchHighlightStop)
+	s.Require().False(results[0].ViewerBookmarked)
+
+	// The results carry the state of the articles for the user who searches them.
+	viewer := s.mustCreateUser()
+	s.Require().NoError(s.store.BookmarkArticle(ctx, viewer.ID, "go-channels"))
+	results, err = s.store.SearchArticles(ctx, store.SearchArticlesParams{Query: "channels", ViewerID: viewer.ID})
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().True(results[0].ViewerBookmarked)
+}
+
 func (s *ArticleStoreTestSuite) TestGetArticleBySlug_Success() {
 	ctx := context.Background()
 	author := s.mustCreateUser()
 	newArticle := s.mustCreateArticle(author.ID)
 
-	article, err := s.store.GetArticleBySlug(ctx, newArticle.Slug)
+	article, err := s.store.GetArticleBySlug(ctx, newArticle.Slug, uuid.Nil)
 
 	s.Require().NoError(err)
 	s.Require().Equal(newArticle.Slug, article.Slug)
@@ -84,42 +389,277 @@ func (s *ArticleStoreTestSuite) TestGetArticleBySlug_Success() {
 	s.Require().Equal(author.Username, article.AuthorUsername)
 }
 
-func (s *ArticleStoreTestSuite) mustCreateUser() *store.User {
-	user := store.CreateUserParams{
-		Username:     "testuser",
-		Email:        "testuser@example.com",
-		PasswordHash: []byte("passwordHash"),
+func (s *ArticleStoreTestSuite) TestListArticlesPreviews_HidesUnpublished() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	articles := []*store.Article{
+		{Slug: "draft", Title: "draft", Content: "content", AuthorID: author.ID},
+		{Slug: "pending", Title: "pending", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusPendingReview},
+		{Slug: "scheduled", Title: "scheduled", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusScheduled, PublishedAt: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+		{Slug: "published", Title: "published", Content: "content", AuthorID: author.ID,
+			Status: store.ArticleStatusPublished},
+	}
+	for _, article := range articles {
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
 	}
+	s.Require().Equal(store.ArticleStatusDraft, articles[0].Status)
 
-	createdUser, err := s.store.CreateUser(context.Background(), user)
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{})
 	s.Require().NoError(err)
-	s.mustCreateDigitalAuthor(createdUser.ID)
+	s.Require().Len(previews, 1)
+	s.Require().Equal("published", previews[0].Slug)
+
+	_, err = s.store.GetArticleBySlug(ctx, "scheduled", uuid.Nil)
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+
+	// The owner sees the articles in any status.
+	owned, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{OwnerID: author.ID})
+	s.Require().NoError(err)
+	s.Require().Len(owned, 4)
+
+	pending, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusPendingReview,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Require().Equal("pending", pending[0].Slug)
 
-	return createdUser
+	details, err := s.store.GetOwnedArticleBySlug(ctx, author.ID, "draft")
+	s.Require().NoError(err)
+	s.Require().Equal(store.ArticleStatusDraft, details.Status)
+
+	_, err = s.store.GetOwnedArticleBySlug(ctx, uuid.New(), "draft")
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
 }
 
-func (s *ArticleStoreTestSuite) mustCreateDigitalAuthor(id uuid.UUID) {
-	_, err := s.dbTestUtil.DB().ExecContext(context.Background(), `
-		INSERT INTO digital_authors (id, display_name, system_prompt)
-		VALUES ($1, $2, $3)
-	`, id, "Test Author Bot", "Write helpful articles")
+func (s *ArticleStoreTestSuite) TestUpdateArticleStatus() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	article := &store.Article{Slug: "pending", Title: "pending", Content: "content", AuthorID: author.ID,
+		Status: store.ArticleStatusPendingReview}
+	s.Require().NoError(s.store.CreateArticle(ctx, article))
+
+	// Only the owner of the digital author can review its articles.
+	_, err := s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    article.Slug,
+		OwnerID: uuid.New(),
+		Status:  store.ArticleStatusPublished,
+	})
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+
+	publishAt := time.Now().Add(time.Hour)
+	state, err := s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:      article.Slug,
+		OwnerID:   author.ID,
+		Status:    store.ArticleStatusScheduled,
+		PublishAt: publishAt,
+	})
 	s.Require().NoError(err)
+	s.Require().Equal(store.ArticleStatusScheduled, state.Status)
+	s.Require().WithinDuration(publishAt, state.PublishedAt.Time, time.Millisecond)
+
+	// A scheduled article can still be approved before its publish time.
+	state, err = s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    article.Slug,
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusPublished,
+	})
+	s.Require().NoError(err)
+	s.Require().WithinDuration(time.Now(), state.PublishedAt.Time, time.Minute)
+
+	_, err = s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().NoError(err)
+
+	// Public articles cannot be scheduled again.
+	_, err = s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:      article.Slug,
+		OwnerID:   author.ID,
+		Status:    store.ArticleStatusScheduled,
+		PublishAt: publishAt,
+	})
+	s.Require().ErrorIs(err, store.ErrInvalidStatusTransition)
 }
 
-func (s *ArticleStoreTestSuite) mustCreateArticle(authorID uuid.UUID) *store.Article {
+func (s *ArticleStoreTestSuite) TestUpdateArticleStatus_ArchivePublished() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	publishedAt := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Microsecond)
+	article := &store.Article{Slug: "published", Title: "published", Content: "content", AuthorID: author.ID,
+		Status: store.ArticleStatusPublished, PublishedAt: sql.NullTime{Time: publishedAt, Valid: true}}
+	s.Require().NoError(s.store.CreateArticle(ctx, article))
+
+	// Only articles which are not public yet can be rejected.
+	_, err := s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    article.Slug,
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusArchived,
+		From:    []store.ArticleStatus{store.ArticleStatusDraft, store.ArticleStatusPendingReview},
+	})
+	s.Require().ErrorIs(err, store.ErrInvalidStatusTransition)
+
+	state, err := s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    article.Slug,
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusArchived,
+		From:    []store.ArticleStatus{store.ArticleStatusPublished},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(store.ArticleStatusArchived, state.Status)
+	_, err = s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+
+	_, err = s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    article.Slug,
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusArchived,
+	})
+	s.Require().ErrorIs(err, store.ErrInvalidStatusTransition)
+
+	// The article is published again at its original publish time.
+	state, err = s.store.UpdateArticleStatus(ctx, store.UpdateArticleStatusParams{
+		Slug:    article.Slug,
+		OwnerID: author.ID,
+		Status:  store.ArticleStatusPublished,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(store.ArticleStatusPublished, state.Status)
+	s.Require().True(publishedAt.Equal(state.PublishedAt.Time))
+	_, err = s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().NoError(err)
+}
+
+func (s *ArticleStoreTestSuite) TestPublishNextDueArticle() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	articles := []*store.Article{
+		{Slug: "due", Title: "due", Content: "content", AuthorID: author.ID, Status: store.ArticleStatusScheduled,
+			PublishedAt: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}},
+		{Slug: "later", Title: "later", Content: "content", AuthorID: author.ID, Status: store.ArticleStatusScheduled,
+			PublishedAt: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+	}
+	for _, article := range articles {
+		s.Require().NoError(s.store.CreateArticle(ctx, article))
+	}
+
+	published, err := s.store.PublishNextDueArticle(ctx)
+	s.Require().NoError(err)
+	s.Require().NotNil(published)
+	s.Require().Equal("due", published.Slug)
+
+	_, err = s.store.GetArticleBySlug(ctx, "due", uuid.Nil)
+	s.Require().NoError(err)
+
+	// The other article is not due yet.
+	published, err = s.store.PublishNextDueArticle(ctx)
+	s.Require().NoError(err)
+	s.Require().Nil(published)
+}
+
+func (s *ArticleStoreTestSuite) TestFeedQueries() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	other := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+	otherArticle := &store.Article{Slug: "other", Content: "content", AuthorID: other.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, otherArticle))
+	draft := &store.Article{Slug: "draft", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusDraft}
+	s.Require().NoError(s.store.CreateArticle(ctx, draft))
+
+	previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{AuthorID: other.ID})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal(otherArticle.ID, previews[0].ID)
+
+	_, err = s.dbTestUtil.DB().Exec("UPDATE articles SET html_content = '<p>content</p>' WHERE id = $1", article.ID)
+	s.Require().NoError(err)
+	// The content of articles which are not public is left out.
+	contents, err := s.store.ListArticlesHTMLContent(ctx, []uuid.UUID{article.ID, draft.ID, uuid.New()})
+	s.Require().NoError(err)
+	s.Require().Equal(map[uuid.UUID]string{article.ID: "<p>content</p>"}, contents)
+
+	author, err := s.store.GetDigitalAuthor(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(user.ID, author.ID)
+	_, err = s.store.GetDigitalAuthor(ctx, uuid.New())
+	s.Require().ErrorIs(err, store.ErrDigitalAuthorNotFound)
+}
+
+func (s *ArticleStoreTestSuite) TestGetArticleSourceBySlug() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
 	article := &store.Article{
-		Title:    "Test Article",
-		Content:  "This is a test article",
-		AuthorID: authorID,
+		Slug:        "draft-article",
+		Title:       "Draft Article",
+		Description: "A draft.",
+		Content:     "# Draft",
+		AuthorID:    author.ID,
+		Tags:        []string{"Go", "Testing"},
+	}
+	s.Require().NoError(s.store.CreateArticle(ctx, article))
+
+	source, err := s.store.GetArticleSourceBySlug(ctx, "draft-article")
+	s.Require().NoError(err)
+	s.Require().Equal(article.ID, source.ID)
+	s.Require().Equal(author.ID, source.AuthorID)
+	s.Require().Equal("Draft Article", source.Title)
+	s.Require().Equal("A draft.", source.Description)
+	s.Require().Equal("# Draft", source.Content)
+	s.Require().Equal(store.ContentFormatMarkdown, source.ContentFormat)
+	s.Require().Equal([]string{"go", "testing"}, tagSlugs(source.Tags))
+	s.Require().False(source.DeletedAt.Valid)
+
+	s.Require().NoError(s.store.DeleteOwnedArticle(ctx, author.ID, "draft-article"))
+	source, err = s.store.GetArticleSourceBySlug(ctx, "draft-article")
+	s.Require().NoError(err)
+	s.Require().True(source.DeletedAt.Valid)
+
+	_, err = s.store.GetArticleSourceBySlug(ctx, "unknown")
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+}
+
+func (s *ArticleStoreTestSuite) TestUpdateArticle_ReplacesTags() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+	article := &store.Article{
+		Slug:     "tagged-article",
+		Title:    "Tagged Article",
+		Content:  "Content",
+		AuthorID: author.ID,
+		Tags:     []string{"Go", "Testing"},
+	}
+	s.Require().NoError(s.store.CreateArticle(ctx, article))
+
+	params := store.UpdateArticleParams{
+		ID:            article.ID,
+		Title:         article.Title,
+		Content:       article.Content,
+		ContentFormat: store.ContentFormatMarkdown,
 	}
+	s.Require().NoError(s.store.UpdateArticle(ctx, params))
+	source, err := s.store.GetArticleSourceBySlug(ctx, "tagged-article")
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"go", "testing"}, tagSlugs(source.Tags))
 
-	err := s.store.CreateArticle(context.Background(), article)
+	params.Tags = []string{"Databases", "go"}
+	s.Require().NoError(s.store.UpdateArticle(ctx, params))
+	source, err = s.store.GetArticleSourceBySlug(ctx, "tagged-article")
 	s.Require().NoError(err)
+	s.Require().Equal([]string{"databases", "go"}, tagSlugs(source.Tags))
 
-	err = s.dbTestUtil.DB().GetContext(context.Background(), article, `
-		SELECT * FROM articles LIMIT 1`,
-	)
+	params.Tags = []string{}
+	s.Require().NoError(s.store.UpdateArticle(ctx, params))
+	source, err = s.store.GetArticleSourceBySlug(ctx, "tagged-article")
 	s.Require().NoError(err)
+	s.Require().Empty(source.Tags)
+}
 
-	return article
+func tagSlugs(tags store.TagList) []string {
+	slugs := make([]string, len(tags))
+	for i, tag := range tags {
+		slugs[i] = tag.Slug
+	}
+	return slugs
 }
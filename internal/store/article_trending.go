@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// The weights of each kind of engagement in the trending score of an article. Rarer engagements show more interest
+// in an article, so they weigh more.
+const (
+	trendingViewWeight     = 1
+	trendingClapWeight     = 2
+	trendingCommentWeight  = 5
+	trendingBookmarkWeight = 8
+)
+
+// articleTrendingScoreExpr is the trending score of the article "a", or 0 if it has not been computed yet.
+const articleTrendingScoreExpr = "COALESCE((SELECT ts.score FROM article_trending_scores ts WHERE ts.article_id = a.id), 0)"
+
+// RefreshTrendingScores recomputes the trending scores of all published articles as of params.Now, and returns
+// the number of scored articles. Each engagement with an article counts for half as much after every
+// params.HalfLife, so that recent engagement ranks higher. Engagement after params.Now is ignored.
+func (p *Store) RefreshTrendingScores(ctx context.Context, params RefreshTrendingScoresParams) (int, error) {
+	var scored int
+	err := p.withTx(ctx, func(tx *sqlx.Tx) error {
+		// The decay of an engagement which happened at time t is 0.5 ^ ((now - t) / half-life).
+		// Daily views are assumed to happen at noon, or now for the views of today before noon.
+		err := tx.GetContext(ctx, &scored, `
+			WITH engagements AS (
+				SELECT article_id, $3::integer * views AS weight,
+					LEAST((day + TIME '12:00') AT TIME ZONE 'UTC', $1::timestamptz) AS happened_at
+				FROM article_daily_stats
+				WHERE day <= ($1::timestamptz AT TIME ZONE 'UTC')::date
+				UNION ALL
+				SELECT article_id, $4::integer * count, created_at FROM article_clap_events
+				UNION ALL
+				SELECT article_id, $5::integer, created_at FROM comments
+				WHERE deleted_at IS NULL AND moderation_status <> 'hidden'
+				UNION ALL
+				SELECT article_id, $6::integer, created_at FROM bookmarks
+			), scores AS (
+				SELECT a.id AS article_id, COALESCE(SUM(
+					e.weight * power(0.5, EXTRACT(EPOCH FROM $1::timestamptz - e.happened_at) / $2::double precision)), 0) AS score
+				FROM articles a
+				LEFT JOIN engagements e ON e.article_id = a.id AND e.happened_at <= $1::timestamptz
+				WHERE `+articleIsPublic+`
+				GROUP BY a.id
+			), upserted AS (
+				INSERT INTO article_trending_scores (article_id, score, refreshed_at)
+				SELECT article_id, score, $1::timestamptz FROM scores
+				ON CONFLICT (article_id) DO UPDATE SET score = EXCLUDED.score, refreshed_at = EXCLUDED.refreshed_at
+				RETURNING article_id
+			)
+			SELECT COUNT(*) FROM upserted`,
+			params.Now, params.HalfLife.Seconds(),
+			trendingViewWeight, trendingClapWeight, trendingCommentWeight, trendingBookmarkWeight)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		// Remove the scores of articles which are no longer public, e.g. after they were archived.
+		_, err = tx.ExecContext(ctx,
+			`DELETE FROM article_trending_scores WHERE refreshed_at <> $1::timestamptz`, params.Now)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return scored, nil
+}
+
+type RefreshTrendingScoresParams struct {
+	// Now is the time the scores are computed for.
+	Now time.Time
+	// HalfLife is the time after which an engagement counts for half as much. It must be positive.
+	HalfLife time.Duration
+}
@@ -0,0 +1,116 @@
+package store_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestArticleTrendingStore(t *testing.T) {
+	suite.Run(t, new(ArticleTrendingStoreTestSuite))
+}
+
+type ArticleTrendingStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ArticleTrendingStoreTestSuite) TestRefreshTrendingScores() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	bookmarked := &store.Article{Slug: "bookmarked", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, bookmarked))
+	viewed := &store.Article{Slug: "viewed", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, viewed))
+
+	now := time.Now().Add(time.Minute)
+	s.Require().NoError(s.store.BookmarkArticle(ctx, user.ID, bookmarked.Slug))
+	// Many views, but 10 days ago.
+	_, err := s.dbTestUtil.DB().ExecContext(ctx,
+		`INSERT INTO article_daily_stats (article_id, day, views) VALUES ($1, $2, 100)`,
+		viewed.ID, now.AddDate(0, 0, -10).UTC().Format(time.DateOnly))
+	s.Require().NoError(err)
+
+	listTrending := func() []store.ArticlePreview {
+		previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+			OrderBy: store.ArticleOrderTrending,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(previews, 2)
+		return previews
+	}
+
+	// With a short half-life, the old views have mostly decayed.
+	scored, err := s.store.RefreshTrendingScores(ctx, store.RefreshTrendingScoresParams{Now: now, HalfLife: 24 * time.Hour})
+	s.Require().NoError(err)
+	s.Require().Equal(2, scored)
+	previews := listTrending()
+	s.Require().Equal(bookmarked.ID, previews[0].ID)
+	s.Require().InDelta(8, previews[0].TrendingScore, 0.01)
+
+	// With a long half-life, the old views still count.
+	_, err = s.store.RefreshTrendingScores(ctx, store.RefreshTrendingScoresParams{Now: now, HalfLife: 30 * 24 * time.Hour})
+	s.Require().NoError(err)
+	previews = listTrending()
+	s.Require().Equal(viewed.ID, previews[0].ID)
+
+	previews, err = s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+		OrderBy: store.ArticleOrderTrending,
+		After: &store.ArticlePreviewCursor{
+			TrendingScore: previews[0].TrendingScore,
+			PublishedAt:   previews[0].ListedAt(),
+			ID:            previews[0].ID,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(previews, 1)
+	s.Require().Equal(bookmarked.ID, previews[0].ID)
+}
+
+func (s *ArticleTrendingStoreTestSuite) TestRefreshTrendingScores_EngagementTimes() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	viewed := &store.Article{Slug: "viewed", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, viewed))
+	clapped := &store.Article{Slug: "clapped", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, clapped))
+	trendingScore := func(now time.Time, id uuid.UUID) float64 {
+		_, err := s.store.RefreshTrendingScores(ctx, store.RefreshTrendingScoresParams{Now: now, HalfLife: 24 * time.Hour})
+		s.Require().NoError(err)
+		previews, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{
+			OrderBy: store.ArticleOrderTrending,
+		})
+		s.Require().NoError(err)
+		for _, preview := range previews {
+			if preview.ID == id {
+				return preview.TrendingScore
+			}
+		}
+		s.FailNow("article not scored")
+		return 0
+	}
+
+	// The views of today count in full before noon, and the views of later days are ignored.
+	morning := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	_, err := s.dbTestUtil.DB().ExecContext(ctx,
+		`INSERT INTO article_daily_stats (article_id, day, views) VALUES ($1, '2026-10-01', 10), ($1, '2026-10-02', 100)`,
+		viewed.ID)
+	s.Require().NoError(err)
+	s.Require().InDelta(10, trendingScore(morning, viewed.ID), 0.01)
+
+	// Old claps keep decaying from the time they were given when the user claps again.
+	_, err = s.store.ClapArticle(ctx, store.ClapArticleParams{Slug: clapped.Slug, UserID: user.ID, Count: 40})
+	s.Require().NoError(err)
+	_, err = s.dbTestUtil.DB().ExecContext(ctx,
+		`UPDATE article_clap_events SET created_at = created_at - INTERVAL '10 days' WHERE article_id = $1`, clapped.ID)
+	s.Require().NoError(err)
+	// Only the claps below the cap are recorded.
+	_, err = s.store.ClapArticle(ctx, store.ClapArticleParams{Slug: clapped.Slug, UserID: user.ID, Count: 20})
+	s.Require().NoError(err)
+	s.Require().InDelta(2*40*math.Pow(0.5, 10)+2*10, trendingScore(time.Now().Add(time.Minute), clapped.ID), 0.05)
+}
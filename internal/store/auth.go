@@ -9,7 +9,7 @@ import (
 func (r *Store) GetUser(ctx context.Context, emailOrUsername string) (*User, error) {
 	var user User
 	err := r.db.GetContext(ctx, &user,
-		`SELECT id, username, email, password_hash
+		`SELECT id, username, email, password_hash, role
 		FROM users 
 		WHERE (email = $1 OR username = $2) LIMIT 1`,
 		emailOrUsername,
@@ -33,7 +33,7 @@ func (r *Store) CreateUser(ctx context.Context, params CreateUserParams) (*User,
 	err := r.db.GetContext(ctx, user,
 		`INSERT INTO users (email, username, password_hash) 
 		VALUES ($1, $2, $3) 
-		RETURNING id, username, email`,
+		RETURNING id, username, email, role`,
 		params.Email,
 		params.Username,
 		params.PasswordHash,
@@ -52,7 +52,7 @@ func (r *Store) CreateUser(ctx context.Context, params CreateUserParams) (*User,
 func (r *Store) GetUserByID(ctx context.Context, userID string) (*User, error) {
 	var user User
 	err := r.db.GetContext(ctx, &user,
-		`SELECT id, username, email, password_hash
+		`SELECT id, username, email, password_hash, role
 		FROM users 
 		WHERE id = $1`,
 		userID)
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// BookmarkArticle bookmarks a published article for a user. Bookmarking an article twice has no effect.
+func (p *Store) BookmarkArticle(ctx context.Context, userID uuid.UUID, slug string) error {
+	query, args, err := p.qb.
+		Insert("bookmarks").
+		Columns("user_id", "article_id").
+		Select(p.qb.
+			Select().
+			Column("?::uuid", userID).
+			Column("a.id").
+			From("articles a").
+			Where(sq.Eq{"a.slug": slug}).
+			Where(articleIsPublic)).
+		// Update the existing bookmark without changing it, so that its article ID is returned.
+		Suffix("ON CONFLICT (user_id, article_id) DO UPDATE SET created_at = bookmarks.created_at RETURNING article_id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var articleID uuid.UUID
+	err = p.db.GetContext(ctx, &articleID, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrArticleNotFound
+		}
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBookmark removes the bookmark of a user on an article. Removing a bookmark which does not exist
+// has no effect.
+func (p *Store) DeleteBookmark(ctx context.Context, userID uuid.UUID, slug string) error {
+	query, args, err := p.qb.
+		Delete("bookmarks b").
+		Suffix("USING articles a WHERE a.id = b.article_id AND a.slug = ? AND b.user_id = ?", slug, userID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return nil
+}
+
+// ListBookmarkedArticles lists the published articles bookmarked by a user, most recently bookmarked first.
+// Results are paginated with a keyset on (bookmark created_at, article id): pass the last item of the previous
+// page as params.After to fetch the next page.
+func (p *Store) ListBookmarkedArticles(ctx context.Context, params ListBookmarkedArticlesParams) ([]BookmarkedArticle, error) {
+	articles := []BookmarkedArticle{}
+
+	builder := p.qb.
+		Select(articlePreviewColumns...).
+		Column(articleViewerColumns(params.UserID)).
+		Column("b.created_at AS bookmarked_at").
+		From("bookmarks b").
+		InnerJoin("articles a ON a.id = b.article_id").
+		InnerJoin("digital_authors da ON a.author_id = da.id").
+		Where(sq.Eq{"b.user_id": params.UserID}).
+		Where(articleIsPublic).
+		OrderBy("b.created_at DESC", "a.id DESC")
+
+	if params.After != nil {
+		builder = builder.Where("(b.created_at, a.id) < (?, ?)", params.After.BookmarkedAt, params.After.ID)
+	}
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &articles, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return articles, nil
+}
+
+type ListBookmarkedArticlesParams struct {
+	UserID uuid.UUID
+	// Limit is the maximum number of articles to return. No limit is applied if it is zero.
+	Limit int
+	// After is an optional cursor. If set, only articles that come after it in the listing order are returned.
+	After *BookmarkCursor
+}
+
+// BookmarkCursor identifies a position in the bookmarked articles listing.
+type BookmarkCursor struct {
+	BookmarkedAt time.Time
+	ID           uuid.UUID
+}
@@ -0,0 +1,57 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestBookmarkStore(t *testing.T) {
+	suite.Run(t, new(BookmarkStoreTestSuite))
+}
+
+type BookmarkStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *BookmarkStoreTestSuite) TestBookmarks() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	first := &store.Article{Slug: "first", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, first))
+	second := &store.Article{Slug: "second", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, second))
+
+	s.Require().ErrorIs(s.store.BookmarkArticle(ctx, user.ID, "unknown"), store.ErrArticleNotFound)
+	s.Require().NoError(s.store.BookmarkArticle(ctx, user.ID, first.Slug))
+	s.Require().NoError(s.store.BookmarkArticle(ctx, user.ID, second.Slug))
+	// Bookmarking an article twice has no effect.
+	s.Require().NoError(s.store.BookmarkArticle(ctx, user.ID, first.Slug))
+
+	bookmarks, err := s.store.ListBookmarkedArticles(ctx, store.ListBookmarkedArticlesParams{UserID: user.ID})
+	s.Require().NoError(err)
+	s.Require().Len(bookmarks, 2)
+	s.Require().Equal(second.ID, bookmarks[0].ID)
+	s.Require().True(bookmarks[0].ViewerBookmarked)
+
+	bookmarks, err = s.store.ListBookmarkedArticles(ctx, store.ListBookmarkedArticlesParams{
+		UserID: user.ID,
+		After:  &store.BookmarkCursor{BookmarkedAt: bookmarks[0].BookmarkedAt, ID: bookmarks[0].ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(bookmarks, 1)
+	s.Require().Equal(first.ID, bookmarks[0].ID)
+
+	details, err := s.store.GetArticleBySlug(ctx, first.Slug, user.ID)
+	s.Require().NoError(err)
+	s.Require().True(details.ViewerBookmarked)
+
+	s.Require().NoError(s.store.DeleteBookmark(ctx, user.ID, first.Slug))
+	s.Require().NoError(s.store.DeleteBookmark(ctx, user.ID, first.Slug))
+	details, err = s.store.GetArticleBySlug(ctx, first.Slug, user.ID)
+	s.Require().NoError(err)
+	s.Require().False(details.ViewerBookmarked)
+}
@@ -0,0 +1,282 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// commentColumns are the columns scanned into a Comment. Queries using them must select from comments "c"
+// joined with users "u".
+var commentColumns = []string{
+	"c.id", "c.article_id", "c.parent_id", "c.body", "c.moderation_status", "c.user_id AS author_id",
+	"u.username AS author_username", "u.display_name AS author_display_name", "u.avatar_url AS author_avatar_url",
+	"c.created_at", "c.updated_at", "c.edited_at", "c.deleted_at",
+}
+
+// GetArticleIDBySlug returns the ID of the published article with the given slug.
+func (p *Store) GetArticleIDBySlug(ctx context.Context, slug string) (uuid.UUID, error) {
+	query, args, err := p.qb.
+		Select("a.id").
+		From("articles a").
+		Where(sq.Eq{"a.slug": slug}).
+		Where(articleIsPublic).
+		ToSql()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var id uuid.UUID
+	err = p.db.GetContext(ctx, &id, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrArticleNotFound
+		}
+		return uuid.Nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return id, nil
+}
+
+// CreateComment creates a comment on an article. If params.ParentID is set, the comment replies to another
+// comment on the same article, which must not be deleted; otherwise ErrCommentNotFound is returned.
+func (p *Store) CreateComment(ctx context.Context, params CreateCommentParams) (*Comment, error) {
+	var id uuid.UUID
+	err := p.withTx(ctx, func(tx *sqlx.Tx) error {
+		if params.ParentID.Valid {
+			var parentExists bool
+			err := tx.GetContext(ctx, &parentExists, `
+				SELECT EXISTS (SELECT 1 FROM comments WHERE id = $1 AND article_id = $2 AND deleted_at IS NULL)`,
+				params.ParentID, params.ArticleID)
+			if err != nil {
+				return fmt.Errorf("failed to execute SQL query: %w", err)
+			}
+			if !parentExists {
+				return fmt.Errorf("%w: parent comment %s", ErrCommentNotFound, params.ParentID.UUID)
+			}
+		}
+
+		query, args, err := p.qb.
+			Insert("comments").
+			Columns("article_id", "parent_id", "user_id", "body").
+			Values(params.ArticleID, params.ParentID, params.UserID, params.Body).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %w", err)
+		}
+
+		if err := tx.GetContext(ctx, &id, query, args...); err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getComment(ctx, id)
+}
+
+type CreateCommentParams struct {
+	ArticleID uuid.UUID
+	// ParentID is the comment to reply to. It is null for top-level comments.
+	ParentID uuid.NullUUID
+	UserID   uuid.UUID
+	Body     string
+}
+
+func (p *Store) getComment(ctx context.Context, id uuid.UUID) (*Comment, error) {
+	query, args, err := p.qb.
+		Select(commentColumns...).
+		From("comments c").
+		InnerJoin("users u ON u.id = c.user_id").
+		Where(sq.Eq{"c.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var comment Comment
+	err = p.db.GetContext(ctx, &comment, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// ListComments lists the top-level comments of an article, oldest first, including deleted and hidden comments.
+// Results are paginated with a keyset on (created_at, id): pass the last item of the previous page as
+// params.After to fetch the next page.
+func (p *Store) ListComments(ctx context.Context, params ListCommentsParams) ([]Comment, error) {
+	comments := []Comment{}
+
+	builder := p.qb.
+		Select(commentColumns...).
+		From("comments c").
+		InnerJoin("users u ON u.id = c.user_id").
+		Where(sq.Eq{"c.article_id": params.ArticleID}).
+		Where("c.parent_id IS NULL").
+		OrderBy("c.created_at", "c.id")
+
+	if params.After != nil {
+		builder = builder.Where("(c.created_at, c.id) > (?, ?)", params.After.CreatedAt, params.After.ID)
+	}
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &comments, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return comments, nil
+}
+
+type ListCommentsParams struct {
+	ArticleID uuid.UUID
+	// Limit is the maximum number of comments to return. No limit is applied if it is zero.
+	Limit int
+	// After is an optional cursor. If set, only comments that come after it in the listing order are returned.
+	After *CommentCursor
+}
+
+// CommentCursor identifies a position in the comments listing.
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// ListCommentReplies lists all direct and indirect replies of the given comments, oldest first.
+func (p *Store) ListCommentReplies(ctx context.Context, commentIDs []uuid.UUID) ([]Comment, error) {
+	replies := []Comment{}
+	if len(commentIDs) == 0 {
+		return replies, nil
+	}
+
+	ids := make([]string, len(commentIDs))
+	for i, id := range commentIDs {
+		ids[i] = id.String()
+	}
+
+	query, args, err := p.qb.
+		Select(commentColumns...).
+		Prefix(`WITH RECURSIVE thread AS (
+			SELECT id FROM comments WHERE parent_id = ANY(?::uuid[])
+			UNION ALL
+			SELECT r.id FROM comments r INNER JOIN thread t ON r.parent_id = t.id
+		)`, pq.Array(ids)).
+		From("comments c").
+		InnerJoin("thread t ON t.id = c.id").
+		InnerJoin("users u ON u.id = c.user_id").
+		OrderBy("c.created_at", "c.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &replies, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return replies, nil
+}
+
+// UpdateComment replaces the body of a comment written by the given user. Deleted comments cannot be edited.
+func (p *Store) UpdateComment(ctx context.Context, params UpdateCommentParams) (*Comment, error) {
+	query, args, err := p.qb.
+		Update("comments").
+		Set("body", params.Body).
+		Set("edited_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": params.ID, "user_id": params.UserID, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if err := p.execCommentUpdate(ctx, query, args); err != nil {
+		return nil, err
+	}
+
+	return p.getComment(ctx, params.ID)
+}
+
+type UpdateCommentParams struct {
+	ID uuid.UUID
+	// UserID is the ID of the user who edits the comment, who must be its author.
+	UserID uuid.UUID
+	Body   string
+}
+
+// DeleteComment soft-deletes a comment written by the given user. The comment is kept so that its replies
+// stay in the thread, but its body must not be shown anymore.
+func (p *Store) DeleteComment(ctx context.Context, id, userID uuid.UUID) error {
+	query, args, err := p.qb.
+		Update("comments").
+		Set("deleted_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": id, "user_id": userID, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	return p.execCommentUpdate(ctx, query, args)
+}
+
+// SetCommentModerationStatus changes the moderation status of a comment. The caller must check that the current
+// user is a moderator.
+func (p *Store) SetCommentModerationStatus(ctx context.Context, id uuid.UUID, status CommentModerationStatus) (*Comment, error) {
+	query, args, err := p.qb.
+		Update("comments").
+		Set("moderation_status", status).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	if err := p.execCommentUpdate(ctx, query, args); err != nil {
+		return nil, err
+	}
+
+	return p.getComment(ctx, id)
+}
+
+// execCommentUpdate runs an update of a single comment, and returns ErrCommentNotFound if no comment was updated.
+func (p *Store) execCommentUpdate(ctx context.Context, query string, args []any) error {
+	result, err := p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+
+	return nil
+}
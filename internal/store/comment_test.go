@@ -0,0 +1,78 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestCommentStore(t *testing.T) {
+	suite.Run(t, new(CommentStoreTestSuite))
+}
+
+type CommentStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *CommentStoreTestSuite) TestComments() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+
+	root, err := s.store.CreateComment(ctx, store.CreateCommentParams{
+		ArticleID: article.ID,
+		UserID:    user.ID,
+		Body:      "Root",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("testuser", root.AuthorUsername)
+	s.Require().Equal(store.CommentModerationStatusVisible, root.ModerationStatus)
+
+	reply, err := s.store.CreateComment(ctx, store.CreateCommentParams{
+		ArticleID: article.ID,
+		ParentID:  uuid.NullUUID{UUID: root.ID, Valid: true},
+		UserID:    user.ID,
+		Body:      "Reply",
+	})
+	s.Require().NoError(err)
+	nested, err := s.store.CreateComment(ctx, store.CreateCommentParams{
+		ArticleID: article.ID,
+		ParentID:  uuid.NullUUID{UUID: reply.ID, Valid: true},
+		UserID:    user.ID,
+		Body:      "Nested",
+	})
+	s.Require().NoError(err)
+
+	roots, err := s.store.ListComments(ctx, store.ListCommentsParams{ArticleID: article.ID})
+	s.Require().NoError(err)
+	s.Require().Len(roots, 1)
+	replies, err := s.store.ListCommentReplies(ctx, []uuid.UUID{root.ID})
+	s.Require().NoError(err)
+	s.Require().Len(replies, 2)
+	s.Require().Equal(nested.ID, replies[1].ID)
+
+	_, err = s.store.UpdateComment(ctx, store.UpdateCommentParams{ID: reply.ID, UserID: uuid.New(), Body: "Hijacked"})
+	s.Require().ErrorIs(err, store.ErrCommentNotFound)
+	edited, err := s.store.UpdateComment(ctx, store.UpdateCommentParams{ID: reply.ID, UserID: user.ID, Body: "Edited"})
+	s.Require().NoError(err)
+	s.Require().Equal("Edited", edited.Body)
+	s.Require().True(edited.EditedAt.Valid)
+
+	s.Require().NoError(s.store.DeleteComment(ctx, reply.ID, user.ID))
+	s.Require().ErrorIs(s.store.DeleteComment(ctx, reply.ID, user.ID), store.ErrCommentNotFound)
+	_, err = s.store.CreateComment(ctx, store.CreateCommentParams{
+		ArticleID: article.ID,
+		ParentID:  uuid.NullUUID{UUID: reply.ID, Valid: true},
+		UserID:    user.ID,
+		Body:      "Reply to deleted",
+	})
+	s.Require().ErrorIs(err, store.ErrCommentNotFound)
+
+	hidden, err := s.store.SetCommentModerationStatus(ctx, root.ID, store.CommentModerationStatusHidden)
+	s.Require().NoError(err)
+	s.Require().Equal(store.CommentModerationStatusHidden, hidden.ModerationStatus)
+}
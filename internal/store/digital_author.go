@@ -2,16 +2,22 @@ package store
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
-// ListDigitalAuthorsWithArticleSlugs returns a list of digital authors along with the slugs of their existing articles.
-// This is used avoid duplication when generating new articles.
+// ListDigitalAuthorsWithArticleSlugs returns a list of digital authors along with the slugs of their existing articles
+// and their latest series, unless it is completed. This is used avoid duplication when generating new articles.
 func (p *Store) ListDigitalAuthorsWithArticleSlugs(ctx context.Context) ([]*DigitalAuthorWithArticleSlugs, error) {
 	rows, err := p.qb.
-		Select("da.id", "da.system_prompt", "COALESCE(ARRAY_AGG(a.slug) FILTER (WHERE a.slug IS NOT NULL), '{}') AS article_slugs").
+		Select("da.id", "da.system_prompt", "COALESCE(ARRAY_AGG(a.slug) FILTER (WHERE a.slug IS NOT NULL), '{}') AS article_slugs",
+			"(SELECT latest.id FROM (SELECT s.id, s.completed_at FROM series s WHERE s.author_id = da.id "+
+				"ORDER BY s.created_at DESC LIMIT 1) latest WHERE latest.completed_at IS NULL) AS series_id").
 		From("digital_authors da").
 		LeftJoin("articles a ON a.author_id = da.id").
 		GroupBy("da.id", "da.system_prompt").
@@ -24,7 +30,7 @@ func (p *Store) ListDigitalAuthorsWithArticleSlugs(ctx context.Context) ([]*Digi
 	items := make([]*DigitalAuthorWithArticleSlugs, 0)
 	for rows.Next() {
 		var item DigitalAuthorWithArticleSlugs
-		err = rows.Scan(&item.ID, &item.SystemPrompt, pq.Array(&item.ArticleSlugs))
+		err = rows.Scan(&item.ID, &item.SystemPrompt, pq.Array(&item.ArticleSlugs), &item.SeriesID)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning item: %v", err)
 		}
@@ -38,17 +44,22 @@ func (p *Store) ListDigitalAuthorsWithArticleSlugs(ctx context.Context) ([]*Digi
 	return items, nil
 }
 
-func (p *Store) ListDigitalAuthors(ctx context.Context) ([]*DigitalAuthor, error) {
-	query, _, err := p.qb.
-		Select("id", "display_name", "system_prompt", "created_at").
-		From("digital_authors").
+// ListDigitalAuthors returns all digital authors with their number of followers. viewerID is the ID of the current
+// user, or uuid.Nil for anonymous users. It is used to report whether the user follows each digital author.
+func (p *Store) ListDigitalAuthors(ctx context.Context, viewerID uuid.UUID) ([]*DigitalAuthor, error) {
+	query, args, err := p.qb.
+		Select("da.id", "da.display_name", "da.system_prompt", "da.created_at",
+			"(SELECT COUNT(*) FROM follows f WHERE f.author_id = da.id) AS follower_count").
+		Column("EXISTS (SELECT 1 FROM follows vf WHERE vf.author_id = da.id AND vf.user_id = ?) AS viewer_following",
+			viewerID).
+		From("digital_authors da").
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
 	var digitalAuthors []*DigitalAuthor
-	err = p.db.SelectContext(ctx, &digitalAuthors, query)
+	err = p.db.SelectContext(ctx, &digitalAuthors, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +67,35 @@ func (p *Store) ListDigitalAuthors(ctx context.Context) ([]*DigitalAuthor, error
 	return digitalAuthors, nil
 }
 
+// GetDigitalAuthor returns the digital author with the given ID. It returns ErrDigitalAuthorNotFound if there is
+// no such digital author.
+func (p *Store) GetDigitalAuthor(ctx context.Context, id uuid.UUID) (*DigitalAuthor, error) {
+	query, args, err := p.qb.
+		Select("da.id", "da.display_name", "da.system_prompt", "da.created_at").
+		From("digital_authors da").
+		Where(sq.Eq{"da.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var digitalAuthor DigitalAuthor
+	err = p.db.GetContext(ctx, &digitalAuthor, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDigitalAuthorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &digitalAuthor, nil
+}
+
 func (s *Store) CreateDigitalAuthor(ctx context.Context, params CreateDigitalAuthorParams) (*DigitalAuthor, error) {
 	query, args, err := s.qb.
 		Insert("digital_authors").
-		Columns("display_name", "system_prompt").
-		Values(params.DisplayName, params.SystemPrompt).
+		Columns("display_name", "system_prompt", "owner_id").
+		Values(params.DisplayName, params.SystemPrompt, params.OwnerID).
 		Suffix("RETURNING id, display_name, system_prompt, created_at").
 		ToSql()
 	if err != nil {
@@ -77,4 +112,6 @@ func (s *Store) CreateDigitalAuthor(ctx context.Context, params CreateDigitalAut
 type CreateDigitalAuthorParams struct {
 	DisplayName  string
 	SystemPrompt string
+	// OwnerID is the ID of the user who reviews the articles of the digital author.
+	OwnerID uuid.UUID
 }
@@ -3,7 +3,18 @@ package store
 import "errors"
 
 var (
-	ErrArticleNotFound   = errors.New("article not found")
-	ErrUserNotFound      = errors.New("user not found")
-	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrArticleNotFound         = errors.New("article not found")
+	ErrArticleRevisionNotFound = errors.New("article revision not found")
+	ErrArticleSlugTaken        = errors.New("article slug is already taken")
+	ErrCommentNotFound         = errors.New("comment not found")
+	ErrDigitalAuthorNotFound   = errors.New("digital author not found")
+	ErrInvalidStatusTransition = errors.New("invalid article status transition")
+	ErrReadingListNameTaken    = errors.New("reading list name is already taken")
+	ErrReadingListNotFound     = errors.New("reading list not found")
+	ErrReportAlreadyResolved   = errors.New("report is already resolved")
+	ErrReportNotFound          = errors.New("report not found")
+	ErrSeriesNotFound          = errors.New("series not found")
+	ErrTagNotFound             = errors.New("tag not found")
+	ErrUserNotFound            = errors.New("user not found")
+	ErrUserAlreadyExists       = errors.New("user already exists")
 )
@@ -0,0 +1,91 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/testutil"
+)
+
+// storeTestSuite runs a database for the store test suites which embed it, and creates the records their tests
+// need. The database is reset before each test.
+type storeTestSuite struct {
+	suite.Suite
+	dbTestUtil *testutil.DatabaseTestUtil
+	store      *store.Store
+	// userCount is the number of users created by mustCreateUser in the current test.
+	userCount int
+}
+
+func (s *storeTestSuite) SetupSuite() {
+	var err error
+	s.dbTestUtil, err = testutil.NewDatabaseTestUtil()
+	s.Require().NoError(err)
+
+	s.store = store.New(s.dbTestUtil.DB())
+}
+
+func (s *storeTestSuite) BeforeTest(suiteName, testName string) {
+	err := s.dbTestUtil.Reset()
+	s.Require().NoError(err)
+	s.userCount = 0
+}
+
+func (s *storeTestSuite) TearDownSuite() {
+	err := s.dbTestUtil.Teardown()
+	s.Require().NoError(err)
+}
+
+// mustCreateUser creates a user, and a digital author with the same ID. The first user of each test is named
+// "testuser", and the next ones are given unique names.
+func (s *storeTestSuite) mustCreateUser() *store.User {
+	username := "testuser"
+	if s.userCount > 0 {
+		username = fmt.Sprintf("testuser%d", s.userCount)
+	}
+	s.userCount++
+	user := store.CreateUserParams{
+		Username:     username,
+		Email:        username + "@example.com",
+		PasswordHash: []byte("passwordHash"),
+	}
+
+	createdUser, err := s.store.CreateUser(context.Background(), user)
+	s.Require().NoError(err)
+	s.mustCreateDigitalAuthor(createdUser.ID)
+
+	return createdUser
+}
+
+func (s *storeTestSuite) mustCreateDigitalAuthor(id uuid.UUID) {
+	_, err := s.dbTestUtil.DB().ExecContext(context.Background(), `
+		INSERT INTO digital_authors (id, display_name, system_prompt, owner_id)
+		VALUES ($1, $2, $3, $1)
+	`, id, "Test Author Bot", "Write helpful articles")
+	s.Require().NoError(err)
+}
+
+func (s *storeTestSuite) mustCreateArticle(authorID uuid.UUID) *store.Article {
+	article := &store.Article{
+		Title:    "Test Article",
+		Content:  "This is a test article",
+		AuthorID: authorID,
+		Status:   store.ArticleStatusPublished,
+	}
+
+	err := s.store.CreateArticle(context.Background(), article)
+	s.Require().NoError(err)
+
+	err = s.dbTestUtil.DB().GetContext(context.Background(), article, `
+		SELECT id, slug, title, description, plaintext_content, content, content_format, html_content,
+			author_id, status, published_at, created_at, updated_at
+		FROM articles LIMIT 1`,
+	)
+	s.Require().NoError(err)
+
+	return article
+}
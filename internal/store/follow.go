@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// FollowDigitalAuthor makes a user follow a digital author. Following a digital author twice has no effect.
+func (p *Store) FollowDigitalAuthor(ctx context.Context, userID, authorID uuid.UUID) error {
+	query, args, err := p.qb.
+		Insert("follows").
+		Columns("user_id", "author_id").
+		Select(p.qb.
+			Select().
+			Column("?::uuid", userID).
+			Column("da.id").
+			From("digital_authors da").
+			Where(sq.Eq{"da.id": authorID})).
+		// Update the existing follow without changing it, so that its author ID is returned.
+		Suffix("ON CONFLICT (user_id, author_id) DO UPDATE SET created_at = follows.created_at RETURNING author_id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var followedID uuid.UUID
+	err = p.db.GetContext(ctx, &followedID, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrDigitalAuthorNotFound
+		}
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return nil
+}
+
+// UnfollowDigitalAuthor makes a user stop following a digital author. Unfollowing a digital author which is not
+// followed has no effect.
+func (p *Store) UnfollowDigitalAuthor(ctx context.Context, userID, authorID uuid.UUID) error {
+	query, args, err := p.qb.
+		Delete("follows").
+		Where(sq.Eq{"user_id": userID, "author_id": authorID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return nil
+}
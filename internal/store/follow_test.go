@@ -0,0 +1,55 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestFollowStore(t *testing.T) {
+	suite.Run(t, new(FollowStoreTestSuite))
+}
+
+type FollowStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *FollowStoreTestSuite) TestFollows() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+	other := &store.Article{Slug: "other", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusPublished}
+	s.Require().NoError(s.store.CreateArticle(ctx, other))
+
+	// Nothing is in the feed until the user follows a digital author.
+	feed, err := s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{FollowerID: user.ID})
+	s.Require().NoError(err)
+	s.Require().Empty(feed)
+
+	s.Require().ErrorIs(s.store.FollowDigitalAuthor(ctx, user.ID, uuid.New()), store.ErrDigitalAuthorNotFound)
+	s.Require().NoError(s.store.FollowDigitalAuthor(ctx, user.ID, user.ID))
+	// Following a digital author twice has no effect.
+	s.Require().NoError(s.store.FollowDigitalAuthor(ctx, user.ID, user.ID))
+
+	feed, err = s.store.ListArticlesPreviews(ctx, store.ListArticlesPreviewsParams{FollowerID: user.ID})
+	s.Require().NoError(err)
+	s.Require().Len(feed, 2)
+	s.Require().Equal(other.ID, feed[0].ID)
+	s.Require().Equal(article.ID, feed[1].ID)
+
+	authors, err := s.store.ListDigitalAuthors(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Require().Len(authors, 1)
+	s.Require().Equal(1, authors[0].FollowerCount)
+	s.Require().True(authors[0].ViewerFollowing)
+
+	s.Require().NoError(s.store.UnfollowDigitalAuthor(ctx, user.ID, user.ID))
+	authors, err = s.store.ListDigitalAuthors(ctx, uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().Equal(0, authors[0].FollowerCount)
+	s.Require().False(authors[0].ViewerFollowing)
+}
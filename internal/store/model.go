@@ -2,6 +2,8 @@ package store
 
 import (
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,7 +12,23 @@ import (
 type ContentFormat string
 
 const (
-	ContentFormatHTML ContentFormat = "html"
+	ContentFormatHTML     ContentFormat = "html"
+	ContentFormatMarkdown ContentFormat = "markdown"
+)
+
+// ArticleStatus is the lifecycle status of an article.
+type ArticleStatus string
+
+const (
+	ArticleStatusDraft ArticleStatus = "draft"
+	// ArticleStatusPendingReview is the status of generated articles which are waiting for their owner's approval.
+	ArticleStatusPendingReview ArticleStatus = "pending_review"
+	// ArticleStatusScheduled is the status of approved articles which are published when their PublishedAt
+	// time has come.
+	ArticleStatusScheduled ArticleStatus = "scheduled"
+	// ArticleStatusPublished is the status of articles which are visible to the public.
+	ArticleStatusPublished ArticleStatus = "published"
+	ArticleStatusArchived  ArticleStatus = "archived"
 )
 
 type Article struct {
@@ -21,9 +39,32 @@ type Article struct {
 	PlaintextContent string        `db:"plaintext_content"`
 	Content          string        `db:"content"`
 	ContentFormat    ContentFormat `db:"content_format"`
+	HTMLContent      string        `db:"html_content"`
 	AuthorID         uuid.UUID     `db:"author_id"`
+	Status           ArticleStatus `db:"status"`
+	PublishedAt      sql.NullTime  `db:"published_at"`
+	WordCount        int           `db:"word_count"`
+	ReadingMinutes   int           `db:"reading_minutes"`
+	ReadabilityGrade float64       `db:"readability_grade"`
+	SeriesID         uuid.NullUUID `db:"series_id"`
+	SeriesPosition   sql.NullInt32 `db:"series_position"`
 	CreatedAt        time.Time     `db:"created_at"`
 	UpdatedAt        time.Time     `db:"updated_at"`
+	// Tags are the names of the tags to attach to the article when it is created.
+	Tags []string `db:"-"`
+}
+
+// ArticleState is the lifecycle status of an article along with its publish time.
+type ArticleState struct {
+	Status      ArticleStatus `db:"status"`
+	PublishedAt sql.NullTime  `db:"published_at"`
+}
+
+// PublishedArticle is a scheduled article which has just been published.
+type PublishedArticle struct {
+	ID          uuid.UUID `db:"id"`
+	Slug        string    `db:"slug"`
+	PublishedAt time.Time `db:"published_at"`
 }
 
 type ArticlePreview struct {
@@ -35,28 +76,239 @@ type ArticlePreview struct {
 	AuthorUsername    string         `db:"author_username"`
 	AuthorDisplayName sql.NullString `db:"author_display_name"`
 	AuthorAvatarURL   sql.NullString `db:"author_avatar_url"`
-	CreatedAt         time.Time      `db:"created_at"`
-	UpdatedAt         time.Time      `db:"updated_at"`
+	Tags              TagList        `db:"tags"`
+	Status            ArticleStatus  `db:"status"`
+	PublishedAt       sql.NullTime   `db:"published_at"`
+	WordCount         int            `db:"word_count"`
+	ReadingMinutes    int            `db:"reading_minutes"`
+	ReadabilityGrade  float64        `db:"readability_grade"`
+	ClapCount         int            `db:"clap_count"`
+	// ViewerClapCount and ViewerBookmarked are the state of the article for the user who lists the articles.
+	// They are only set by the queries which take the ID of the viewer.
+	ViewerClapCount  int  `db:"viewer_clap_count"`
+	ViewerBookmarked bool `db:"viewer_bookmarked"`
+	// TrendingScore is the time-decayed engagement of the article. It is only set when ordering by
+	// ArticleOrderTrending.
+	TrendingScore float64   `db:"trending_score"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// ListedAt returns the time by which the article is ordered in listings: its publish time, or its creation time
+// if it has not been approved yet.
+func (a ArticlePreview) ListedAt() time.Time {
+	if a.PublishedAt.Valid {
+		return a.PublishedAt.Time
+	}
+	return a.CreatedAt
+}
+
+// ArticleSearchResult is an article preview matching a full-text search query.
+type ArticleSearchResult struct {
+	ArticlePreview
+	// Rank is the relevance of the article to the search query. Higher is more relevant.
+	Rank float64 `db:"rank"`
+	// Highlight is a snippet of the article content where matched terms are enclosed by
+	// SearchHighlightStart and SearchHighlightStop.
+	Highlight string `db:"highlight"`
 }
 
 type ArticleDetails struct {
 	ID                uuid.UUID      `db:"id"`
 	Slug              string         `db:"slug"`
 	Title             string         `db:"title"`
+	Description       string         `db:"description"`
 	Content           string         `db:"content"`
+	ContentFormat     ContentFormat  `db:"content_format"`
+	HTMLContent       string         `db:"html_content"`
+	Status            ArticleStatus  `db:"status"`
+	PublishedAt       sql.NullTime   `db:"published_at"`
+	WordCount         int            `db:"word_count"`
+	ReadingMinutes    int            `db:"reading_minutes"`
+	ReadabilityGrade  float64        `db:"readability_grade"`
+	ClapCount         int            `db:"clap_count"`
+	ViewerClapCount   int            `db:"viewer_clap_count"`
+	ViewerBookmarked  bool           `db:"viewer_bookmarked"`
 	CreatedAt         time.Time      `db:"created_at"`
 	UpdatedAt         time.Time      `db:"updated_at"`
 	AuthorID          uuid.UUID      `db:"author_id"`
 	AuthorUsername    string         `db:"author_username"`
 	AuthorDisplayName sql.NullString `db:"author_display_name"`
 	AuthorAvatarURL   sql.NullString `db:"author_avatar_url"`
+	Tags              TagList        `db:"tags"`
+	ArticleSeriesInfo
+}
+
+// ArticleSeriesInfo describes the series an article is a part of, along with the published parts around it.
+// All fields are null for standalone articles.
+type ArticleSeriesInfo struct {
+	SeriesID      uuid.NullUUID  `db:"series_id"`
+	SeriesTitle   sql.NullString `db:"series_title"`
+	Position      sql.NullInt32  `db:"series_position"`
+	PreviousSlug  sql.NullString `db:"previous_slug"`
+	PreviousTitle sql.NullString `db:"previous_title"`
+	NextSlug      sql.NullString `db:"next_slug"`
+	NextTitle     sql.NullString `db:"next_title"`
+}
+
+// ArticleClaps are the claps of an article.
+type ArticleClaps struct {
+	// ClapCount is the total number of claps given to the article.
+	ClapCount int `db:"clap_count"`
+	// ViewerClapCount is the number of claps given to the article by the current user.
+	ViewerClapCount int `db:"viewer_clap_count"`
+}
+
+// BookmarkedArticle is an article bookmarked by a user.
+type BookmarkedArticle struct {
+	ArticlePreview
+	BookmarkedAt time.Time `db:"bookmarked_at"`
+}
+
+// ReadingList is a named collection of articles curated by a user.
+type ReadingList struct {
+	ID          uuid.UUID `db:"id"`
+	OwnerID     uuid.UUID `db:"owner_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	// IsPublic reports whether anyone can view the reading list, instead of only its owner.
+	IsPublic     bool      `db:"is_public"`
+	ArticleCount int       `db:"article_count"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+// ArticleSource is the source content of an article, from which its other representations are derived.
+type ArticleSource struct {
+	ID            uuid.UUID     `db:"id"`
+	Content       string        `db:"content"`
+	ContentFormat ContentFormat `db:"content_format"`
+	// The following fields are only set by GetArticleSourceBySlug.
+	AuthorID    uuid.UUID    `db:"author_id"`
+	Title       string       `db:"title"`
+	Description string       `db:"description"`
+	Tags        TagList      `db:"tags"`
+	DeletedAt   sql.NullTime `db:"deleted_at"`
+}
+
+// ArticleRevisionSummary describes a revision of an article, without its content.
+type ArticleRevisionSummary struct {
+	// Number is the number of the revision within its article, starting at 1.
+	Number        int           `db:"revision_number"`
+	Title         string        `db:"title"`
+	Description   string        `db:"description"`
+	ContentFormat ContentFormat `db:"content_format"`
+	CreatedAt     time.Time     `db:"created_at"`
+}
+
+// ArticleRevision is a snapshot of an article taken when the article was written.
+type ArticleRevision struct {
+	ArticleRevisionSummary
+	Content string `db:"content"`
 }
 
+// Series is an ordered sequence of articles by the same digital author.
+type Series struct {
+	ID                uuid.UUID      `db:"id"`
+	AuthorID          uuid.UUID      `db:"author_id"`
+	AuthorDisplayName sql.NullString `db:"author_display_name"`
+	Title             string         `db:"title"`
+	Description       string         `db:"description"`
+	// CompletedAt is the time the series was ended. New articles of the digital author do not continue a
+	// completed series.
+	CompletedAt sql.NullTime `db:"completed_at"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   time.Time    `db:"updated_at"`
+}
+
+// SeriesArticle is a published article of a series.
+type SeriesArticle struct {
+	ArticlePreview
+	// Position is the position of the article within the series. Positions of unpublished articles are skipped.
+	Position int `db:"series_position"`
+}
+
+// SeriesPartSummary summarizes an article of a series, so that the next part can be written.
+type SeriesPartSummary struct {
+	Position    int    `db:"series_position"`
+	Title       string `db:"title"`
+	Description string `db:"description"`
+}
+
+type Tag struct {
+	ID   uuid.UUID `db:"id" json:"id"`
+	Slug string    `db:"slug" json:"slug"`
+	Name string    `db:"name" json:"name"`
+}
+
+// TagSummary is a tag along with the number of articles it is attached to.
+type TagSummary struct {
+	Tag
+	ArticleCount int `db:"article_count"`
+}
+
+// TagList is a list of tags, scanned from a JSON array built in SQL with json_agg.
+type TagList []Tag
+
+func (l *TagList) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*l = TagList{}
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into TagList", src)
+	}
+
+	return json.Unmarshal(data, l)
+}
+
+// UserRole is the permissions of a user.
+type UserRole string
+
+const (
+	UserRoleMember UserRole = "member"
+	// UserRoleModerator is the role of users who can moderate the content written by other users.
+	UserRoleModerator UserRole = "moderator"
+)
+
 type User struct {
 	ID           uuid.UUID `db:"id"`
 	Username     string    `db:"username"`
 	Email        string    `db:"email"`
 	PasswordHash []byte    `db:"password_hash"`
+	Role         UserRole  `db:"role"`
+}
+
+// CommentModerationStatus is the state of a comment set by moderators.
+type CommentModerationStatus string
+
+const (
+	CommentModerationStatusVisible CommentModerationStatus = "visible"
+	// CommentModerationStatusFlagged is the status of visible comments which need the attention of a moderator.
+	CommentModerationStatusFlagged CommentModerationStatus = "flagged"
+	// CommentModerationStatusHidden is the status of comments whose body must not be shown.
+	CommentModerationStatusHidden CommentModerationStatus = "hidden"
+)
+
+type Comment struct {
+	ID                uuid.UUID               `db:"id"`
+	ArticleID         uuid.UUID               `db:"article_id"`
+	ParentID          uuid.NullUUID           `db:"parent_id"`
+	Body              string                  `db:"body"`
+	ModerationStatus  CommentModerationStatus `db:"moderation_status"`
+	AuthorID          uuid.UUID               `db:"author_id"`
+	AuthorUsername    string                  `db:"author_username"`
+	AuthorDisplayName sql.NullString          `db:"author_display_name"`
+	AuthorAvatarURL   sql.NullString          `db:"author_avatar_url"`
+	CreatedAt         time.Time               `db:"created_at"`
+	UpdatedAt         time.Time               `db:"updated_at"`
+	EditedAt          sql.NullTime            `db:"edited_at"`
+	DeletedAt         sql.NullTime            `db:"deleted_at"`
 }
 
 // OpenRouterAPIKey are user-provided API keys for OpenRouter.
@@ -73,10 +325,103 @@ type DigitalAuthor struct {
 	DisplayName  string    `db:"display_name"`
 	SystemPrompt string    `db:"system_prompt"`
 	CreatedAt    time.Time `db:"created_at"`
+	// FollowerCount is the number of users following the digital author. It is only set when listing
+	// digital authors.
+	FollowerCount int `db:"follower_count"`
+	// ViewerFollowing reports whether the current user follows the digital author. It is only set when listing
+	// digital authors.
+	ViewerFollowing bool `db:"viewer_following"`
 }
 
 type DigitalAuthorWithArticleSlugs struct {
 	ID           uuid.UUID `db:"id"`
 	SystemPrompt string    `db:"system_prompt"`
 	ArticleSlugs []string  `db:"article_slugs"`
+	// SeriesID is the latest series of the author, which new articles continue. It is null if the author has
+	// no series.
+	SeriesID uuid.NullUUID `db:"series_id"`
+}
+
+// AuthorAnalytics are the metrics of the articles of a digital author over a date range.
+type AuthorAnalytics struct {
+	Views int
+	// UniqueReaders is the number of distinct visitors over the whole range.
+	UniqueReaders int
+	Completions   int
+	// ReadSeconds is the total reading time of the read completions.
+	ReadSeconds int64
+	// Days holds the metrics of each day in the range, including the days without any event.
+	Days []DailyAnalytics
+}
+
+// DailyAnalytics are the metrics of the articles of a digital author on one day.
+type DailyAnalytics struct {
+	Day           time.Time `db:"day"`
+	Views         int       `db:"views"`
+	UniqueReaders int       `db:"unique_readers"`
+	Completions   int       `db:"completions"`
+	ReadSeconds   int64     `db:"read_seconds"`
+}
+
+// SitemapEntryKind is the kind of page a sitemap entry points to.
+type SitemapEntryKind string
+
+const (
+	SitemapEntryArticle       SitemapEntryKind = "article"
+	SitemapEntryDigitalAuthor SitemapEntryKind = "digital_author"
+)
+
+// SitemapEntry is a public page of the website which search engines should index.
+type SitemapEntry struct {
+	Kind SitemapEntryKind `db:"kind"`
+	// Key identifies the page among the pages of its kind: the slug of an article, or the ID of a digital author.
+	Key string `db:"key"`
+	// UpdatedAt is the last time the content of the page changed.
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// ReportReason is why a reader reports an article or a comment.
+type ReportReason string
+
+const (
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonMisinformation ReportReason = "misinformation"
+	ReportReasonInappropriate  ReportReason = "inappropriate"
+	ReportReasonCopyright      ReportReason = "copyright"
+	ReportReasonOther          ReportReason = "other"
+)
+
+// ReportStatus is the state of a report in the moderation queue.
+type ReportStatus string
+
+const (
+	// ReportStatusOpen is the status of reports which are waiting for a moderator.
+	ReportStatusOpen ReportStatus = "open"
+	// ReportStatusUpheld is the status of reports whose article was taken down, or whose comment was hidden.
+	ReportStatusUpheld ReportStatus = "upheld"
+	// ReportStatusDismissed is the status of reports whose content was kept.
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+// Report is an article or a comment flagged by a reader for moderators to review.
+type Report struct {
+	ID         uuid.UUID `db:"id"`
+	ReporterID uuid.UUID `db:"reporter_id"`
+	// ArticleID is the ID of the reported article, or of the article of the reported comment.
+	ArticleID uuid.UUID `db:"article_id"`
+	// CommentID is the ID of the reported comment. It is not valid for reports of articles.
+	CommentID uuid.NullUUID `db:"comment_id"`
+	Reason    ReportReason  `db:"reason"`
+	Details   string        `db:"details"`
+	Status    ReportStatus  `db:"status"`
+	// ArticleSlug and ArticleTitle identify the reported article, or the article of the reported comment.
+	ArticleSlug  string `db:"article_slug"`
+	ArticleTitle string `db:"article_title"`
+	// CommentBody is the body of the reported comment, if any.
+	CommentBody    sql.NullString `db:"comment_body"`
+	ResolvedBy     uuid.NullUUID  `db:"resolved_by"`
+	ResolvedAt     sql.NullTime   `db:"resolved_at"`
+	ResolutionNote string         `db:"resolution_note"`
+	CreatedAt      time.Time      `db:"created_at"`
 }
@@ -0,0 +1,282 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// readingListColumns are the columns scanned into a ReadingList. Queries using them must select from
+// reading_lists "rl".
+var readingListColumns = []string{
+	"rl.id", "rl.owner_id", "rl.name", "rl.description", "rl.is_public",
+	"(SELECT COUNT(*) FROM reading_list_articles rla WHERE rla.reading_list_id = rl.id) AS article_count",
+	"rl.created_at", "rl.updated_at",
+}
+
+// CreateReadingList creates a reading list. ErrReadingListNameTaken is returned if the owner already has
+// a reading list with the same name.
+func (p *Store) CreateReadingList(ctx context.Context, params CreateReadingListParams) (*ReadingList, error) {
+	query, args, err := p.qb.
+		Insert("reading_lists").
+		Columns("owner_id", "name", "description", "is_public").
+		Values(params.OwnerID, params.Name, params.Description, params.IsPublic).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var id uuid.UUID
+	err = p.db.GetContext(ctx, &id, query, args...)
+	if err != nil {
+		if isReadingListNameViolation(err) {
+			return nil, ErrReadingListNameTaken
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return p.GetReadingList(ctx, id, params.OwnerID)
+}
+
+type CreateReadingListParams struct {
+	OwnerID     uuid.UUID
+	Name        string
+	Description string
+	IsPublic    bool
+}
+
+// GetReadingList retrieves a single reading list by its ID. Private reading lists are only returned to their
+// owner: viewerID is the ID of the current user, or uuid.Nil for anonymous users.
+func (p *Store) GetReadingList(ctx context.Context, id, viewerID uuid.UUID) (*ReadingList, error) {
+	query, args, err := p.qb.
+		Select(readingListColumns...).
+		From("reading_lists rl").
+		Where(sq.Eq{"rl.id": id}).
+		Where(sq.Or{sq.Eq{"rl.is_public": true}, sq.Eq{"rl.owner_id": viewerID}}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var readingList ReadingList
+	err = p.db.GetContext(ctx, &readingList, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReadingListNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &readingList, nil
+}
+
+// ListReadingLists lists the reading lists of a user, sorted by name.
+func (p *Store) ListReadingLists(ctx context.Context, ownerID uuid.UUID) ([]ReadingList, error) {
+	readingLists := []ReadingList{}
+
+	query, args, err := p.qb.
+		Select(readingListColumns...).
+		From("reading_lists rl").
+		Where(sq.Eq{"rl.owner_id": ownerID}).
+		OrderBy("rl.name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &readingLists, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return readingLists, nil
+}
+
+// UpdateReadingList replaces the name, description and visibility of a reading list owned by the given user.
+func (p *Store) UpdateReadingList(ctx context.Context, params UpdateReadingListParams) (*ReadingList, error) {
+	query, args, err := p.qb.
+		Update("reading_lists").
+		Set("name", params.Name).
+		Set("description", params.Description).
+		Set("is_public", params.IsPublic).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": params.ID, "owner_id": params.OwnerID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isReadingListNameViolation(err) {
+			return nil, ErrReadingListNameTaken
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+	if err := checkReadingListAffected(result); err != nil {
+		return nil, err
+	}
+
+	return p.GetReadingList(ctx, params.ID, params.OwnerID)
+}
+
+type UpdateReadingListParams struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	Name        string
+	Description string
+	IsPublic    bool
+}
+
+// DeleteReadingList deletes a reading list owned by the given user. The articles themselves are kept.
+func (p *Store) DeleteReadingList(ctx context.Context, id, ownerID uuid.UUID) error {
+	query, args, err := p.qb.
+		Delete("reading_lists").
+		Where(sq.Eq{"id": id, "owner_id": ownerID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	result, err := p.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return checkReadingListAffected(result)
+}
+
+// AddReadingListArticle adds a published article to a reading list owned by the given user. Adding an article
+// twice has no effect.
+func (p *Store) AddReadingListArticle(ctx context.Context, params ReadingListArticleParams) error {
+	return p.withTx(ctx, func(tx *sqlx.Tx) error {
+		if err := lockOwnedReadingList(ctx, tx, params.ReadingListID, params.OwnerID); err != nil {
+			return err
+		}
+
+		var articleID uuid.UUID
+		err := tx.GetContext(ctx, &articleID,
+			`SELECT a.id FROM articles a WHERE a.slug = $1 AND `+articleIsPublic, params.Slug)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrArticleNotFound
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO reading_list_articles (reading_list_id, article_id) VALUES ($1, $2)
+			ON CONFLICT (reading_list_id, article_id) DO NOTHING`,
+			params.ReadingListID, articleID)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return touchReadingList(ctx, tx, params.ReadingListID)
+	})
+}
+
+// RemoveReadingListArticle removes an article from a reading list owned by the given user. Removing an article
+// which is not in the reading list has no effect.
+func (p *Store) RemoveReadingListArticle(ctx context.Context, params ReadingListArticleParams) error {
+	return p.withTx(ctx, func(tx *sqlx.Tx) error {
+		if err := lockOwnedReadingList(ctx, tx, params.ReadingListID, params.OwnerID); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM reading_list_articles rla USING articles a
+			WHERE a.id = rla.article_id AND rla.reading_list_id = $1 AND a.slug = $2`,
+			params.ReadingListID, params.Slug)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return touchReadingList(ctx, tx, params.ReadingListID)
+	})
+}
+
+type ReadingListArticleParams struct {
+	ReadingListID uuid.UUID
+	// OwnerID is the ID of the user who changes the reading list, who must own it.
+	OwnerID uuid.UUID
+	Slug    string
+}
+
+// ListReadingListArticles lists the published articles of a reading list, most recently added first.
+// viewerID is the ID of the current user, or uuid.Nil for anonymous users.
+func (p *Store) ListReadingListArticles(ctx context.Context, readingListID, viewerID uuid.UUID) ([]ArticlePreview, error) {
+	articles := []ArticlePreview{}
+
+	query, args, err := p.qb.
+		Select(articlePreviewColumns...).
+		Column(articleViewerColumns(viewerID)).
+		From("reading_list_articles rla").
+		InnerJoin("articles a ON a.id = rla.article_id").
+		InnerJoin("digital_authors da ON a.author_id = da.id").
+		Where(sq.Eq{"rla.reading_list_id": readingListID}).
+		Where(articleIsPublic).
+		OrderBy("rla.created_at DESC", "a.id DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &articles, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return articles, nil
+}
+
+// lockOwnedReadingList locks a reading list until the end of the transaction. ErrReadingListNotFound is returned
+// if the reading list is not owned by the given user.
+func lockOwnedReadingList(ctx context.Context, tx *sqlx.Tx, id, ownerID uuid.UUID) error {
+	var lockedID uuid.UUID
+	err := tx.GetContext(ctx, &lockedID,
+		`SELECT id FROM reading_lists WHERE id = $1 AND owner_id = $2 FOR UPDATE`, id, ownerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrReadingListNotFound
+		}
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return nil
+}
+
+func touchReadingList(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `UPDATE reading_lists SET updated_at = CURRENT_TIMESTAMP WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return nil
+}
+
+func checkReadingListAffected(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrReadingListNotFound
+	}
+
+	return nil
+}
+
+// isReadingListNameViolation reports whether err is caused by writing a reading list with the name of another
+// reading list of the same owner.
+func isReadingListNameViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation && pqErr.Constraint == "reading_lists_owner_id_name_key"
+}
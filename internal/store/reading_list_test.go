@@ -0,0 +1,81 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestReadingListStore(t *testing.T) {
+	suite.Run(t, new(ReadingListStoreTestSuite))
+}
+
+type ReadingListStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ReadingListStoreTestSuite) TestReadingLists() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+
+	readingList, err := s.store.CreateReadingList(ctx, store.CreateReadingListParams{
+		OwnerID: user.ID,
+		Name:    "Weekend reads",
+	})
+	s.Require().NoError(err)
+	s.Require().False(readingList.IsPublic)
+
+	_, err = s.store.CreateReadingList(ctx, store.CreateReadingListParams{OwnerID: user.ID, Name: "Weekend reads"})
+	s.Require().ErrorIs(err, store.ErrReadingListNameTaken)
+
+	// Private reading lists are only visible to their owners.
+	_, err = s.store.GetReadingList(ctx, readingList.ID, uuid.Nil)
+	s.Require().ErrorIs(err, store.ErrReadingListNotFound)
+
+	s.Require().NoError(s.store.AddReadingListArticle(ctx, store.ReadingListArticleParams{
+		ReadingListID: readingList.ID,
+		OwnerID:       user.ID,
+		Slug:          article.Slug,
+	}))
+	s.Require().ErrorIs(s.store.AddReadingListArticle(ctx, store.ReadingListArticleParams{
+		ReadingListID: readingList.ID,
+		OwnerID:       uuid.New(),
+		Slug:          article.Slug,
+	}), store.ErrReadingListNotFound)
+
+	readingList, err = s.store.UpdateReadingList(ctx, store.UpdateReadingListParams{
+		ID:       readingList.ID,
+		OwnerID:  user.ID,
+		Name:     "Weekend reads",
+		IsPublic: true,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, readingList.ArticleCount)
+
+	readingList, err = s.store.GetReadingList(ctx, readingList.ID, uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().True(readingList.IsPublic)
+
+	articles, err := s.store.ListReadingListArticles(ctx, readingList.ID, uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().Len(articles, 1)
+	s.Require().Equal(article.ID, articles[0].ID)
+
+	s.Require().NoError(s.store.RemoveReadingListArticle(ctx, store.ReadingListArticleParams{
+		ReadingListID: readingList.ID,
+		OwnerID:       user.ID,
+		Slug:          article.Slug,
+	}))
+	readingLists, err := s.store.ListReadingLists(ctx, user.ID)
+	s.Require().NoError(err)
+	s.Require().Len(readingLists, 1)
+	s.Require().Equal(0, readingLists[0].ArticleCount)
+
+	s.Require().NoError(s.store.DeleteReadingList(ctx, readingList.ID, user.ID))
+	s.Require().ErrorIs(s.store.DeleteReadingList(ctx, readingList.ID, user.ID), store.ErrReadingListNotFound)
+}
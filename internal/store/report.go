@@ -0,0 +1,292 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// reportColumns are the columns scanned into a Report. Queries using them must select from reports "r" joined by
+// joinReportTargets.
+var reportColumns = []string{
+	"r.id", "r.reporter_id", "a.id AS article_id", "r.comment_id", "r.reason", "r.details", "r.status",
+	"a.slug AS article_slug", "a.title AS article_title", "c.body AS comment_body", "r.resolved_by",
+	"r.resolved_at", "r.resolution_note", "r.created_at",
+}
+
+// joinReportTargets joins the reported comment "c", if any, and the reported article "a", or the article of the
+// reported comment.
+func joinReportTargets(builder sq.SelectBuilder) sq.SelectBuilder {
+	return builder.
+		LeftJoin("comments c ON c.id = r.comment_id").
+		InnerJoin("articles a ON a.id = COALESCE(r.article_id, c.article_id)")
+}
+
+// CreateReport reports an article or a comment to the moderators on behalf of a reader. The article must be
+// visible to the public, and the comment must not be deleted or hidden; otherwise ErrArticleNotFound or
+// ErrCommentNotFound is returned. Reporting the same content again while the report is open replaces its reason
+// and details. Reported comments are flagged until a moderator resolves their reports.
+func (p *Store) CreateReport(ctx context.Context, params CreateReportParams) (*Report, error) {
+	var id uuid.UUID
+	err := p.withTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		if params.CommentID != uuid.Nil {
+			id, err = p.createCommentReport(ctx, tx, params)
+		} else {
+			id, err = p.createArticleReport(ctx, tx, params)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getReport(ctx, id)
+}
+
+type CreateReportParams struct {
+	ReporterID uuid.UUID
+	// ArticleSlug is the slug of the reported article. It is ignored if CommentID is set.
+	ArticleSlug string
+	// CommentID is the ID of the reported comment, or uuid.Nil to report an article.
+	CommentID uuid.UUID
+	Reason    ReportReason
+	// Details are optional explanations of the reader.
+	Details string
+}
+
+func (p *Store) createArticleReport(ctx context.Context, tx *sqlx.Tx, params CreateReportParams) (uuid.UUID, error) {
+	var articleID uuid.UUID
+	err := tx.GetContext(ctx, &articleID, `SELECT a.id FROM articles a WHERE a.slug = $1 AND `+articleIsPublic,
+		params.ArticleSlug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, ErrArticleNotFound
+		}
+		return uuid.Nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	var id uuid.UUID
+	err = tx.GetContext(ctx, &id, `
+		INSERT INTO reports (reporter_id, article_id, reason, details)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (reporter_id, article_id) WHERE status = 'open' AND article_id IS NOT NULL
+		DO UPDATE SET reason = EXCLUDED.reason, details = EXCLUDED.details
+		RETURNING id`,
+		params.ReporterID, articleID, params.Reason, params.Details)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return id, nil
+}
+
+func (p *Store) createCommentReport(ctx context.Context, tx *sqlx.Tx, params CreateReportParams) (uuid.UUID, error) {
+	var exists bool
+	err := tx.GetContext(ctx, &exists, `
+		SELECT EXISTS (
+			SELECT 1 FROM comments c
+			INNER JOIN articles a ON a.id = c.article_id
+			WHERE c.id = $1 AND c.deleted_at IS NULL AND c.moderation_status <> 'hidden' AND `+articleIsPublic+`)`,
+		params.CommentID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+	if !exists {
+		return uuid.Nil, ErrCommentNotFound
+	}
+
+	var id uuid.UUID
+	err = tx.GetContext(ctx, &id, `
+		INSERT INTO reports (reporter_id, comment_id, reason, details)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (reporter_id, comment_id) WHERE status = 'open' AND comment_id IS NOT NULL
+		DO UPDATE SET reason = EXCLUDED.reason, details = EXCLUDED.details
+		RETURNING id`,
+		params.ReporterID, params.CommentID, params.Reason, params.Details)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE comments SET moderation_status = 'flagged', updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND moderation_status = 'visible'`, params.CommentID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return id, nil
+}
+
+func (p *Store) getReport(ctx context.Context, id uuid.UUID) (*Report, error) {
+	query, args, err := joinReportTargets(p.qb.Select(reportColumns...).From("reports r")).
+		Where(sq.Eq{"r.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var report Report
+	if err := p.db.GetContext(ctx, &report, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReportNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &report, nil
+}
+
+// ListReports lists the reports in the moderation queue, oldest first, so that reports are handled in the order
+// they were made. Results are paginated with a keyset on (created_at, id): pass the last item of the previous
+// page as params.After to fetch the next page.
+func (p *Store) ListReports(ctx context.Context, params ListReportsParams) ([]Report, error) {
+	builder := joinReportTargets(p.qb.Select(reportColumns...).From("reports r")).
+		OrderBy("r.created_at", "r.id")
+
+	if params.Status != "" {
+		builder = builder.Where(sq.Eq{"r.status": params.Status})
+	}
+	if params.After != nil {
+		builder = builder.Where("(r.created_at, r.id) > (?, ?)", params.After.CreatedAt, params.After.ID)
+	}
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	reports := []Report{}
+	if err := p.db.SelectContext(ctx, &reports, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return reports, nil
+}
+
+type ListReportsParams struct {
+	// Status is an optional filter on the status of the reports.
+	Status ReportStatus
+	// Limit is the maximum number of reports to return. No limit is applied if it is zero.
+	Limit int
+	// After is an optional cursor. If set, only reports that come after it in the listing order are returned.
+	After *ReportCursor
+}
+
+// ReportCursor identifies a position in the reports listing.
+type ReportCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// ResolveReport resolves an open report on behalf of a moderator. Upholding a report takes the reported article
+// down, or hides the reported comment. Dismissing it keeps the content, and makes a flagged comment visible
+// again. The other open reports of the same content are resolved the same way. ErrReportAlreadyResolved is
+// returned if the report is not open. The caller must check that the current user is a moderator.
+func (p *Store) ResolveReport(ctx context.Context, params ResolveReportParams) (*Report, error) {
+	if params.Status != ReportStatusUpheld && params.Status != ReportStatusDismissed {
+		return nil, fmt.Errorf("invalid report resolution: %s", params.Status)
+	}
+
+	err := p.withTx(ctx, func(tx *sqlx.Tx) error {
+		var report struct {
+			ArticleID uuid.NullUUID `db:"article_id"`
+			CommentID uuid.NullUUID `db:"comment_id"`
+			Reason    ReportReason  `db:"reason"`
+			Status    ReportStatus  `db:"status"`
+		}
+		err := tx.GetContext(ctx, &report,
+			`SELECT article_id, comment_id, reason, status FROM reports WHERE id = $1 FOR UPDATE`, params.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrReportNotFound
+			}
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+		if report.Status != ReportStatusOpen {
+			return fmt.Errorf("%w: %s", ErrReportAlreadyResolved, report.Status)
+		}
+
+		// The takedown reason is shown to the owner of the article, so it falls back to the reason of the report.
+		takedownReason := params.Note
+		if takedownReason == "" {
+			takedownReason = string(report.Reason)
+		}
+
+		var target sq.Eq
+		var contentQuery string
+		var contentArgs []any
+		switch {
+		case report.CommentID.Valid:
+			target = sq.Eq{"comment_id": report.CommentID.UUID}
+			status := CommentModerationStatusHidden
+			if params.Status == ReportStatusDismissed {
+				status = CommentModerationStatusVisible
+			}
+			// Hidden comments stay hidden if a later report is dismissed.
+			contentQuery = `
+				UPDATE comments SET moderation_status = $2, updated_at = CURRENT_TIMESTAMP
+				WHERE id = $1 AND moderation_status <> 'hidden'`
+			contentArgs = []any{report.CommentID.UUID, status}
+		default:
+			target = sq.Eq{"article_id": report.ArticleID.UUID}
+			if params.Status == ReportStatusUpheld {
+				contentQuery = `
+					UPDATE articles
+					SET taken_down_at = COALESCE(taken_down_at, CURRENT_TIMESTAMP), taken_down_by = $2,
+						takedown_reason = $3, updated_at = CURRENT_TIMESTAMP
+					WHERE id = $1`
+				contentArgs = []any{report.ArticleID.UUID, params.ModeratorID, takedownReason}
+			}
+		}
+
+		if contentQuery != "" {
+			if _, err := tx.ExecContext(ctx, contentQuery, contentArgs...); err != nil {
+				return fmt.Errorf("failed to execute SQL query: %w", err)
+			}
+		}
+
+		query, args, err := p.qb.
+			Update("reports").
+			Set("status", params.Status).
+			Set("resolved_by", params.ModeratorID).
+			Set("resolved_at", sq.Expr("CURRENT_TIMESTAMP")).
+			Set("resolution_note", params.Note).
+			Where(target).
+			Where(sq.Eq{"status": ReportStatusOpen}).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build query: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to execute SQL query: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.getReport(ctx, params.ID)
+}
+
+type ResolveReportParams struct {
+	ID uuid.UUID
+	// ModeratorID is the ID of the moderator who resolves the report.
+	ModeratorID uuid.UUID
+	// Status is the resolution of the report: ReportStatusUpheld or ReportStatusDismissed.
+	Status ReportStatus
+	// Note is an optional explanation of the moderator. It is also the takedown reason of upheld article reports.
+	Note string
+}
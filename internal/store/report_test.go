@@ -0,0 +1,110 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestReportStore(t *testing.T) {
+	suite.Run(t, new(ReportStoreTestSuite))
+}
+
+type ReportStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *ReportStoreTestSuite) TestReports() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	reader := s.mustCreateUser()
+	moderator := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+	comment, err := s.store.CreateComment(ctx, store.CreateCommentParams{
+		ArticleID: article.ID,
+		UserID:    user.ID,
+		Body:      "Buy cheap watches",
+	})
+	s.Require().NoError(err)
+
+	// Reporting the same article twice while the report is open updates the report.
+	articleReport, err := s.store.CreateReport(ctx, store.CreateReportParams{
+		ReporterID:  reader.ID,
+		ArticleSlug: article.Slug,
+		Reason:      store.ReportReasonOther,
+	})
+	s.Require().NoError(err)
+	again, err := s.store.CreateReport(ctx, store.CreateReportParams{
+		ReporterID:  reader.ID,
+		ArticleSlug: article.Slug,
+		Reason:      store.ReportReasonCopyright,
+		Details:     "Copied from my blog",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(articleReport.ID, again.ID)
+	s.Require().Equal(store.ReportReasonCopyright, again.Reason)
+	s.Require().Equal(article.ID, again.ArticleID)
+
+	commentReport, err := s.store.CreateReport(ctx, store.CreateReportParams{
+		ReporterID: reader.ID,
+		CommentID:  comment.ID,
+		Reason:     store.ReportReasonSpam,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(article.ID, commentReport.ArticleID)
+	s.Require().Equal("Buy cheap watches", commentReport.CommentBody.String)
+
+	reports, err := s.store.ListReports(ctx, store.ListReportsParams{Status: store.ReportStatusOpen})
+	s.Require().NoError(err)
+	s.Require().Len(reports, 2)
+	s.Require().Equal(articleReport.ID, reports[0].ID)
+	reports, err = s.store.ListReports(ctx, store.ListReportsParams{
+		Status: store.ReportStatusOpen,
+		After:  &store.ReportCursor{CreatedAt: reports[0].CreatedAt, ID: reports[0].ID},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(reports, 1)
+	s.Require().Equal(commentReport.ID, reports[0].ID)
+
+	// Dismissing the report of the comment makes it visible again.
+	resolved, err := s.store.ResolveReport(ctx, store.ResolveReportParams{
+		ID:          commentReport.ID,
+		ModeratorID: moderator.ID,
+		Status:      store.ReportStatusDismissed,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(store.ReportStatusDismissed, resolved.Status)
+	_, err = s.store.ResolveReport(ctx, store.ResolveReportParams{
+		ID:          commentReport.ID,
+		ModeratorID: moderator.ID,
+		Status:      store.ReportStatusUpheld,
+	})
+	s.Require().ErrorIs(err, store.ErrReportAlreadyResolved)
+	comments, err := s.store.ListComments(ctx, store.ListCommentsParams{ArticleID: article.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(store.CommentModerationStatusVisible, comments[0].ModerationStatus)
+
+	// Upholding the report of the article takes it down.
+	resolved, err = s.store.ResolveReport(ctx, store.ResolveReportParams{
+		ID:          articleReport.ID,
+		ModeratorID: moderator.ID,
+		Status:      store.ReportStatusUpheld,
+		Note:        "Plagiarism",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(store.ReportStatusUpheld, resolved.Status)
+	s.Require().Equal(moderator.ID, resolved.ResolvedBy.UUID)
+	_, err = s.store.GetArticleBySlug(ctx, article.Slug, uuid.Nil)
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+
+	_, err = s.store.CreateReport(ctx, store.CreateReportParams{
+		ReporterID:  reader.ID,
+		ArticleSlug: article.Slug,
+		Reason:      store.ReportReasonSpam,
+	})
+	s.Require().ErrorIs(err, store.ErrArticleNotFound)
+}
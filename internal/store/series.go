@@ -0,0 +1,182 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// CreateSeries creates a series for a digital author owned by the given user. New articles of the digital author
+// continue the series until another series is created or the series is completed. ErrDigitalAuthorNotFound is
+// returned if the user does not own the digital author.
+func (p *Store) CreateSeries(ctx context.Context, params CreateSeriesParams) (*Series, error) {
+	query, args, err := p.qb.
+		Insert("series").
+		Columns("author_id", "title", "description").
+		Select(p.qb.
+			Select("da.id").
+			Column("?", params.Title).
+			Column("?", params.Description).
+			From("digital_authors da").
+			Where(sq.Eq{"da.id": params.AuthorID, "da.owner_id": params.OwnerID})).
+		Suffix("RETURNING id, author_id, title, description, completed_at, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var series Series
+	err = p.db.GetContext(ctx, &series, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDigitalAuthorNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &series, nil
+}
+
+type CreateSeriesParams struct {
+	// OwnerID is the ID of the user who creates the series, who must own the digital author.
+	OwnerID     uuid.UUID
+	AuthorID    uuid.UUID
+	Title       string
+	Description string
+}
+
+// GetSeries retrieves a single series by its ID.
+func (p *Store) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	query, args, err := p.qb.
+		Select("s.id", "s.author_id", "da.display_name AS author_display_name", "s.title", "s.description",
+			"s.completed_at", "s.created_at", "s.updated_at").
+		From("series s").
+		InnerJoin("digital_authors da ON da.id = s.author_id").
+		Where(sq.Eq{"s.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var series Series
+	err = p.db.GetContext(ctx, &series, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &series, nil
+}
+
+// CompleteSeries ends a series of a digital author owned by the given user, so that new articles of the digital
+// author are standalone articles until another series is created. Completing a completed series has no effect.
+// ErrSeriesNotFound is returned if the user does not own the digital author of the series.
+func (p *Store) CompleteSeries(ctx context.Context, params CompleteSeriesParams) (*Series, error) {
+	query, args, err := p.qb.
+		Update("series s").
+		Set("completed_at", sq.Expr("COALESCE(s.completed_at, CURRENT_TIMESTAMP)")).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		From("digital_authors da").
+		Where("da.id = s.author_id").
+		Where(sq.Eq{"s.id": params.ID, "da.owner_id": params.OwnerID}).
+		Suffix("RETURNING s.id, s.author_id, da.display_name AS author_display_name, s.title, s.description, " +
+			"s.completed_at, s.created_at, s.updated_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var series Series
+	err = p.db.GetContext(ctx, &series, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return &series, nil
+}
+
+type CompleteSeriesParams struct {
+	ID uuid.UUID
+	// OwnerID is the ID of the user who completes the series, who must own its digital author.
+	OwnerID uuid.UUID
+}
+
+// ListSeriesArticles lists the published articles of a series in order.
+func (p *Store) ListSeriesArticles(ctx context.Context, seriesID uuid.UUID) ([]SeriesArticle, error) {
+	articles := []SeriesArticle{}
+
+	query, args, err := p.qb.
+		Select(articlePreviewColumns...).
+		Column("a.series_position").
+		From("articles a").
+		InnerJoin("digital_authors da ON a.author_id = da.id").
+		Where(sq.Eq{"a.series_id": seriesID}).
+		Where(articleIsPublic).
+		OrderBy("a.series_position").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &articles, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return articles, nil
+}
+
+// ListSeriesPartSummaries summarizes the articles of a series in order. It is used to write the next part of the
+// series, so parts waiting for review or scheduled are kept to avoid writing them again, while deleted, taken down
+// and archived parts are left out.
+func (p *Store) ListSeriesPartSummaries(ctx context.Context, seriesID uuid.UUID) ([]SeriesPartSummary, error) {
+	parts := []SeriesPartSummary{}
+
+	query, args, err := p.qb.
+		Select("a.series_position", "a.title", "COALESCE(a.description, '') AS description").
+		From("articles a").
+		Where(sq.Eq{"a.series_id": seriesID}).
+		Where(sq.NotEq{"a.status": ArticleStatusArchived}).
+		Where("a.deleted_at IS NULL AND a.taken_down_at IS NULL").
+		OrderBy("a.series_position").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &parts, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return parts, nil
+}
+
+// nextSeriesPositionQuery is the query for the position of the next article of a series. Positions of removed articles
+// are not reused, so that the positions of the parts never change.
+const nextSeriesPositionQuery = "SELECT COALESCE(MAX(series_position), 0) + 1 FROM articles WHERE series_id = ?"
+
+// GetNextSeriesPosition returns the position that the next article of a series will have.
+func (p *Store) GetNextSeriesPosition(ctx context.Context, seriesID uuid.UUID) (int, error) {
+	query, args, err := p.qb.Select().Column(sq.Expr("("+nextSeriesPositionQuery+")", seriesID)).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var position int
+	err = p.db.GetContext(ctx, &position, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return position, nil
+}
@@ -0,0 +1,104 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestSeriesStore(t *testing.T) {
+	suite.Run(t, new(SeriesStoreTestSuite))
+}
+
+type SeriesStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *SeriesStoreTestSuite) TestSeries() {
+	ctx := context.Background()
+	author := s.mustCreateUser()
+
+	_, err := s.store.CreateSeries(ctx, store.CreateSeriesParams{
+		OwnerID:  uuid.New(),
+		AuthorID: author.ID,
+		Title:    "Not My Series",
+	})
+	s.Require().ErrorIs(err, store.ErrDigitalAuthorNotFound)
+
+	series, err := s.store.CreateSeries(ctx, store.CreateSeriesParams{
+		OwnerID:     author.ID,
+		AuthorID:    author.ID,
+		Title:       "Learn Go Concurrency",
+		Description: "From goroutines to pipelines",
+	})
+	s.Require().NoError(err)
+
+	parts := []*store.Article{
+		{Slug: "goroutines", Title: "Goroutines", Status: store.ArticleStatusPublished},
+		{Slug: "mutexes", Title: "Mutexes", Status: store.ArticleStatusPendingReview},
+		{Slug: "channels", Title: "Channels", Status: store.ArticleStatusPublished},
+	}
+	for i, part := range parts {
+		part.Content = "content"
+		part.AuthorID = author.ID
+		part.SeriesID = uuid.NullUUID{UUID: series.ID, Valid: true}
+		s.Require().NoError(s.store.CreateArticle(ctx, part))
+		s.Require().Equal(int32(i+1), part.SeriesPosition.Int32)
+	}
+
+	authors, err := s.store.ListDigitalAuthorsWithArticleSlugs(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(authors, 1)
+	s.Require().Equal(series.ID, authors[0].SeriesID.UUID)
+
+	// Unpublished parts are skipped by the links between parts.
+	details, err := s.store.GetArticleBySlug(ctx, "goroutines", uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().Equal("Learn Go Concurrency", details.SeriesTitle.String)
+	s.Require().False(details.PreviousSlug.Valid)
+	s.Require().Equal("channels", details.NextSlug.String)
+	details, err = s.store.GetArticleBySlug(ctx, "channels", uuid.Nil)
+	s.Require().NoError(err)
+	s.Require().Equal(int32(3), details.Position.Int32)
+	s.Require().Equal("goroutines", details.PreviousSlug.String)
+	s.Require().False(details.NextSlug.Valid)
+
+	articles, err := s.store.ListSeriesArticles(ctx, series.ID)
+	s.Require().NoError(err)
+	s.Require().Len(articles, 2)
+	s.Require().Equal("goroutines", articles[0].Slug)
+	s.Require().Equal(3, articles[1].Position)
+
+	// Parts waiting for review are kept, so that they are not written again.
+	summaries, err := s.store.ListSeriesPartSummaries(ctx, series.ID)
+	s.Require().NoError(err)
+	s.Require().Len(summaries, 3)
+	s.Require().Equal("Mutexes", summaries[1].Title)
+	s.Require().Equal(2, summaries[1].Position)
+
+	// Deleted parts are left out, but their positions are not reused.
+	s.Require().NoError(s.store.DeleteOwnedArticle(ctx, author.ID, "channels"))
+	summaries, err = s.store.ListSeriesPartSummaries(ctx, series.ID)
+	s.Require().NoError(err)
+	s.Require().Len(summaries, 2)
+	s.Require().Equal("Mutexes", summaries[1].Title)
+	nextPosition, err := s.store.GetNextSeriesPosition(ctx, series.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(4, nextPosition)
+
+	_, err = s.store.CompleteSeries(ctx, store.CompleteSeriesParams{ID: series.ID, OwnerID: uuid.New()})
+	s.Require().ErrorIs(err, store.ErrSeriesNotFound)
+	completed, err := s.store.CompleteSeries(ctx, store.CompleteSeriesParams{ID: series.ID, OwnerID: author.ID})
+	s.Require().NoError(err)
+	s.Require().True(completed.CompletedAt.Valid)
+
+	// New articles of the digital author no longer continue the completed series.
+	authors, err = s.store.ListDigitalAuthorsWithArticleSlugs(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(authors, 1)
+	s.Require().False(authors[0].SeriesID.Valid)
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// sitemapEntriesQuery selects the public pages of the website as sitemap entries. The pages of digital authors
+// list their articles, so they change whenever one of their published articles does.
+var sitemapEntriesQuery = `
+	SELECT 'article' AS kind, a.slug AS key, a.updated_at FROM articles a WHERE ` + articleIsPublic + `
+	UNION ALL
+	SELECT 'digital_author', da.id::text, GREATEST(da.updated_at AT TIME ZONE 'UTC', (
+		SELECT MAX(a.updated_at) FROM articles a WHERE a.author_id = da.id AND ` + articleIsPublic + `))
+	FROM digital_authors da`
+
+// CountSitemapEntries returns the number of entries listed by ListSitemapEntries.
+func (p *Store) CountSitemapEntries(ctx context.Context) (int, error) {
+	var count int
+	err := p.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM (`+sitemapEntriesQuery+`) entries`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListSitemapEntries returns the public pages of the website which search engines should index: the published
+// articles and the digital authors. The entries are in a stable order, so that they can be split in pages.
+func (p *Store) ListSitemapEntries(ctx context.Context, params ListSitemapEntriesParams) ([]SitemapEntry, error) {
+	entries := []SitemapEntry{}
+	err := p.db.SelectContext(ctx, &entries, `
+		SELECT kind, key, updated_at FROM (`+sitemapEntriesQuery+`) entries
+		ORDER BY kind, key
+		LIMIT $1 OFFSET $2`,
+		params.Limit, params.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return entries, nil
+}
+
+type ListSitemapEntriesParams struct {
+	// Offset is the number of entries to skip.
+	Offset int
+	// Limit is the maximum number of entries to return.
+	Limit int
+}
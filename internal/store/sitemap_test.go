@@ -0,0 +1,47 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+func TestSitemapStore(t *testing.T) {
+	suite.Run(t, new(SitemapStoreTestSuite))
+}
+
+type SitemapStoreTestSuite struct {
+	storeTestSuite
+}
+
+func (s *SitemapStoreTestSuite) TestSitemapEntries() {
+	ctx := context.Background()
+	user := s.mustCreateUser()
+	article := s.mustCreateArticle(user.ID)
+	draft := &store.Article{Slug: "draft", Content: "content", AuthorID: user.ID, Status: store.ArticleStatusDraft}
+	s.Require().NoError(s.store.CreateArticle(ctx, draft))
+
+	// Drafts are left out.
+	count, err := s.store.CountSitemapEntries(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(2, count)
+
+	entries, err := s.store.ListSitemapEntries(ctx, store.ListSitemapEntriesParams{Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 2)
+	s.Require().Equal(store.SitemapEntryArticle, entries[0].Kind)
+	s.Require().Equal(article.Slug, entries[0].Key)
+	s.Require().True(article.UpdatedAt.Equal(entries[0].UpdatedAt))
+	s.Require().Equal(store.SitemapEntryDigitalAuthor, entries[1].Kind)
+	s.Require().Equal(user.ID.String(), entries[1].Key)
+	// The page of a digital author changes with its articles.
+	s.Require().False(entries[1].UpdatedAt.Before(article.UpdatedAt))
+
+	entries, err = s.store.ListSitemapEntries(ctx, store.ListSitemapEntriesParams{Offset: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Require().Equal(store.SitemapEntryDigitalAuthor, entries[0].Kind)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/tuananhlai/brevity-go/internal/slug"
+)
+
+const (
+	// MaxTagsPerArticle is the maximum number of tags that can be attached to an article.
+	MaxTagsPerArticle = 5
+	maxTagNameLength  = 64
+)
+
+// ListTags returns all tags which have at least one published article, the most used tags first.
+func (p *Store) ListTags(ctx context.Context) ([]TagSummary, error) {
+	tags := []TagSummary{}
+
+	query, args, err := p.qb.
+		Select("t.id", "t.slug", "t.name", "COUNT(at.article_id) AS article_count").
+		From("tags t").
+		InnerJoin("article_tags at ON at.tag_id = t.id").
+		InnerJoin("articles a ON a.id = at.article_id").
+		Where(articleIsPublic).
+		GroupBy("t.id").
+		OrderBy("article_count DESC", "t.name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query: %w", err)
+	}
+
+	err = p.db.SelectContext(ctx, &tags, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	return tags, nil
+}
+
+// GetTagBySlug retrieves a single tag by its slug.
+func (p *Store) GetTagBySlug(ctx context.Context, tagSlug string) (*Tag, error) {
+	query, args, err := p.qb.
+		Select("id", "slug", "name").
+		From("tags").
+		Where("slug = ?", tagSlug).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var tag Tag
+	err = p.db.GetContext(ctx, &tag, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// setArticleTags attaches tags to an article, creating the tags which do not exist yet.
+// Tag names are deduplicated by their slug, and only the first MaxTagsPerArticle tags are kept.
+func setArticleTags(ctx context.Context, tx *sqlx.Tx, articleID uuid.UUID, tagNames []string) error {
+	slugs, names := normalizeTagNames(tagNames)
+	if len(slugs) == 0 {
+		return nil
+	}
+
+	// The no-op update makes RETURNING include the tags which already exist.
+	_, err := tx.ExecContext(ctx, `
+		WITH upserted_tags AS (
+			INSERT INTO tags (slug, name)
+			SELECT * FROM unnest($1::text[], $2::text[])
+			ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
+			RETURNING id
+		)
+		INSERT INTO article_tags (article_id, tag_id)
+		SELECT $3::uuid, id FROM upserted_tags
+		ON CONFLICT DO NOTHING`,
+		pq.Array(slugs), pq.Array(names), articleID)
+	if err != nil {
+		return fmt.Errorf("failed to set article tags: %w", err)
+	}
+
+	return nil
+}
+
+// replaceArticleTags replaces the tags of an article with the given tags, see setArticleTags.
+func replaceArticleTags(ctx context.Context, tx *sqlx.Tx, articleID uuid.UUID, tagNames []string) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM article_tags WHERE article_id = $1`, articleID)
+	if err != nil {
+		return fmt.Errorf("failed to remove article tags: %w", err)
+	}
+
+	return setArticleTags(ctx, tx, articleID, tagNames)
+}
+
+func normalizeTagNames(tagNames []string) (slugs []string, names []string) {
+	seen := map[string]bool{}
+	for _, name := range tagNames {
+		if len(slugs) == MaxTagsPerArticle {
+			break
+		}
+
+		name = truncateRunes(strings.TrimSpace(name), maxTagNameLength)
+		tagSlug := truncateRunes(slug.Normalize(name), maxTagNameLength)
+		if tagSlug == "" || seen[tagSlug] {
+			continue
+		}
+
+		seen[tagSlug] = true
+		slugs = append(slugs, tagSlug)
+		names = append(names, name)
+	}
+
+	return slugs, names
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
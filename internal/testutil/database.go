@@ -79,6 +79,8 @@ func (d *DatabaseTestUtil) Reset() error {
 	_, err := d.db.Exec(`
 	TRUNCATE TABLE llm_api_keys CASCADE;
 	TRUNCATE TABLE articles CASCADE;
+	TRUNCATE TABLE tags CASCADE;
+	TRUNCATE TABLE digital_authors CASCADE;
 	TRUNCATE TABLE users CASCADE;
 	`)
 	if err != nil {
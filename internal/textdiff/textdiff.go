@@ -0,0 +1,101 @@
+// Package textdiff computes human-readable differences between two versions of a text.
+package textdiff
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// contextLines is the number of unchanged lines shown around each change in a unified diff.
+const contextLines = 3
+
+// Op is the kind of a change in a word-level diff.
+type Op string
+
+const (
+	OpEqual  Op = "equal"
+	OpInsert Op = "insert"
+	OpDelete Op = "delete"
+)
+
+// Change is a run of text which is unchanged, inserted or deleted.
+type Change struct {
+	Op   Op
+	Text string
+}
+
+// Unified returns a unified diff between a and b, in the format of `diff -u`. fromName and toName are used
+// as the file names in the diff header. An empty string is returned if a and b are equal.
+func Unified(a, b, fromName, toName string) (string, error) {
+	if a == b {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(a),
+		B:        splitLines(b),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  contextLines,
+	})
+}
+
+// splitLines splits s into lines which all end with a newline, including the last line.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
+
+// wordPattern splits a text into words and the whitespace between them. Whitespace is kept so that
+// the original texts can be rebuilt from the changes.
+var wordPattern = regexp.MustCompile(`\s+|[^\s]+`)
+
+// Words returns a word-level diff between a and b. Concatenating the text of the changes which are not
+// inserted gives a, and concatenating the text of the changes which are not deleted gives b.
+// Adjacent changes always have different operations.
+func Words(a, b string) []Change {
+	wordsA := wordPattern.FindAllString(a, -1)
+	wordsB := wordPattern.FindAllString(b, -1)
+
+	// Automatic junk detection is disabled, otherwise common words and whitespace in long texts
+	// would never be matched.
+	matcher := difflib.NewMatcherWithJunk(wordsA, wordsB, false, nil)
+
+	var changes []Change
+	add := func(op Op, words []string) {
+		if len(words) == 0 {
+			return
+		}
+		text := strings.Join(words, "")
+		if n := len(changes); n > 0 && changes[n-1].Op == op {
+			changes[n-1].Text += text
+			return
+		}
+		changes = append(changes, Change{Op: op, Text: text})
+	}
+
+	for _, opCode := range matcher.GetOpCodes() {
+		deleted := wordsA[opCode.I1:opCode.I2]
+		inserted := wordsB[opCode.J1:opCode.J2]
+
+		switch opCode.Tag {
+		case 'e':
+			add(OpEqual, deleted)
+		case 'd':
+			add(OpDelete, deleted)
+		case 'i':
+			add(OpInsert, inserted)
+		case 'r':
+			add(OpDelete, deleted)
+			add(OpInsert, inserted)
+		}
+	}
+
+	return changes
+}
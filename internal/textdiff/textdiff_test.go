@@ -0,0 +1,54 @@
+package textdiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/textdiff"
+)
+
+func TestUnified(t *testing.T) {
+	diff, err := textdiff.Unified("one\ntwo\nthree\n", "one\n2\nthree\n", "revision 1", "revision 2")
+	require.NoError(t, err)
+
+	require.Equal(t, "--- revision 1\n+++ revision 2\n@@ -1,3 +1,3 @@\n one\n-two\n+2\n three\n", diff)
+}
+
+func TestUnified_Equal(t *testing.T) {
+	diff, err := textdiff.Unified("same\n", "same\n", "a", "b")
+	require.NoError(t, err)
+
+	require.Empty(t, diff)
+}
+
+func TestWords(t *testing.T) {
+	changes := textdiff.Words("the quick brown fox", "the slow brown fox jumps")
+
+	require.Equal(t, []textdiff.Change{
+		{Op: textdiff.OpEqual, Text: "the "},
+		{Op: textdiff.OpDelete, Text: "quick"},
+		{Op: textdiff.OpInsert, Text: "slow"},
+		{Op: textdiff.OpEqual, Text: " brown fox"},
+		{Op: textdiff.OpInsert, Text: " jumps"},
+	}, changes)
+}
+
+func TestWords_RebuildsBothTexts(t *testing.T) {
+	a := strings.Repeat("a b c d ", 100) + "end"
+	b := strings.Repeat("a b x d ", 100) + "\nend"
+
+	var rebuiltA, rebuiltB strings.Builder
+	for _, change := range textdiff.Words(a, b) {
+		if change.Op != textdiff.OpInsert {
+			rebuiltA.WriteString(change.Text)
+		}
+		if change.Op != textdiff.OpDelete {
+			rebuiltB.WriteString(change.Text)
+		}
+	}
+
+	require.Equal(t, a, rebuiltA.String())
+	require.Equal(t, b, rebuiltB.String())
+}
@@ -0,0 +1,177 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package trending
+
+import (
+	"context"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+// NewMockScoreStore creates a new instance of MockScoreStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockScoreStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockScoreStore {
+	mock := &MockScoreStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockScoreStore is an autogenerated mock type for the ScoreStore type
+type MockScoreStore struct {
+	mock.Mock
+}
+
+type MockScoreStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockScoreStore) EXPECT() *MockScoreStore_Expecter {
+	return &MockScoreStore_Expecter{mock: &_m.Mock}
+}
+
+// RefreshTrendingScores provides a mock function for the type MockScoreStore
+func (_mock *MockScoreStore) RefreshTrendingScores(ctx context.Context, params store.RefreshTrendingScoresParams) (int, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTrendingScores")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.RefreshTrendingScoresParams) (int, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.RefreshTrendingScoresParams) int); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, store.RefreshTrendingScoresParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockScoreStore_RefreshTrendingScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTrendingScores'
+type MockScoreStore_RefreshTrendingScores_Call struct {
+	*mock.Call
+}
+
+// RefreshTrendingScores is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params store.RefreshTrendingScoresParams
+func (_e *MockScoreStore_Expecter) RefreshTrendingScores(ctx interface{}, params interface{}) *MockScoreStore_RefreshTrendingScores_Call {
+	return &MockScoreStore_RefreshTrendingScores_Call{Call: _e.mock.On("RefreshTrendingScores", ctx, params)}
+}
+
+func (_c *MockScoreStore_RefreshTrendingScores_Call) Run(run func(ctx context.Context, params store.RefreshTrendingScoresParams)) *MockScoreStore_RefreshTrendingScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.RefreshTrendingScoresParams
+		if args[1] != nil {
+			arg1 = args[1].(store.RefreshTrendingScoresParams)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockScoreStore_RefreshTrendingScores_Call) Return(n int, err error) *MockScoreStore_RefreshTrendingScores_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockScoreStore_RefreshTrendingScores_Call) RunAndReturn(run func(ctx context.Context, params store.RefreshTrendingScoresParams) (int, error)) *MockScoreStore_RefreshTrendingScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClock creates a new instance of MockClock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClock {
+	mock := &MockClock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClock is an autogenerated mock type for the Clock type
+type MockClock struct {
+	mock.Mock
+}
+
+type MockClock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClock) EXPECT() *MockClock_Expecter {
+	return &MockClock_Expecter{mock: &_m.Mock}
+}
+
+// Now provides a mock function for the type MockClock
+func (_mock *MockClock) Now() time.Time {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Now")
+	}
+
+	var r0 time.Time
+	if returnFunc, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	return r0
+}
+
+// MockClock_Now_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Now'
+type MockClock_Now_Call struct {
+	*mock.Call
+}
+
+// Now is a helper method to define mock.On call
+func (_e *MockClock_Expecter) Now() *MockClock_Now_Call {
+	return &MockClock_Now_Call{Call: _e.mock.On("Now")}
+}
+
+func (_c *MockClock_Now_Call) Run(run func()) *MockClock_Now_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClock_Now_Call) Return(time1 time.Time) *MockClock_Now_Call {
+	_c.Call.Return(time1)
+	return _c
+}
+
+func (_c *MockClock_Now_Call) RunAndReturn(run func() time.Time) *MockClock_Now_Call {
+	_c.Call.Return(run)
+	return _c
+}
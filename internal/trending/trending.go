@@ -0,0 +1,105 @@
+// Package trending periodically refreshes the trending scores which rank articles by recent engagement.
+package trending
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+)
+
+const otelScopeName = "github.com/tuananhlai/brevity-go/internal/trending"
+
+type ScoreStore interface {
+	RefreshTrendingScores(ctx context.Context, params store.RefreshTrendingScoresParams) (int, error)
+}
+
+// Clock tells the current time. It is replaced in tests, so that scores can be checked deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock which tells the time of the system.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Refresher recomputes the trending scores of the articles every interval. Several refreshers can run at the
+// same time, but one is enough.
+type Refresher struct {
+	store    ScoreStore
+	clock    Clock
+	interval time.Duration
+	halfLife time.Duration
+	logger   *slog.Logger
+}
+
+// New creates a refresher which recomputes the trending scores every interval. halfLife is the time after which
+// an engagement with an article counts for half as much.
+func New(store ScoreStore, clock Clock, interval, halfLife time.Duration, logger *slog.Logger) (*Refresher, error) {
+	if halfLife <= 0 {
+		return nil, fmt.Errorf("half-life must be positive, got %s", halfLife)
+	}
+
+	return &Refresher{
+		store:    store,
+		clock:    clock,
+		interval: interval,
+		halfLife: halfLife,
+		logger:   logger,
+	}, nil
+}
+
+// Run refreshes the trending scores every interval until ctx is canceled.
+func (r *Refresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		scored, err := r.Refresh(ctx)
+		if err != nil {
+			r.logger.ErrorContext(ctx, "failed to refresh trending scores", "error", err)
+		} else {
+			r.logger.InfoContext(ctx, "refreshed trending scores", "count", scored)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh recomputes the trending scores as of the current time of the clock, and returns the number of
+// scored articles.
+func (r *Refresher) Refresh(ctx context.Context) (int, error) {
+	ctx, span := otel.Tracer(otelScopeName).Start(ctx, "Refresher.Refresh")
+	defer span.End()
+
+	now := r.clock.Now()
+	scored, err := r.store.RefreshTrendingScores(ctx, store.RefreshTrendingScoresParams{
+		Now:      now,
+		HalfLife: r.halfLife,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	span.SetAttributes(
+		attribute.String("trending.now", now.Format(time.RFC3339)),
+		attribute.Int("trending.scored", scored),
+	)
+
+	return scored, nil
+}
@@ -0,0 +1,79 @@
+package trending_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tuananhlai/brevity-go/internal/store"
+	"github.com/tuananhlai/brevity-go/internal/trending"
+)
+
+func TestRefresher(t *testing.T) {
+	suite.Run(t, new(RefresherTestSuite))
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type RefresherTestSuite struct {
+	suite.Suite
+	refresher *trending.Refresher
+	mockStore *trending.MockScoreStore
+	clock     *fakeClock
+}
+
+func (s *RefresherTestSuite) SetupTest() {
+	s.mockStore = trending.NewMockScoreStore(s.T())
+	s.clock = &fakeClock{now: time.Date(2026, 10, 24, 12, 0, 0, 0, time.UTC)}
+	var err error
+	s.refresher, err = trending.New(s.mockStore, s.clock, time.Minute, 6*time.Hour,
+		slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.Require().NoError(err)
+}
+
+func (s *RefresherTestSuite) TestRefresh_UsesClock() {
+	ctx := context.Background()
+	s.mockStore.On("RefreshTrendingScores", mock.Anything, store.RefreshTrendingScoresParams{
+		Now:      s.clock.now,
+		HalfLife: 6 * time.Hour,
+	}).Return(3, nil).Once()
+
+	scored, err := s.refresher.Refresh(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(3, scored)
+
+	// The next refresh computes the scores as of the new time.
+	s.clock.now = s.clock.now.Add(time.Hour)
+	s.mockStore.On("RefreshTrendingScores", mock.Anything, store.RefreshTrendingScoresParams{
+		Now:      s.clock.now,
+		HalfLife: 6 * time.Hour,
+	}).Return(3, nil).Once()
+
+	_, err = s.refresher.Refresh(ctx)
+	s.Require().NoError(err)
+}
+
+func (s *RefresherTestSuite) TestRefresh_StoreError() {
+	storeErr := errors.New("connection refused")
+	s.mockStore.On("RefreshTrendingScores", mock.Anything, mock.Anything).Return(0, storeErr)
+
+	_, err := s.refresher.Refresh(context.Background())
+	s.Require().ErrorIs(err, storeErr)
+}
+
+func (s *RefresherTestSuite) TestNew_InvalidHalfLife() {
+	_, err := trending.New(s.mockStore, s.clock, time.Minute, 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	s.Require().Error(err)
+}
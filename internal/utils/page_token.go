@@ -1,34 +1,72 @@
 package utils
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"strings"
 )
 
-// GeneratePageToken creates a page token from the given value. The value must be JSON-serializable.
-func GeneratePageToken(v any) (string, error) {
-	json, err := json.Marshal(v)
+// ErrInvalidPageToken is returned when a page token is malformed or its signature does not match.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageTokenEncoding is URL-safe so that tokens can be passed as query parameters without escaping.
+var pageTokenEncoding = base64.RawURLEncoding
+
+// GeneratePageToken creates an opaque page token from the given value. The value must be JSON-serializable.
+// The token is signed with secret so that clients cannot forge or modify it.
+func GeneratePageToken(secret []byte, v any) (string, error) {
+	payload, err := json.Marshal(v)
 	if err != nil {
 		return "", err
 	}
 
-	return base64.StdEncoding.EncodeToString(json), nil
+	encodedPayload := pageTokenEncoding.EncodeToString(payload)
+	signature := pageTokenEncoding.EncodeToString(signPageToken(secret, encodedPayload))
+
+	return encodedPayload + "." + signature, nil
 }
 
-// ParsePageToken parses a page token into the given variable. The token must be generated by generatePageToken.
+// ParsePageToken parses a page token into the given variable. The token must be generated by GeneratePageToken
+// with the same secret, otherwise ErrInvalidPageToken is returned.
 //
 // example:
 //
 //	var v ListPreviewsPageToken{}
-//	err := ParsePageToken(token, &v)
+//	err := ParsePageToken(secret, token, &v)
 //	if err != nil {
 //		return err
 //	}
-func ParsePageToken(token string, v any) error {
-	jsonString, err := base64.StdEncoding.DecodeString(token)
+func ParsePageToken(secret []byte, token string, v any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidPageToken
+	}
+
+	signature, err := pageTokenEncoding.DecodeString(encodedSignature)
 	if err != nil {
-		return err
+		return ErrInvalidPageToken
+	}
+	if !hmac.Equal(signature, signPageToken(secret, encodedPayload)) {
+		return ErrInvalidPageToken
 	}
 
-	return json.Unmarshal(jsonString, v)
+	payload, err := pageTokenEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidPageToken
+	}
+
+	if err := json.Unmarshal(payload, v); err != nil {
+		return ErrInvalidPageToken
+	}
+
+	return nil
+}
+
+func signPageToken(secret []byte, encodedPayload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(encodedPayload))
+	return mac.Sum(nil)
 }
@@ -0,0 +1,67 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tuananhlai/brevity-go/internal/utils"
+)
+
+type testPageToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        string    `json:"id"`
+}
+
+var testSecret = []byte("page-token-secret")
+
+func TestPageToken_RoundTrip(t *testing.T) {
+	expected := testPageToken{
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC),
+		ID:        "b6a1c1f0-5f8e-4a52-9f0e-3c1a8d9e2b11",
+	}
+
+	token, err := utils.GeneratePageToken(testSecret, expected)
+	require.NoError(t, err)
+
+	var actual testPageToken
+	err = utils.ParsePageToken(testSecret, token, &actual)
+	require.NoError(t, err)
+	require.True(t, expected.CreatedAt.Equal(actual.CreatedAt))
+	require.Equal(t, expected.ID, actual.ID)
+}
+
+func TestParsePageToken_TamperedPayload(t *testing.T) {
+	token, err := utils.GeneratePageToken(testSecret, testPageToken{ID: "a"})
+	require.NoError(t, err)
+
+	forged, err := utils.GeneratePageToken(testSecret, testPageToken{ID: "b"})
+	require.NoError(t, err)
+
+	// Combine the payload of one token with the signature of another.
+	payload, _, _ := strings.Cut(forged, ".")
+	_, signature, _ := strings.Cut(token, ".")
+
+	var v testPageToken
+	err = utils.ParsePageToken(testSecret, payload+"."+signature, &v)
+	require.ErrorIs(t, err, utils.ErrInvalidPageToken)
+}
+
+func TestParsePageToken_WrongSecret(t *testing.T) {
+	token, err := utils.GeneratePageToken(testSecret, testPageToken{ID: "a"})
+	require.NoError(t, err)
+
+	var v testPageToken
+	err = utils.ParsePageToken([]byte("another-secret"), token, &v)
+	require.ErrorIs(t, err, utils.ErrInvalidPageToken)
+}
+
+func TestParsePageToken_Malformed(t *testing.T) {
+	for _, token := range []string{"", "abc", "abc.def", "!!!.???"} {
+		var v testPageToken
+		err := utils.ParsePageToken(testSecret, token, &v)
+		require.ErrorIs(t, err, utils.ErrInvalidPageToken, "token %q", token)
+	}
+}